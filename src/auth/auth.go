@@ -122,6 +122,14 @@ var (
 	// ErrExpiredToken is returned by the Auth API if a restored token expired in
 	// the past.
 	ErrExpiredToken = status.Error(codes.Internal, "token expiration is in the past")
+
+	// ErrAuthorizationPending is returned by Authenticate with a device code
+	// if the user hasn't approved the device login yet.
+	ErrAuthorizationPending = status.Error(codes.Unavailable, "device login has not been approved yet")
+
+	// ErrSlowDown is returned by Authenticate with a device code if it is
+	// called more often than the interval returned by GetOIDCDeviceLogin.
+	ErrSlowDown = status.Error(codes.Unavailable, "device login is being polled too often, slow down")
 )
 
 var DefaultOIDCScopes = []string{"email", "profile", "groups", oidc.ScopeOpenID}
@@ -179,6 +187,22 @@ func IsErrExpiredToken(err error) bool {
 	return strings.Contains(err.Error(), status.Convert(ErrExpiredToken).Message())
 }
 
+// IsErrAuthorizationPending returns true if 'err' is a ErrAuthorizationPending
+func IsErrAuthorizationPending(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), status.Convert(ErrAuthorizationPending).Message())
+}
+
+// IsErrSlowDown returns true if 'err' is a ErrSlowDown
+func IsErrSlowDown(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), status.Convert(ErrSlowDown).Message())
+}
+
 const errNoRoleBindingMsg = "no role binding exists for"
 
 // ErrNoRoleBinding is returned if no role binding exists for a resource.
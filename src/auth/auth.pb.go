@@ -82,6 +82,9 @@ const (
 	Permission_SECRET_DELETE               Permission = 145
 	Permission_SECRET_INSPECT              Permission = 146
 	Permission_CLUSTER_DELETE_ALL          Permission = 138
+	Permission_CLUSTER_SET_REPO_QUOTA      Permission = 149
+	Permission_CLUSTER_EDIT_NOTIFICATIONS  Permission = 150
+	Permission_CLUSTER_LIST_NOTIFICATIONS  Permission = 151
 	Permission_REPO_READ                   Permission = 200
 	Permission_REPO_WRITE                  Permission = 201
 	Permission_REPO_MODIFY_BINDINGS        Permission = 202
@@ -147,6 +150,9 @@ var Permission_name = map[int32]string{
 	145: "SECRET_DELETE",
 	146: "SECRET_INSPECT",
 	138: "CLUSTER_DELETE_ALL",
+	149: "CLUSTER_SET_REPO_QUOTA",
+	150: "CLUSTER_EDIT_NOTIFICATIONS",
+	151: "CLUSTER_LIST_NOTIFICATIONS",
 	200: "REPO_READ",
 	201: "REPO_WRITE",
 	202: "REPO_MODIFY_BINDINGS",
@@ -212,6 +218,9 @@ var Permission_value = map[string]int32{
 	"SECRET_DELETE":                              145,
 	"SECRET_INSPECT":                             146,
 	"CLUSTER_DELETE_ALL":                         138,
+	"CLUSTER_SET_REPO_QUOTA":                     149,
+	"CLUSTER_EDIT_NOTIFICATIONS":                 150,
+	"CLUSTER_LIST_NOTIFICATIONS":                 151,
 	"REPO_READ":                                  200,
 	"REPO_WRITE":                                 201,
 	"REPO_MODIFY_BINDINGS":                       202,
@@ -831,12 +840,15 @@ var xxx_messageInfo_SetConfigurationResponse proto.InternalMessageInfo
 type TokenInfo struct {
 	// Subject (i.e. Pachyderm account) that a given token authorizes.
 	// See the note at the top of the doc for an explanation of subject structure.
-	Subject              string     `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	Expiration           *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
-	HashedToken          string     `protobuf:"bytes,3,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty" db:"token_hash"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Subject     string     `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Expiration  *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
+	HashedToken string     `protobuf:"bytes,3,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty" db:"token_hash"`
+	// If set, the token can only use the given permissions on the given
+	// resources (and only those its subject is granted by role bindings).
+	Scopes               []*TokenScope `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty" db:"-"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *TokenInfo) Reset()         { *m = TokenInfo{} }
@@ -893,12 +905,79 @@ func (m *TokenInfo) GetHashedToken() string {
 	return ""
 }
 
+func (m *TokenInfo) GetScopes() []*TokenScope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+// TokenScope restricts a token to a set of permissions on a resource. A scope
+// on the cluster applies to every resource, like a cluster role binding.
+type TokenScope struct {
+	Resource             *Resource    `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Permissions          []Permission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TokenScope) Reset()         { *m = TokenScope{} }
+func (m *TokenScope) String() string { return proto.CompactTextString(m) }
+func (*TokenScope) ProtoMessage()    {}
+func (*TokenScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{12}
+}
+func (m *TokenScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenScope.Merge(m, src)
+}
+func (m *TokenScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenScope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenScope proto.InternalMessageInfo
+
+func (m *TokenScope) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *TokenScope) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 type AuthenticateRequest struct {
 	// This is the session state that Pachyderm creates in order to keep track of
 	// information related to the current OIDC session.
 	OIDCState string `protobuf:"bytes,1,opt,name=oidc_state,json=oidcState,proto3" json:"oidc_state,omitempty"`
 	// This is an ID Token issued by the OIDC provider.
-	IdToken              string   `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	IdToken string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	// This is the device code returned by GetOIDCDeviceLogin. Until the user
+	// approves the login, Authenticate returns ErrAuthorizationPending.
+	DeviceCode           string   `protobuf:"bytes,3,opt,name=device_code,json=deviceCode,proto3" json:"device_code,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -908,7 +987,7 @@ func (m *AuthenticateRequest) Reset()         { *m = AuthenticateRequest{} }
 func (m *AuthenticateRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateRequest) ProtoMessage()    {}
 func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{12}
+	return fileDescriptor_712ec48c1eaf43a2, []int{13}
 }
 func (m *AuthenticateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -951,6 +1030,13 @@ func (m *AuthenticateRequest) GetIdToken() string {
 	return ""
 }
 
+func (m *AuthenticateRequest) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
 type AuthenticateResponse struct {
 	// pach_token authenticates the caller with Pachyderm (if you want to perform
 	// Pachyderm operations after auth has been activated as themselves, you must
@@ -965,7 +1051,7 @@ func (m *AuthenticateResponse) Reset()         { *m = AuthenticateResponse{} }
 func (m *AuthenticateResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateResponse) ProtoMessage()    {}
 func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{13}
+	return fileDescriptor_712ec48c1eaf43a2, []int{14}
 }
 func (m *AuthenticateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1011,7 +1097,7 @@ func (m *WhoAmIRequest) Reset()         { *m = WhoAmIRequest{} }
 func (m *WhoAmIRequest) String() string { return proto.CompactTextString(m) }
 func (*WhoAmIRequest) ProtoMessage()    {}
 func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{14}
+	return fileDescriptor_712ec48c1eaf43a2, []int{15}
 }
 func (m *WhoAmIRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1041,18 +1127,19 @@ func (m *WhoAmIRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_WhoAmIRequest proto.InternalMessageInfo
 
 type WhoAmIResponse struct {
-	Username             string     `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Expiration           *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Username             string        `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Expiration           *time.Time    `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
+	Scopes               []*TokenScope `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *WhoAmIResponse) Reset()         { *m = WhoAmIResponse{} }
 func (m *WhoAmIResponse) String() string { return proto.CompactTextString(m) }
 func (*WhoAmIResponse) ProtoMessage()    {}
 func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{15}
+	return fileDescriptor_712ec48c1eaf43a2, []int{16}
 }
 func (m *WhoAmIResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,6 +1182,13 @@ func (m *WhoAmIResponse) GetExpiration() *time.Time {
 	return nil
 }
 
+func (m *WhoAmIResponse) GetScopes() []*TokenScope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
 type GetRolesForPermissionRequest struct {
 	Permission           Permission `protobuf:"varint,1,opt,name=permission,proto3,enum=auth_v2.Permission" json:"permission,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -1106,7 +1200,7 @@ func (m *GetRolesForPermissionRequest) Reset()         { *m = GetRolesForPermiss
 func (m *GetRolesForPermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRolesForPermissionRequest) ProtoMessage()    {}
 func (*GetRolesForPermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{16}
+	return fileDescriptor_712ec48c1eaf43a2, []int{17}
 }
 func (m *GetRolesForPermissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1247,7 @@ func (m *GetRolesForPermissionResponse) Reset()         { *m = GetRolesForPermis
 func (m *GetRolesForPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRolesForPermissionResponse) ProtoMessage()    {}
 func (*GetRolesForPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{17}
+	return fileDescriptor_712ec48c1eaf43a2, []int{18}
 }
 func (m *GetRolesForPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1295,7 @@ func (m *Roles) Reset()         { *m = Roles{} }
 func (m *Roles) String() string { return proto.CompactTextString(m) }
 func (*Roles) ProtoMessage()    {}
 func (*Roles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{18}
+	return fileDescriptor_712ec48c1eaf43a2, []int{19}
 }
 func (m *Roles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +1344,7 @@ func (m *RoleBinding) Reset()         { *m = RoleBinding{} }
 func (m *RoleBinding) String() string { return proto.CompactTextString(m) }
 func (*RoleBinding) ProtoMessage()    {}
 func (*RoleBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{19}
+	return fileDescriptor_712ec48c1eaf43a2, []int{20}
 }
 func (m *RoleBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1393,7 @@ func (m *Resource) Reset()         { *m = Resource{} }
 func (m *Resource) String() string { return proto.CompactTextString(m) }
 func (*Resource) ProtoMessage()    {}
 func (*Resource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{20}
+	return fileDescriptor_712ec48c1eaf43a2, []int{21}
 }
 func (m *Resource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1353,7 +1447,7 @@ func (m *Users) Reset()         { *m = Users{} }
 func (m *Users) String() string { return proto.CompactTextString(m) }
 func (*Users) ProtoMessage()    {}
 func (*Users) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{21}
+	return fileDescriptor_712ec48c1eaf43a2, []int{22}
 }
 func (m *Users) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1400,7 +1494,7 @@ func (m *Groups) Reset()         { *m = Groups{} }
 func (m *Groups) String() string { return proto.CompactTextString(m) }
 func (*Groups) ProtoMessage()    {}
 func (*Groups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{22}
+	return fileDescriptor_712ec48c1eaf43a2, []int{23}
 }
 func (m *Groups) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,7 +1543,7 @@ func (m *Role) Reset()         { *m = Role{} }
 func (m *Role) String() string { return proto.CompactTextString(m) }
 func (*Role) ProtoMessage()    {}
 func (*Role) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{23}
+	return fileDescriptor_712ec48c1eaf43a2, []int{24}
 }
 func (m *Role) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,7 +1606,7 @@ func (m *AuthorizeRequest) Reset()         { *m = AuthorizeRequest{} }
 func (m *AuthorizeRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthorizeRequest) ProtoMessage()    {}
 func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{24}
+	return fileDescriptor_712ec48c1eaf43a2, []int{25}
 }
 func (m *AuthorizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1573,7 +1667,7 @@ func (m *AuthorizeResponse) Reset()         { *m = AuthorizeResponse{} }
 func (m *AuthorizeResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthorizeResponse) ProtoMessage()    {}
 func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{25}
+	return fileDescriptor_712ec48c1eaf43a2, []int{26}
 }
 func (m *AuthorizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1642,7 +1736,7 @@ func (m *GetPermissionsRequest) Reset()         { *m = GetPermissionsRequest{} }
 func (m *GetPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsRequest) ProtoMessage()    {}
 func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{26}
+	return fileDescriptor_712ec48c1eaf43a2, []int{27}
 }
 func (m *GetPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1692,7 +1786,7 @@ func (m *GetPermissionsForPrincipalRequest) Reset()         { *m = GetPermission
 func (m *GetPermissionsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsForPrincipalRequest) ProtoMessage()    {}
 func (*GetPermissionsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{27}
+	return fileDescriptor_712ec48c1eaf43a2, []int{28}
 }
 func (m *GetPermissionsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1749,7 +1843,7 @@ func (m *GetPermissionsResponse) Reset()         { *m = GetPermissionsResponse{}
 func (m *GetPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsResponse) ProtoMessage()    {}
 func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{28}
+	return fileDescriptor_712ec48c1eaf43a2, []int{29}
 }
 func (m *GetPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1809,7 +1903,7 @@ func (m *ModifyRoleBindingRequest) Reset()         { *m = ModifyRoleBindingReque
 func (m *ModifyRoleBindingRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyRoleBindingRequest) ProtoMessage()    {}
 func (*ModifyRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{29}
+	return fileDescriptor_712ec48c1eaf43a2, []int{30}
 }
 func (m *ModifyRoleBindingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1869,7 +1963,7 @@ func (m *ModifyRoleBindingResponse) Reset()         { *m = ModifyRoleBindingResp
 func (m *ModifyRoleBindingResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyRoleBindingResponse) ProtoMessage()    {}
 func (*ModifyRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{30}
+	return fileDescriptor_712ec48c1eaf43a2, []int{31}
 }
 func (m *ModifyRoleBindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1909,7 +2003,7 @@ func (m *GetRoleBindingRequest) Reset()         { *m = GetRoleBindingRequest{} }
 func (m *GetRoleBindingRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRoleBindingRequest) ProtoMessage()    {}
 func (*GetRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{31}
+	return fileDescriptor_712ec48c1eaf43a2, []int{32}
 }
 func (m *GetRoleBindingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1956,7 +2050,7 @@ func (m *GetRoleBindingResponse) Reset()         { *m = GetRoleBindingResponse{}
 func (m *GetRoleBindingResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRoleBindingResponse) ProtoMessage()    {}
 func (*GetRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{32}
+	return fileDescriptor_712ec48c1eaf43a2, []int{33}
 }
 func (m *GetRoleBindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2031,7 +2125,7 @@ func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
 func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
 func (*SessionInfo) ProtoMessage()    {}
 func (*SessionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{33}
+	return fileDescriptor_712ec48c1eaf43a2, []int{34}
 }
 func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2091,7 +2185,7 @@ func (m *GetOIDCLoginRequest) Reset()         { *m = GetOIDCLoginRequest{} }
 func (m *GetOIDCLoginRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOIDCLoginRequest) ProtoMessage()    {}
 func (*GetOIDCLoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{34}
+	return fileDescriptor_712ec48c1eaf43a2, []int{35}
 }
 func (m *GetOIDCLoginRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2133,7 +2227,7 @@ func (m *GetOIDCLoginResponse) Reset()         { *m = GetOIDCLoginResponse{} }
 func (m *GetOIDCLoginResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOIDCLoginResponse) ProtoMessage()    {}
 func (*GetOIDCLoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{35}
+	return fileDescriptor_712ec48c1eaf43a2, []int{36}
 }
 func (m *GetOIDCLoginResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2176,23 +2270,161 @@ func (m *GetOIDCLoginResponse) GetState() string {
 	return ""
 }
 
+type GetOIDCDeviceLoginRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOIDCDeviceLoginRequest) Reset()         { *m = GetOIDCDeviceLoginRequest{} }
+func (m *GetOIDCDeviceLoginRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOIDCDeviceLoginRequest) ProtoMessage()    {}
+func (*GetOIDCDeviceLoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{37}
+}
+func (m *GetOIDCDeviceLoginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOIDCDeviceLoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOIDCDeviceLoginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetOIDCDeviceLoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOIDCDeviceLoginRequest.Merge(m, src)
+}
+func (m *GetOIDCDeviceLoginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOIDCDeviceLoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOIDCDeviceLoginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOIDCDeviceLoginRequest proto.InternalMessageInfo
+
+// GetOIDCDeviceLoginResponse is the start of an OAuth 2.0 device
+// authorization grant, which lets users log in from a device without a
+// browser: they approve the login by entering user_code at verification_url on
+// any device, while the caller polls Authenticate with device_code.
+type GetOIDCDeviceLoginResponse struct {
+	UserCode        string `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	VerificationURL string `protobuf:"bytes,2,opt,name=verification_url,json=verificationUrl,proto3" json:"verification_url,omitempty"`
+	// verification_url_complete is verification_url with user_code filled in,
+	// if the OIDC provider supports it.
+	VerificationURLComplete string `protobuf:"bytes,3,opt,name=verification_url_complete,json=verificationUrlComplete,proto3" json:"verification_url_complete,omitempty"`
+	DeviceCode              string `protobuf:"bytes,4,opt,name=device_code,json=deviceCode,proto3" json:"device_code,omitempty"`
+	// expires_in is the number of seconds until device_code expires.
+	ExpiresIn int64 `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// interval is the number of seconds callers should wait between calls to
+	// Authenticate.
+	Interval             int64    `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOIDCDeviceLoginResponse) Reset()         { *m = GetOIDCDeviceLoginResponse{} }
+func (m *GetOIDCDeviceLoginResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOIDCDeviceLoginResponse) ProtoMessage()    {}
+func (*GetOIDCDeviceLoginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{38}
+}
+func (m *GetOIDCDeviceLoginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOIDCDeviceLoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOIDCDeviceLoginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetOIDCDeviceLoginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOIDCDeviceLoginResponse.Merge(m, src)
+}
+func (m *GetOIDCDeviceLoginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOIDCDeviceLoginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOIDCDeviceLoginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOIDCDeviceLoginResponse proto.InternalMessageInfo
+
+func (m *GetOIDCDeviceLoginResponse) GetUserCode() string {
+	if m != nil {
+		return m.UserCode
+	}
+	return ""
+}
+
+func (m *GetOIDCDeviceLoginResponse) GetVerificationURL() string {
+	if m != nil {
+		return m.VerificationURL
+	}
+	return ""
+}
+
+func (m *GetOIDCDeviceLoginResponse) GetVerificationURLComplete() string {
+	if m != nil {
+		return m.VerificationURLComplete
+	}
+	return ""
+}
+
+func (m *GetOIDCDeviceLoginResponse) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+func (m *GetOIDCDeviceLoginResponse) GetExpiresIn() int64 {
+	if m != nil {
+		return m.ExpiresIn
+	}
+	return 0
+}
+
+func (m *GetOIDCDeviceLoginResponse) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
 type GetRobotTokenRequest struct {
 	// The returned token will allow the caller to access resources as this
 	// robot user
 	Robot string `protobuf:"bytes,1,opt,name=robot,proto3" json:"robot,omitempty"`
 	// ttl indicates the requested (approximate) remaining lifetime of this token,
 	// in seconds
-	TTL                  int64    `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TTL int64 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// scopes, if set, restricts the token to the given permissions on the
+	// given resources. Otherwise the token has all of the robot's permissions.
+	Scopes               []*TokenScope `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *GetRobotTokenRequest) Reset()         { *m = GetRobotTokenRequest{} }
 func (m *GetRobotTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRobotTokenRequest) ProtoMessage()    {}
 func (*GetRobotTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{36}
+	return fileDescriptor_712ec48c1eaf43a2, []int{39}
 }
 func (m *GetRobotTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2235,6 +2467,13 @@ func (m *GetRobotTokenRequest) GetTTL() int64 {
 	return 0
 }
 
+func (m *GetRobotTokenRequest) GetScopes() []*TokenScope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
 type GetRobotTokenResponse struct {
 	// A new auth token for the requested robot
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -2247,7 +2486,7 @@ func (m *GetRobotTokenResponse) Reset()         { *m = GetRobotTokenResponse{} }
 func (m *GetRobotTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRobotTokenResponse) ProtoMessage()    {}
 func (*GetRobotTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{37}
+	return fileDescriptor_712ec48c1eaf43a2, []int{40}
 }
 func (m *GetRobotTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2294,7 +2533,7 @@ func (m *RevokeAuthTokenRequest) Reset()         { *m = RevokeAuthTokenRequest{}
 func (m *RevokeAuthTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokenRequest) ProtoMessage()    {}
 func (*RevokeAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{38}
+	return fileDescriptor_712ec48c1eaf43a2, []int{41}
 }
 func (m *RevokeAuthTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2579,7 @@ func (m *RevokeAuthTokenResponse) Reset()         { *m = RevokeAuthTokenResponse
 func (m *RevokeAuthTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokenResponse) ProtoMessage()    {}
 func (*RevokeAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{39}
+	return fileDescriptor_712ec48c1eaf43a2, []int{42}
 }
 func (m *RevokeAuthTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2381,7 +2620,7 @@ func (m *SetGroupsForUserRequest) Reset()         { *m = SetGroupsForUserRequest
 func (m *SetGroupsForUserRequest) String() string { return proto.CompactTextString(m) }
 func (*SetGroupsForUserRequest) ProtoMessage()    {}
 func (*SetGroupsForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{40}
+	return fileDescriptor_712ec48c1eaf43a2, []int{43}
 }
 func (m *SetGroupsForUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2434,7 +2673,7 @@ func (m *SetGroupsForUserResponse) Reset()         { *m = SetGroupsForUserRespon
 func (m *SetGroupsForUserResponse) String() string { return proto.CompactTextString(m) }
 func (*SetGroupsForUserResponse) ProtoMessage()    {}
 func (*SetGroupsForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{41}
+	return fileDescriptor_712ec48c1eaf43a2, []int{44}
 }
 func (m *SetGroupsForUserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2476,7 +2715,7 @@ func (m *ModifyMembersRequest) Reset()         { *m = ModifyMembersRequest{} }
 func (m *ModifyMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyMembersRequest) ProtoMessage()    {}
 func (*ModifyMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{42}
+	return fileDescriptor_712ec48c1eaf43a2, []int{45}
 }
 func (m *ModifyMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2775,7 @@ func (m *ModifyMembersResponse) Reset()         { *m = ModifyMembersResponse{} }
 func (m *ModifyMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyMembersResponse) ProtoMessage()    {}
 func (*ModifyMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{43}
+	return fileDescriptor_712ec48c1eaf43a2, []int{46}
 }
 func (m *ModifyMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2575,7 +2814,7 @@ func (m *GetGroupsRequest) Reset()         { *m = GetGroupsRequest{} }
 func (m *GetGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsRequest) ProtoMessage()    {}
 func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{44}
+	return fileDescriptor_712ec48c1eaf43a2, []int{47}
 }
 func (m *GetGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +2854,7 @@ func (m *GetGroupsForPrincipalRequest) Reset()         { *m = GetGroupsForPrinci
 func (m *GetGroupsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsForPrincipalRequest) ProtoMessage()    {}
 func (*GetGroupsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{45}
+	return fileDescriptor_712ec48c1eaf43a2, []int{48}
 }
 func (m *GetGroupsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2662,7 +2901,7 @@ func (m *GetGroupsResponse) Reset()         { *m = GetGroupsResponse{} }
 func (m *GetGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsResponse) ProtoMessage()    {}
 func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{46}
+	return fileDescriptor_712ec48c1eaf43a2, []int{49}
 }
 func (m *GetGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2709,7 +2948,7 @@ func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
 func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUsersRequest) ProtoMessage()    {}
 func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{47}
+	return fileDescriptor_712ec48c1eaf43a2, []int{50}
 }
 func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2756,7 +2995,7 @@ func (m *GetUsersResponse) Reset()         { *m = GetUsersResponse{} }
 func (m *GetUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUsersResponse) ProtoMessage()    {}
 func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{48}
+	return fileDescriptor_712ec48c1eaf43a2, []int{51}
 }
 func (m *GetUsersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2804,7 +3043,7 @@ func (m *ExtractAuthTokensRequest) Reset()         { *m = ExtractAuthTokensReque
 func (m *ExtractAuthTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*ExtractAuthTokensRequest) ProtoMessage()    {}
 func (*ExtractAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{49}
+	return fileDescriptor_712ec48c1eaf43a2, []int{52}
 }
 func (m *ExtractAuthTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3083,7 @@ func (m *ExtractAuthTokensResponse) Reset()         { *m = ExtractAuthTokensResp
 func (m *ExtractAuthTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*ExtractAuthTokensResponse) ProtoMessage()    {}
 func (*ExtractAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{50}
+	return fileDescriptor_712ec48c1eaf43a2, []int{53}
 }
 func (m *ExtractAuthTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2892,7 +3131,7 @@ func (m *RestoreAuthTokenRequest) Reset()         { *m = RestoreAuthTokenRequest
 func (m *RestoreAuthTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreAuthTokenRequest) ProtoMessage()    {}
 func (*RestoreAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{51}
+	return fileDescriptor_712ec48c1eaf43a2, []int{54}
 }
 func (m *RestoreAuthTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2938,7 +3177,7 @@ func (m *RestoreAuthTokenResponse) Reset()         { *m = RestoreAuthTokenRespon
 func (m *RestoreAuthTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreAuthTokenResponse) ProtoMessage()    {}
 func (*RestoreAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{52}
+	return fileDescriptor_712ec48c1eaf43a2, []int{55}
 }
 func (m *RestoreAuthTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2978,7 +3217,7 @@ func (m *RevokeAuthTokensForUserRequest) Reset()         { *m = RevokeAuthTokens
 func (m *RevokeAuthTokensForUserRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokensForUserRequest) ProtoMessage()    {}
 func (*RevokeAuthTokensForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{53}
+	return fileDescriptor_712ec48c1eaf43a2, []int{56}
 }
 func (m *RevokeAuthTokensForUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +3263,7 @@ func (m *RevokeAuthTokensForUserResponse) Reset()         { *m = RevokeAuthToken
 func (m *RevokeAuthTokensForUserResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokensForUserResponse) ProtoMessage()    {}
 func (*RevokeAuthTokensForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{54}
+	return fileDescriptor_712ec48c1eaf43a2, []int{57}
 }
 func (m *RevokeAuthTokensForUserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3063,7 +3302,7 @@ func (m *DeleteExpiredAuthTokensRequest) Reset()         { *m = DeleteExpiredAut
 func (m *DeleteExpiredAuthTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteExpiredAuthTokensRequest) ProtoMessage()    {}
 func (*DeleteExpiredAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{55}
+	return fileDescriptor_712ec48c1eaf43a2, []int{58}
 }
 func (m *DeleteExpiredAuthTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3102,7 +3341,7 @@ func (m *DeleteExpiredAuthTokensResponse) Reset()         { *m = DeleteExpiredAu
 func (m *DeleteExpiredAuthTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteExpiredAuthTokensResponse) ProtoMessage()    {}
 func (*DeleteExpiredAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{56}
+	return fileDescriptor_712ec48c1eaf43a2, []int{59}
 }
 func (m *DeleteExpiredAuthTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3146,6 +3385,7 @@ func init() {
 	proto.RegisterType((*SetConfigurationRequest)(nil), "auth_v2.SetConfigurationRequest")
 	proto.RegisterType((*SetConfigurationResponse)(nil), "auth_v2.SetConfigurationResponse")
 	proto.RegisterType((*TokenInfo)(nil), "auth_v2.TokenInfo")
+	proto.RegisterType((*TokenScope)(nil), "auth_v2.TokenScope")
 	proto.RegisterType((*AuthenticateRequest)(nil), "auth_v2.AuthenticateRequest")
 	proto.RegisterType((*AuthenticateResponse)(nil), "auth_v2.AuthenticateResponse")
 	proto.RegisterType((*WhoAmIRequest)(nil), "auth_v2.WhoAmIRequest")
@@ -3174,6 +3414,8 @@ func init() {
 	proto.RegisterType((*SessionInfo)(nil), "auth_v2.SessionInfo")
 	proto.RegisterType((*GetOIDCLoginRequest)(nil), "auth_v2.GetOIDCLoginRequest")
 	proto.RegisterType((*GetOIDCLoginResponse)(nil), "auth_v2.GetOIDCLoginResponse")
+	proto.RegisterType((*GetOIDCDeviceLoginRequest)(nil), "auth_v2.GetOIDCDeviceLoginRequest")
+	proto.RegisterType((*GetOIDCDeviceLoginResponse)(nil), "auth_v2.GetOIDCDeviceLoginResponse")
 	proto.RegisterType((*GetRobotTokenRequest)(nil), "auth_v2.GetRobotTokenRequest")
 	proto.RegisterType((*GetRobotTokenResponse)(nil), "auth_v2.GetRobotTokenResponse")
 	proto.RegisterType((*RevokeAuthTokenRequest)(nil), "auth_v2.RevokeAuthTokenRequest")
@@ -3200,183 +3442,198 @@ func init() {
 func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
 
 var fileDescriptor_712ec48c1eaf43a2 = []byte{
-	// 2809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xe9, 0x73, 0xdb, 0xc6,
-	0x15, 0x37, 0x24, 0x5b, 0x22, 0x9f, 0x2e, 0x78, 0x45, 0x49, 0x14, 0x64, 0x89, 0x12, 0x1c, 0xc7,
-	0x47, 0x1b, 0x29, 0x51, 0x9a, 0xd6, 0x49, 0xdc, 0x0f, 0x3c, 0x20, 0x1a, 0x09, 0x45, 0x72, 0x00,
-	0xd0, 0x8e, 0x3b, 0x9d, 0xa2, 0x14, 0xb9, 0x96, 0x50, 0x4b, 0x04, 0x03, 0x80, 0xaa, 0x95, 0x36,
-	0x6d, 0xd3, 0xfb, 0x4e, 0x7a, 0x4c, 0xff, 0x8b, 0x7e, 0x69, 0xff, 0x89, 0xf4, 0x4e, 0xcf, 0x8f,
-	0x6e, 0x46, 0x7f, 0x42, 0xa7, 0xd3, 0xcf, 0x9d, 0x5d, 0x2c, 0x80, 0x05, 0x08, 0xca, 0x4e, 0x32,
-	0xf9, 0x22, 0x61, 0xdf, 0xfb, 0xbd, 0x63, 0xdf, 0xbe, 0x5d, 0xbc, 0x7d, 0x20, 0xcc, 0xb5, 0x07,
-	0xde, 0xc1, 0x16, 0xf9, 0xb3, 0xd9, 0x77, 0x6c, 0xcf, 0x46, 0x93, 0xe4, 0xd9, 0x3c, 0xde, 0x96,
-	0x72, 0xfb, 0xf6, 0xbe, 0x4d, 0x69, 0x5b, 0xe4, 0xc9, 0x67, 0x4b, 0x85, 0x7d, 0xdb, 0xde, 0x3f,
-	0xc4, 0x5b, 0x74, 0xb4, 0x37, 0xb8, 0xbf, 0xe5, 0x59, 0x47, 0xd8, 0xf5, 0xda, 0x47, 0x7d, 0x1f,
-	0x20, 0x3f, 0x0b, 0x73, 0xc5, 0x8e, 0x67, 0x1d, 0xb7, 0x3d, 0xac, 0xe1, 0xd7, 0x07, 0xd8, 0xf5,
-	0xd0, 0x2a, 0x80, 0x63, 0xdb, 0x9e, 0xe9, 0xd9, 0x0f, 0x70, 0x2f, 0x2f, 0xac, 0x0b, 0xd7, 0xb2,
-	0x5a, 0x96, 0x50, 0x0c, 0x42, 0x90, 0x9f, 0x03, 0x31, 0x92, 0x70, 0xfb, 0x76, 0xcf, 0xc5, 0x44,
-	0xa4, 0xdf, 0xee, 0x1c, 0xc4, 0x45, 0x08, 0xc5, 0x17, 0x99, 0x87, 0x8b, 0x15, 0xdc, 0x8e, 0x9b,
-	0x91, 0x73, 0x80, 0x78, 0xa2, 0xaf, 0x49, 0xfe, 0x0c, 0x2c, 0x6a, 0xb6, 0x47, 0x28, 0x81, 0xc1,
-	0x27, 0x74, 0xeb, 0x26, 0x2c, 0x0d, 0x09, 0x46, 0xde, 0x9d, 0x25, 0xf9, 0xfe, 0x18, 0x40, 0x43,
-	0xad, 0x94, 0xcb, 0x76, 0xef, 0xbe, 0xb5, 0x8f, 0x16, 0x61, 0xc2, 0x72, 0xdd, 0x01, 0x76, 0x18,
-	0x92, 0x8d, 0xd0, 0x75, 0xc8, 0x76, 0x0e, 0x2d, 0xdc, 0xf3, 0x4c, 0xab, 0x9b, 0x1f, 0x23, 0xac,
-	0xd2, 0xf4, 0xe9, 0xa3, 0x42, 0xa6, 0x4c, 0x89, 0x6a, 0x45, 0xcb, 0xf8, 0x6c, 0xb5, 0x8b, 0x2e,
-	0xc3, 0x0c, 0x83, 0xba, 0xb8, 0xe3, 0x60, 0x2f, 0x3f, 0x4e, 0x35, 0x4d, 0xfb, 0x44, 0x9d, 0xd2,
-	0xd0, 0x36, 0x4c, 0x3b, 0xb8, 0x6b, 0x39, 0xb8, 0xe3, 0x99, 0x03, 0xc7, 0xca, 0x9f, 0xa7, 0x2a,
-	0xe7, 0x4e, 0x1f, 0x15, 0xa6, 0x34, 0x46, 0x6f, 0x69, 0xaa, 0x36, 0x15, 0x80, 0x5a, 0x8e, 0x45,
-	0x7c, 0x73, 0x3b, 0x76, 0x1f, 0xbb, 0xf9, 0x0b, 0xeb, 0xe3, 0xc4, 0x37, 0x7f, 0x84, 0x3e, 0x05,
-	0x8b, 0x0e, 0x7e, 0x7d, 0x60, 0x39, 0xd8, 0xc4, 0x47, 0x6d, 0xeb, 0xd0, 0x3c, 0xc6, 0x8e, 0x75,
-	0xdf, 0xc2, 0xdd, 0xfc, 0xc4, 0xba, 0x70, 0x2d, 0xa3, 0xe5, 0x18, 0x57, 0x21, 0xcc, 0x3b, 0x8c,
-	0x87, 0xae, 0x83, 0x78, 0x68, 0x77, 0xda, 0x87, 0x07, 0xb6, 0xeb, 0x99, 0x6c, 0xce, 0x93, 0x14,
-	0x3f, 0x17, 0xd2, 0x55, 0x7f, 0xf2, 0x9f, 0x85, 0x95, 0x81, 0x8b, 0x1d, 0xb3, 0xdd, 0xe9, 0x60,
-	0xd7, 0xb5, 0xf6, 0x0e, 0x31, 0x13, 0x30, 0x09, 0x28, 0x9f, 0xa1, 0xf3, 0xcb, 0x13, 0x48, 0x31,
-	0x44, 0xf8, 0xa2, 0xb7, 0x6d, 0xd7, 0x93, 0x97, 0x61, 0xa9, 0x8a, 0x3d, 0x3f, 0xc0, 0x03, 0xa7,
-	0xed, 0x59, 0x76, 0xb0, 0xac, 0x72, 0x0b, 0xf2, 0xc3, 0x2c, 0xb6, 0x70, 0x2f, 0xc2, 0x4c, 0x87,
-	0x67, 0xd0, 0x15, 0x99, 0xda, 0x9e, 0xdf, 0x64, 0x49, 0xbf, 0x19, 0x2d, 0x9b, 0x16, 0x47, 0xca,
-	0x06, 0x2c, 0xe9, 0xe9, 0x16, 0x3f, 0x8a, 0x56, 0x09, 0xf2, 0xfa, 0x08, 0x67, 0xe5, 0xdf, 0x08,
-	0x90, 0xa5, 0x09, 0xa5, 0xf6, 0xee, 0xdb, 0x28, 0x0f, 0x93, 0xee, 0x60, 0xef, 0x4b, 0xb8, 0xe3,
-	0xb1, 0x34, 0x0a, 0x86, 0x48, 0x07, 0xc0, 0x0f, 0xfb, 0x16, 0xb3, 0x3d, 0x46, 0x6d, 0x4b, 0x9b,
-	0xfe, 0x3e, 0xdd, 0x0c, 0xf6, 0xe9, 0xa6, 0x11, 0xec, 0xd3, 0xd2, 0xd2, 0x7f, 0x1e, 0x15, 0xe6,
-	0xba, 0x7b, 0x2f, 0xc9, 0x91, 0x94, 0xfc, 0xce, 0xbf, 0x0b, 0x82, 0xc6, 0xa9, 0x41, 0x9f, 0x86,
-	0xe9, 0x83, 0xb6, 0x7b, 0x80, 0xbb, 0x2c, 0xc9, 0x69, 0xc2, 0x95, 0xe6, 0x03, 0x51, 0x4a, 0x34,
-	0x09, 0x42, 0xd6, 0xa6, 0x7c, 0xa0, 0x9f, 0xfb, 0x5f, 0x80, 0xf9, 0xe2, 0xc0, 0x3b, 0xc0, 0x3d,
-	0xcf, 0xea, 0x70, 0x47, 0xc0, 0x27, 0x01, 0x6c, 0xab, 0xdb, 0x31, 0x5d, 0xb2, 0xa1, 0xfc, 0x09,
-	0x94, 0x66, 0x4e, 0x1f, 0x15, 0xb2, 0x24, 0x34, 0x3a, 0xdd, 0x65, 0x59, 0x02, 0xa0, 0x8f, 0x68,
-	0x19, 0x32, 0x56, 0x60, 0x78, 0xcc, 0x9f, 0xac, 0xc5, 0xf4, 0xbf, 0x00, 0xb9, 0xb8, 0xfe, 0x27,
-	0x3b, 0x30, 0xe6, 0x60, 0xe6, 0xee, 0x81, 0x5d, 0x3c, 0x52, 0x83, 0x2c, 0x79, 0x4b, 0x80, 0xd9,
-	0x80, 0xc2, 0x54, 0x48, 0x90, 0x21, 0xf9, 0xd6, 0x6b, 0x1f, 0x31, 0x0f, 0xb5, 0x70, 0xfc, 0xb1,
-	0xc4, 0x58, 0xd6, 0xe1, 0x52, 0x15, 0x7b, 0x9a, 0x7d, 0x88, 0xdd, 0x1d, 0xdb, 0x69, 0x62, 0xe7,
-	0xc8, 0x72, 0x5d, 0x2e, 0xaf, 0x9e, 0x07, 0xe8, 0x87, 0x44, 0xea, 0xd2, 0x2c, 0x97, 0x54, 0x1c,
-	0x9e, 0x83, 0xc9, 0x15, 0x58, 0x1d, 0xa1, 0x94, 0x4d, 0xf3, 0x32, 0x5c, 0x70, 0x08, 0x37, 0x2f,
-	0xac, 0x8f, 0x5f, 0x9b, 0xda, 0x9e, 0x09, 0x15, 0x12, 0x19, 0xcd, 0xe7, 0xc9, 0x0e, 0x5c, 0xa0,
-	0x2a, 0xd0, 0x56, 0x1c, 0xbd, 0x1c, 0x43, 0xbb, 0xfe, 0x5f, 0xa5, 0xe7, 0x39, 0x27, 0x4c, 0x52,
-	0xba, 0x09, 0x10, 0x11, 0x91, 0x08, 0xe3, 0x0f, 0xf0, 0x09, 0x0b, 0x27, 0x79, 0x44, 0x39, 0xb8,
-	0x70, 0xdc, 0x3e, 0x1c, 0x60, 0x1a, 0xc4, 0x8c, 0xe6, 0x0f, 0x5e, 0x1a, 0xbb, 0x29, 0xc8, 0xbf,
-	0x12, 0x60, 0x8a, 0x88, 0x96, 0xac, 0x5e, 0xd7, 0xea, 0xed, 0xa3, 0x97, 0x61, 0x12, 0xf7, 0x3c,
-	0xc7, 0x0a, 0x8d, 0x6f, 0xc4, 0x8c, 0x33, 0xd8, 0xa6, 0xe2, 0x63, 0x7c, 0x27, 0x02, 0x09, 0xe9,
-	0x15, 0x98, 0xe6, 0x19, 0x29, 0x8e, 0x3c, 0xc5, 0x3b, 0x32, 0xb5, 0x3d, 0x1b, 0x9f, 0x19, 0xef,
-	0x98, 0x0a, 0x19, 0x0d, 0xbb, 0xf6, 0xc0, 0xe9, 0x60, 0x74, 0x1d, 0xce, 0x7b, 0x27, 0x7d, 0xcc,
-	0x56, 0x63, 0x21, 0x12, 0x62, 0x00, 0xe3, 0xa4, 0x8f, 0x35, 0x0a, 0x41, 0x08, 0xce, 0xd3, 0x5c,
-	0xf2, 0x33, 0x98, 0x3e, 0xcb, 0xdf, 0x14, 0xe0, 0x42, 0xcb, 0xc5, 0x8e, 0x8b, 0x5e, 0x86, 0x6c,
-	0x90, 0x5d, 0xc1, 0xfc, 0x56, 0x43, 0x6d, 0x14, 0x42, 0xff, 0x52, 0xbe, 0x3f, 0xb7, 0x08, 0x2f,
-	0xdd, 0x82, 0xd9, 0x38, 0xf3, 0x03, 0x05, 0xfa, 0x21, 0x4c, 0x54, 0x1d, 0x7b, 0xd0, 0x77, 0xd1,
-	0xf3, 0x30, 0xb1, 0x4f, 0x9f, 0x98, 0x07, 0x2b, 0xa1, 0x07, 0x3e, 0x80, 0xfd, 0xf3, 0xed, 0x33,
-	0xa8, 0xf4, 0x22, 0x4c, 0x71, 0xe4, 0x0f, 0x64, 0xf9, 0x6d, 0x01, 0xce, 0x93, 0xf0, 0x86, 0xb1,
-	0x11, 0xa2, 0xd8, 0xa0, 0x17, 0x60, 0x2a, 0xca, 0x63, 0x37, 0x3f, 0xb6, 0x3e, 0x3e, 0x2a, 0xdf,
-	0x79, 0x1c, 0xba, 0x05, 0xb3, 0x0e, 0x0b, 0xbe, 0x49, 0xe2, 0xee, 0xe6, 0xc7, 0xa9, 0xe4, 0x88,
-	0xb5, 0x99, 0x71, 0xb8, 0x91, 0x2b, 0x3f, 0x04, 0x91, 0x9c, 0x27, 0xb6, 0x63, 0xbd, 0x11, 0x1e,
-	0x56, 0xcf, 0x40, 0x26, 0x00, 0xb1, 0xa3, 0xfc, 0xe2, 0x90, 0x2e, 0x2d, 0x84, 0x7c, 0x48, 0xbf,
-	0xe5, 0xdf, 0x0a, 0x70, 0x91, 0x33, 0xcd, 0x76, 0xe7, 0x1a, 0x40, 0x3b, 0x20, 0x76, 0xa9, 0xf5,
-	0x8c, 0xc6, 0x51, 0xd0, 0x73, 0x90, 0x75, 0xdb, 0x9e, 0xe5, 0xd2, 0x77, 0xf1, 0x19, 0xa6, 0x22,
-	0x14, 0x7a, 0x06, 0x26, 0x29, 0xb5, 0xb7, 0xcf, 0x22, 0x93, 0x2a, 0x10, 0x60, 0xd0, 0x25, 0xc8,
-	0xf6, 0x1d, 0xab, 0xd7, 0xb1, 0xfa, 0xed, 0x43, 0xbf, 0x86, 0xd0, 0x22, 0x82, 0xbc, 0x03, 0x0b,
-	0x55, 0xec, 0x45, 0x72, 0xee, 0x87, 0x0b, 0x9a, 0xdc, 0x87, 0x8d, 0xb8, 0x1e, 0x72, 0x58, 0x05,
-	0x56, 0x3e, 0xe4, 0x42, 0xc4, 0x3c, 0x1f, 0x4b, 0x7a, 0x8e, 0x61, 0x31, 0xe9, 0x39, 0x8b, 0x79,
-	0x62, 0x01, 0x85, 0x27, 0x4c, 0xbc, 0x5c, 0x70, 0x34, 0x8e, 0xd1, 0xd2, 0x89, 0x9d, 0x9c, 0x6f,
-	0x42, 0x7e, 0xd7, 0xee, 0x5a, 0xf7, 0x4f, 0xb8, 0x33, 0xea, 0xe3, 0x98, 0x4f, 0x64, 0x7e, 0x9c,
-	0x37, 0xbf, 0x02, 0xcb, 0x29, 0xe6, 0x59, 0x45, 0xe1, 0x2f, 0xde, 0x47, 0x76, 0x4c, 0xbe, 0x4d,
-	0x43, 0x99, 0x62, 0x01, 0x6d, 0xc2, 0xe4, 0x9e, 0x4f, 0x62, 0x7a, 0x72, 0x69, 0x67, 0xb6, 0x16,
-	0x80, 0xe4, 0x2f, 0xc2, 0x94, 0x8e, 0x69, 0x3c, 0x69, 0x91, 0x93, 0x83, 0x0b, 0x3d, 0xbb, 0xd7,
-	0x09, 0xce, 0x05, 0x7f, 0x40, 0xa8, 0xb4, 0x08, 0x65, 0x31, 0xf0, 0x07, 0xe8, 0x0a, 0xcc, 0x76,
-	0xec, 0xde, 0x31, 0x76, 0x88, 0xb4, 0x89, 0x1d, 0x87, 0xd6, 0x28, 0x19, 0x5a, 0x61, 0x31, 0xaa,
-	0xe2, 0x38, 0xf2, 0x02, 0xcc, 0x57, 0xb1, 0x47, 0xca, 0x8c, 0x9a, 0xbd, 0x6f, 0x85, 0x55, 0xe2,
-	0x5d, 0xc8, 0xc5, 0xc9, 0x6c, 0x02, 0xd7, 0x21, 0x7b, 0x48, 0x08, 0xe6, 0xc0, 0x39, 0x64, 0x75,
-	0x0a, 0x2d, 0xca, 0x29, 0xaa, 0xa5, 0xd5, 0xb4, 0x0c, 0x65, 0xb7, 0x1c, 0xba, 0x00, 0x7e, 0x39,
-	0xc3, 0xdc, 0xa2, 0x03, 0xb9, 0x4a, 0x15, 0x6b, 0xf6, 0x5e, 0xe2, 0xb6, 0x41, 0x97, 0x6b, 0xcf,
-	0x0e, 0xaa, 0x37, 0x7f, 0x80, 0x96, 0x61, 0xdc, 0xf3, 0xfc, 0x89, 0x8d, 0x97, 0x26, 0x4f, 0x1f,
-	0x15, 0xc6, 0x0d, 0xa3, 0xa6, 0x11, 0x9a, 0xfc, 0x0c, 0x5b, 0xac, 0xbd, 0xe4, 0xed, 0x23, 0x07,
-	0x17, 0xf8, 0x2a, 0xc7, 0x1f, 0xc8, 0x9b, 0xb0, 0xa8, 0xe1, 0x63, 0xfb, 0x01, 0x26, 0x67, 0x4a,
-	0xd2, 0x72, 0x0a, 0x7e, 0x19, 0x96, 0x86, 0xf0, 0x2c, 0x4d, 0x76, 0x69, 0xa9, 0xeb, 0x9f, 0xf1,
-	0x3b, 0xb6, 0x43, 0xde, 0x34, 0x81, 0xae, 0xb3, 0x6a, 0xa4, 0xc5, 0xf0, 0x65, 0xe2, 0x6f, 0x08,
-	0x36, 0x62, 0x35, 0x6e, 0x42, 0x1d, 0x33, 0x75, 0x07, 0x72, 0x7e, 0xba, 0xee, 0xe2, 0xa3, 0x3d,
-	0xec, 0xb8, 0x9c, 0xcf, 0x54, 0x3a, 0xf0, 0x99, 0x0e, 0xc8, 0xab, 0xa6, 0xdd, 0xed, 0x32, 0xf5,
-	0xe4, 0x91, 0xd8, 0x74, 0xf0, 0x91, 0x7d, 0x8c, 0xd9, 0x2e, 0x60, 0x23, 0x79, 0x09, 0x16, 0x12,
-	0x7a, 0x99, 0x41, 0x04, 0x62, 0x35, 0x70, 0x26, 0xc8, 0x85, 0x5b, 0xb4, 0x0e, 0x0b, 0x1d, 0x1c,
-	0x3a, 0x86, 0x62, 0xfb, 0x50, 0x48, 0x9e, 0x2b, 0x9f, 0x80, 0x8b, 0x9c, 0x46, 0xb6, 0x46, 0x8b,
-	0xb1, 0x17, 0x6b, 0x14, 0x8b, 0xab, 0x30, 0x57, 0xc5, 0x1e, 0x7d, 0xbd, 0x9f, 0x39, 0x55, 0xf9,
-	0x59, 0xea, 0x27, 0x03, 0x32, 0xa5, 0x97, 0x92, 0x25, 0x43, 0x96, 0xab, 0x09, 0x48, 0x98, 0x95,
-	0x87, 0x9e, 0xd3, 0xee, 0x78, 0xe1, 0x8a, 0x86, 0x33, 0xac, 0xc2, 0x72, 0x0a, 0x8f, 0xa9, 0xbd,
-	0x01, 0x13, 0x34, 0x25, 0x82, 0x22, 0x00, 0x85, 0x5b, 0x36, 0xbc, 0x7d, 0x68, 0x0c, 0x21, 0x97,
-	0x49, 0xd6, 0xb8, 0x9e, 0xed, 0x0c, 0xa7, 0xd9, 0x35, 0x3e, 0xcd, 0xd2, 0xb5, 0xb0, 0xd4, 0x93,
-	0x20, 0x3f, 0xac, 0x84, 0xad, 0xcf, 0x2d, 0x58, 0x4b, 0xa4, 0xe5, 0x07, 0x48, 0x41, 0x79, 0x03,
-	0x0a, 0x23, 0xa5, 0x99, 0x81, 0x75, 0x58, 0xab, 0xe0, 0x43, 0xec, 0x61, 0x85, 0x14, 0xe2, 0xb8,
-	0x3b, 0x1c, 0xac, 0x0d, 0x28, 0x8c, 0x44, 0xf8, 0x4a, 0x6e, 0xfc, 0x77, 0x16, 0x20, 0x7a, 0x2d,
-	0xa0, 0x45, 0x40, 0x4d, 0x45, 0xdb, 0x55, 0x75, 0x5d, 0x6d, 0xd4, 0xcd, 0x56, 0xfd, 0xd5, 0x7a,
-	0xe3, 0x6e, 0x5d, 0x3c, 0x87, 0x56, 0x60, 0xa9, 0x5c, 0x6b, 0xe9, 0x86, 0xa2, 0x99, 0xbb, 0x8d,
-	0x8a, 0xba, 0x73, 0xcf, 0x2c, 0xa9, 0xf5, 0x8a, 0x5a, 0xaf, 0xea, 0x62, 0x17, 0xe5, 0x21, 0x17,
-	0x30, 0xab, 0x8a, 0x11, 0x71, 0x30, 0x5a, 0x81, 0x45, 0x9e, 0xd3, 0x2c, 0x96, 0x6f, 0x57, 0xcc,
-	0x5a, 0xa3, 0xaa, 0x8b, 0xbf, 0x14, 0xd0, 0x32, 0x2c, 0x04, 0xcc, 0x62, 0xcb, 0xb8, 0x6d, 0x16,
-	0xcb, 0x86, 0x7a, 0xa7, 0x68, 0x28, 0xe2, 0x7d, 0xde, 0x1c, 0x65, 0x55, 0x94, 0x90, 0xb9, 0x3f,
-	0xc4, 0x24, 0x9a, 0xcb, 0x8d, 0xfa, 0x8e, 0x5a, 0x15, 0x0f, 0x86, 0x98, 0x7a, 0xc4, 0xb4, 0xd0,
-	0x06, 0x5c, 0x1a, 0x92, 0xd4, 0x1a, 0xa5, 0x86, 0x61, 0x1a, 0x8d, 0x57, 0x95, 0xba, 0xf8, 0x23,
-	0x01, 0x5d, 0x81, 0x8d, 0x18, 0x84, 0xcd, 0xb6, 0xaa, 0x35, 0x5a, 0x4d, 0x73, 0x57, 0xd9, 0x2d,
-	0x29, 0x9a, 0x2e, 0x1e, 0xa5, 0xfa, 0x40, 0x31, 0xba, 0xd8, 0x43, 0xeb, 0x29, 0x66, 0x7c, 0x05,
-	0x2d, 0x9d, 0x88, 0xdb, 0xa8, 0x00, 0x2b, 0x31, 0x84, 0xf2, 0x9a, 0xa1, 0x15, 0xcb, 0xcc, 0x0d,
-	0x5d, 0xec, 0xa3, 0x35, 0x90, 0x62, 0x00, 0x4d, 0xd1, 0x8d, 0x86, 0xa6, 0x30, 0x3f, 0x5f, 0x47,
-	0x5b, 0x70, 0x63, 0xc8, 0x44, 0xb4, 0x70, 0xba, 0xb9, 0xd3, 0xd0, 0xcc, 0xa6, 0xa6, 0xd6, 0xcb,
-	0x6a, 0xb3, 0x58, 0x13, 0x7f, 0x22, 0xa0, 0xab, 0x20, 0x27, 0x22, 0x5a, 0x53, 0x0c, 0xc5, 0x54,
-	0x5e, 0x6b, 0xaa, 0x9a, 0x52, 0x09, 0x0c, 0xff, 0x58, 0x40, 0x4f, 0x41, 0x21, 0x61, 0xf9, 0x4e,
-	0xe3, 0x55, 0x85, 0x7a, 0x1e, 0xa0, 0x7e, 0x2a, 0xa0, 0xcb, 0xb0, 0x16, 0x47, 0x35, 0x8c, 0xa2,
-	0xa1, 0x98, 0x5a, 0x23, 0x8c, 0xe5, 0x2f, 0x04, 0x7e, 0x96, 0x4a, 0xdd, 0x50, 0xb4, 0xa6, 0xa6,
-	0xea, 0x4a, 0xb4, 0xcc, 0x0e, 0x1f, 0x28, 0x0e, 0x70, 0x5b, 0x29, 0x6a, 0x46, 0x49, 0x29, 0x1a,
-	0xa2, 0x3b, 0x42, 0x85, 0xbf, 0xe2, 0x15, 0x45, 0xf4, 0xd0, 0x06, 0xac, 0xa6, 0x00, 0xb8, 0x7c,
-	0x19, 0xf0, 0x3a, 0xd4, 0x8a, 0x52, 0x37, 0x54, 0xe3, 0x1e, 0x9f, 0x16, 0xc7, 0xa9, 0x00, 0x2e,
-	0xa9, 0xbe, 0x9c, 0x0a, 0x28, 0x6b, 0x0a, 0x99, 0xb1, 0x5a, 0x69, 0x8a, 0x0f, 0x53, 0x01, 0xad,
-	0x66, 0x25, 0x00, 0x9c, 0xf0, 0xeb, 0x19, 0x02, 0x6a, 0xaa, 0x6e, 0x10, 0xb6, 0x2e, 0xbe, 0x81,
-	0x2e, 0x41, 0x3e, 0xd5, 0x05, 0x22, 0xfd, 0x95, 0x54, 0xf5, 0x6c, 0x01, 0x09, 0xe0, 0xab, 0xe8,
-	0x2a, 0x5c, 0x1e, 0xe5, 0x20, 0x29, 0x0c, 0xcc, 0x72, 0x4d, 0x55, 0xea, 0x86, 0xf8, 0x66, 0x2a,
-	0x90, 0x39, 0xca, 0x03, 0xbf, 0x86, 0x9e, 0x8e, 0xf2, 0x25, 0xee, 0x30, 0x07, 0xd3, 0xc5, 0xaf,
-	0xa3, 0x2b, 0xb0, 0x9e, 0xea, 0x38, 0xaf, 0xed, 0x1b, 0x02, 0xba, 0x96, 0x62, 0x97, 0xcd, 0x80,
-	0x47, 0xbe, 0x25, 0xa0, 0x25, 0x40, 0x01, 0xb2, 0xa2, 0x94, 0x5a, 0x55, 0xb3, 0xd2, 0xda, 0x6d,
-	0x8a, 0xdf, 0x12, 0xd0, 0x6a, 0x14, 0xa2, 0x9a, 0x5a, 0x56, 0xea, 0x7c, 0x2a, 0x7d, 0x3b, 0x95,
-	0x1d, 0xa6, 0xc9, 0x77, 0x04, 0xb4, 0x1e, 0x85, 0x30, 0x94, 0xae, 0x54, 0x4c, 0x46, 0x13, 0xbf,
-	0x1b, 0x4b, 0xe9, 0x00, 0xc1, 0x22, 0x13, 0x80, 0xbe, 0x97, 0x0a, 0x62, 0xd3, 0x08, 0x40, 0xdf,
-	0x17, 0x90, 0x1c, 0xe5, 0x64, 0x00, 0xa2, 0xa1, 0x63, 0x44, 0x5d, 0xfc, 0x81, 0x80, 0xa4, 0xe8,
-	0xf0, 0x63, 0x0b, 0xa5, 0x2b, 0x65, 0x4d, 0x31, 0xc4, 0xb7, 0xc9, 0xc1, 0x98, 0x8b, 0xe4, 0x75,
-	0x83, 0x71, 0x74, 0xf1, 0x1d, 0x01, 0x21, 0x98, 0xf1, 0x47, 0xcc, 0xac, 0xf8, 0x33, 0x01, 0xcd,
-	0xc3, 0x2c, 0xa3, 0xa9, 0x75, 0xbd, 0xa9, 0x94, 0x0d, 0xf1, 0xe7, 0x89, 0x30, 0x52, 0x07, 0x8b,
-	0xb5, 0x9a, 0xf8, 0x43, 0x01, 0xcd, 0x42, 0x56, 0x53, 0x9a, 0x0d, 0x53, 0x53, 0x8a, 0x15, 0xf1,
-	0x5d, 0x01, 0xcd, 0x01, 0xd0, 0xf1, 0x5d, 0x4d, 0x35, 0x14, 0xf1, 0x77, 0xd4, 0x3a, 0x25, 0x24,
-	0xcf, 0xf9, 0xdf, 0x0b, 0x48, 0x84, 0x29, 0xca, 0x62, 0xb6, 0xff, 0x20, 0xa0, 0x3c, 0xcc, 0x53,
-	0x0a, 0xb3, 0x6c, 0x96, 0x1b, 0xbb, 0xbb, 0xaa, 0x21, 0xfe, 0x51, 0x40, 0x0b, 0x20, 0x52, 0x8e,
-	0x3f, 0x73, 0x9f, 0xfc, 0x27, 0xea, 0x17, 0xa7, 0x22, 0x60, 0xfc, 0x39, 0x62, 0xb0, 0x68, 0x94,
-	0xb4, 0x62, 0xbd, 0x7c, 0x5b, 0xfc, 0x4b, 0x42, 0x11, 0x23, 0xbf, 0x37, 0xa4, 0x88, 0x31, 0xfe,
-	0x2a, 0xa0, 0x45, 0xb8, 0x18, 0x73, 0x69, 0x47, 0xad, 0x29, 0xe2, 0xdf, 0x68, 0x98, 0x22, 0x3d,
-	0x94, 0xf8, 0x77, 0x9a, 0x35, 0x94, 0x48, 0x72, 0xa1, 0xa9, 0x36, 0x95, 0x9a, 0x5a, 0x57, 0x68,
-	0x68, 0x14, 0x4d, 0xfc, 0x07, 0xcd, 0x1a, 0x16, 0xac, 0xdd, 0xc6, 0x1d, 0x65, 0x08, 0xf1, 0xcf,
-	0x11, 0x0a, 0x68, 0x2c, 0x35, 0xf1, 0x5f, 0xd4, 0x99, 0x90, 0x4a, 0x0d, 0xbf, 0xd2, 0x28, 0x89,
-	0xbf, 0x1e, 0xbb, 0xd1, 0x80, 0x69, 0xfe, 0x2e, 0x4f, 0xde, 0x85, 0x9a, 0xa2, 0x37, 0x5a, 0x5a,
-	0x59, 0x31, 0x8d, 0x7b, 0x4d, 0x85, 0x7b, 0xf5, 0x4e, 0xc1, 0x64, 0x90, 0x5b, 0x02, 0xca, 0xc0,
-	0x79, 0x62, 0x4e, 0x1c, 0x43, 0x33, 0x90, 0x25, 0xf3, 0x33, 0xe9, 0x70, 0x7c, 0xfb, 0x7f, 0x22,
-	0x8c, 0x17, 0x9b, 0x2a, 0x2a, 0x42, 0x26, 0xf8, 0x04, 0x81, 0xf2, 0x61, 0xe1, 0x92, 0xf8, 0x8e,
-	0x21, 0x2d, 0xa7, 0x70, 0x58, 0x55, 0x71, 0x0e, 0x55, 0x01, 0xa2, 0xaf, 0x0f, 0x48, 0x0a, 0xa1,
-	0x43, 0xdf, 0x29, 0xa4, 0x95, 0x54, 0x5e, 0xa8, 0xe8, 0x1e, 0xad, 0xfc, 0x62, 0x2d, 0x61, 0xb4,
-	0x1e, 0xf5, 0x65, 0xd2, 0x7b, 0xd0, 0xd2, 0xc6, 0x19, 0x08, 0x5e, 0xb5, 0x3e, 0x5a, 0xb5, 0xfe,
-	0x58, 0xd5, 0xfa, 0x68, 0xd5, 0xbb, 0x30, 0xcd, 0xf7, 0x65, 0xd1, 0xa5, 0x28, 0x56, 0xc3, 0xed,
-	0x60, 0x69, 0x75, 0x04, 0x37, 0x54, 0x57, 0x81, 0x6c, 0xd8, 0x1b, 0x41, 0xcb, 0x31, 0x34, 0xdf,
-	0xaa, 0x91, 0xa4, 0x34, 0x56, 0xa8, 0x45, 0x87, 0xd9, 0xf8, 0x95, 0x1f, 0xad, 0xf1, 0x61, 0x1a,
-	0xee, 0x62, 0x48, 0x85, 0x91, 0xfc, 0x50, 0xe9, 0x03, 0x90, 0x46, 0x77, 0x2e, 0xd0, 0x8d, 0x11,
-	0x0a, 0x52, 0xee, 0x15, 0x4f, 0x62, 0xec, 0x65, 0x98, 0xf0, 0xbb, 0xd4, 0x68, 0x31, 0x04, 0xc7,
-	0x1a, 0xd9, 0xd2, 0xd2, 0x10, 0x3d, 0x14, 0x3e, 0x08, 0xaf, 0xfb, 0xf1, 0x56, 0x30, 0xba, 0xc2,
-	0x1b, 0x1e, 0xd9, 0x7f, 0x96, 0x9e, 0x7e, 0x1c, 0x2c, 0xb4, 0xf4, 0x79, 0xb8, 0x38, 0xd4, 0x75,
-	0x40, 0x51, 0xde, 0x8c, 0x6a, 0x88, 0x48, 0xf2, 0x59, 0x90, 0xc4, 0x32, 0xf2, 0xaa, 0xd7, 0x92,
-	0x9e, 0x25, 0xf4, 0x16, 0x46, 0xf2, 0xf9, 0x84, 0xe5, 0x1b, 0x00, 0x5c, 0xc2, 0xa6, 0xb4, 0x0b,
-	0xb8, 0x84, 0x4d, 0xeb, 0x1a, 0xc8, 0xe7, 0x50, 0x13, 0x66, 0x62, 0xb7, 0x75, 0xb4, 0x1a, 0x77,
-	0x21, 0xd1, 0x0e, 0x90, 0xd6, 0x46, 0xb1, 0x43, 0x8d, 0x77, 0x60, 0x2e, 0x71, 0x97, 0x41, 0x05,
-	0xae, 0x29, 0x93, 0x76, 0xd5, 0x97, 0xd6, 0x47, 0x03, 0x42, 0xbd, 0xbd, 0xa1, 0x8b, 0x7f, 0x70,
-	0x47, 0x42, 0x57, 0x47, 0x89, 0x27, 0xee, 0x60, 0xd2, 0xb5, 0xc7, 0x03, 0x13, 0x87, 0x4e, 0xec,
-	0xfa, 0x1f, 0x3f, 0x74, 0xd2, 0x1a, 0x0d, 0xf1, 0x43, 0x27, 0xbd, 0x77, 0x40, 0x83, 0x1e, 0xbb,
-	0xe5, 0x73, 0x41, 0x4f, 0xeb, 0x2a, 0x70, 0x41, 0x4f, 0x6f, 0x0e, 0xd0, 0x73, 0x27, 0xbc, 0xcc,
-	0x73, 0xe7, 0x4e, 0xb2, 0x65, 0xc0, 0x9d, 0x3b, 0x43, 0x77, 0x7f, 0xba, 0x1d, 0x16, 0x52, 0x1b,
-	0x0a, 0xf1, 0x8d, 0x37, 0xb2, 0xe1, 0xf0, 0x18, 0xed, 0x45, 0xc8, 0x04, 0xad, 0x01, 0xee, 0x65,
-	0x95, 0x68, 0x2b, 0x48, 0xcb, 0x29, 0x1c, 0x7e, 0xbf, 0x0e, 0xf5, 0x03, 0xb8, 0xfd, 0x3a, 0xaa,
-	0x8f, 0xc0, 0xed, 0xd7, 0x91, 0xed, 0x04, 0x7f, 0xc5, 0x93, 0xf7, 0x7b, 0xc4, 0x67, 0x66, 0x6a,
-	0xff, 0x80, 0x5b, 0xf1, 0x91, 0xcd, 0x01, 0x9a, 0xbc, 0x23, 0xee, 0xe6, 0x5c, 0xf2, 0x9e, 0x7d,
-	0xbf, 0xe7, 0x92, 0xf7, 0x31, 0xd7, 0x7c, 0xb6, 0x09, 0xe3, 0x3f, 0x02, 0xe0, 0x37, 0x61, 0xea,
-	0xef, 0x0a, 0xf8, 0x4d, 0x98, 0xfe, 0xfb, 0x01, 0xf9, 0x5c, 0xe9, 0xe6, 0xbb, 0xa7, 0x6b, 0xc2,
-	0x7b, 0xa7, 0x6b, 0xc2, 0xfb, 0xa7, 0x6b, 0xc2, 0xe7, 0x6e, 0xec, 0x5b, 0xde, 0xc1, 0x60, 0x6f,
-	0xb3, 0x63, 0x1f, 0x6d, 0xf5, 0xdb, 0x9d, 0x83, 0x93, 0x2e, 0x76, 0xf8, 0xa7, 0xe3, 0xed, 0x2d,
-	0xd7, 0xe9, 0xd0, 0x5f, 0x69, 0xec, 0x4d, 0xd0, 0xaf, 0x8d, 0xcf, 0xff, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0xec, 0xaa, 0x28, 0xb5, 0xb9, 0x21, 0x00, 0x00,
+	// 3048 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x59, 0x77, 0xdc, 0xc6,
+	0xb1, 0x36, 0x48, 0x71, 0x2b, 0x8a, 0x24, 0xd4, 0xdc, 0x86, 0xe0, 0x32, 0x24, 0x64, 0x59, 0x94,
+	0x7c, 0x45, 0xda, 0xf2, 0xf5, 0xb5, 0x6c, 0xeb, 0xde, 0x73, 0x66, 0x01, 0x47, 0xb0, 0xc9, 0x99,
+	0xb9, 0x00, 0x46, 0xb2, 0xee, 0xb9, 0xe7, 0x20, 0xc3, 0x99, 0x16, 0x89, 0x88, 0x1c, 0x8c, 0x01,
+	0x0c, 0x23, 0x3a, 0x71, 0x9c, 0x7d, 0x4f, 0xec, 0x6c, 0xce, 0xaf, 0x88, 0x5f, 0xf2, 0x27, 0x9c,
+	0xdd, 0x89, 0xb3, 0xbc, 0x31, 0x3e, 0x7c, 0xcd, 0x9b, 0x7f, 0x41, 0x4e, 0x37, 0x1a, 0x40, 0x03,
+	0x03, 0x50, 0x92, 0x7d, 0x9c, 0x17, 0x69, 0xba, 0xea, 0xeb, 0xaa, 0xea, 0xaa, 0xea, 0x42, 0xa1,
+	0x40, 0x98, 0x6a, 0xf6, 0xbc, 0xfd, 0x4d, 0xf2, 0xcf, 0x46, 0xd7, 0xb1, 0x3d, 0x1b, 0x8d, 0x90,
+	0xdf, 0xe6, 0xd1, 0x75, 0x69, 0x66, 0xcf, 0xde, 0xb3, 0x29, 0x6d, 0x93, 0xfc, 0xf2, 0xd9, 0x52,
+	0x7e, 0xcf, 0xb6, 0xf7, 0x0e, 0xf0, 0x26, 0x5d, 0xed, 0xf6, 0xee, 0x6d, 0x7a, 0xd6, 0x21, 0x76,
+	0xbd, 0xe6, 0x61, 0xd7, 0x07, 0xc8, 0xcf, 0xc0, 0x54, 0xa1, 0xe5, 0x59, 0x47, 0x4d, 0x0f, 0x6b,
+	0xf8, 0xf5, 0x1e, 0x76, 0x3d, 0xb4, 0x0c, 0xe0, 0xd8, 0xb6, 0x67, 0x7a, 0xf6, 0x7d, 0xdc, 0xc9,
+	0x09, 0xab, 0xc2, 0xfa, 0x98, 0x36, 0x46, 0x28, 0x06, 0x21, 0xc8, 0xcf, 0x82, 0x18, 0xed, 0x70,
+	0xbb, 0x76, 0xc7, 0xc5, 0x64, 0x4b, 0xb7, 0xd9, 0xda, 0x8f, 0x6f, 0x21, 0x14, 0x7f, 0xcb, 0x34,
+	0x5c, 0x28, 0xe3, 0x66, 0x5c, 0x8d, 0x3c, 0x03, 0x88, 0x27, 0xfa, 0x92, 0xe4, 0x17, 0x60, 0x4e,
+	0xb3, 0x3d, 0x42, 0x09, 0x14, 0x3e, 0xa2, 0x59, 0x37, 0x60, 0xbe, 0x6f, 0x63, 0x64, 0xdd, 0x59,
+	0x3b, 0x3f, 0x1a, 0x00, 0xa8, 0xa9, 0xe5, 0x52, 0xc9, 0xee, 0xdc, 0xb3, 0xf6, 0xd0, 0x1c, 0x0c,
+	0x5b, 0xae, 0xdb, 0xc3, 0x0e, 0x43, 0xb2, 0x15, 0xba, 0x02, 0x63, 0xad, 0x03, 0x0b, 0x77, 0x3c,
+	0xd3, 0x6a, 0xe7, 0x06, 0x08, 0xab, 0x78, 0xfe, 0xf4, 0x24, 0x3f, 0x5a, 0xa2, 0x44, 0xb5, 0xac,
+	0x8d, 0xfa, 0x6c, 0xb5, 0x8d, 0x2e, 0xc2, 0x04, 0x83, 0xba, 0xb8, 0xe5, 0x60, 0x2f, 0x37, 0x48,
+	0x25, 0x9d, 0xf7, 0x89, 0x3a, 0xa5, 0xa1, 0xeb, 0x70, 0xde, 0xc1, 0x6d, 0xcb, 0xc1, 0x2d, 0xcf,
+	0xec, 0x39, 0x56, 0xee, 0x1c, 0x15, 0x39, 0x75, 0x7a, 0x92, 0x1f, 0xd7, 0x18, 0xbd, 0xa1, 0xa9,
+	0xda, 0x78, 0x00, 0x6a, 0x38, 0x16, 0xb1, 0xcd, 0x6d, 0xd9, 0x5d, 0xec, 0xe6, 0x86, 0x56, 0x07,
+	0x89, 0x6d, 0xfe, 0x0a, 0xfd, 0x27, 0xcc, 0x39, 0xf8, 0xf5, 0x9e, 0xe5, 0x60, 0x13, 0x1f, 0x36,
+	0xad, 0x03, 0xf3, 0x08, 0x3b, 0xd6, 0x3d, 0x0b, 0xb7, 0x73, 0xc3, 0xab, 0xc2, 0xfa, 0xa8, 0x36,
+	0xc3, 0xb8, 0x0a, 0x61, 0xde, 0x66, 0x3c, 0x74, 0x05, 0xc4, 0x03, 0xbb, 0xd5, 0x3c, 0xd8, 0xb7,
+	0x5d, 0xcf, 0x64, 0x67, 0x1e, 0xa1, 0xf8, 0xa9, 0x90, 0xae, 0xfa, 0x87, 0xff, 0x6f, 0x58, 0xec,
+	0xb9, 0xd8, 0x31, 0x9b, 0xad, 0x16, 0x76, 0x5d, 0x6b, 0xf7, 0x00, 0xb3, 0x0d, 0x26, 0x01, 0xe5,
+	0x46, 0xe9, 0xf9, 0x72, 0x04, 0x52, 0x08, 0x11, 0xfe, 0xd6, 0x5b, 0xb6, 0xeb, 0xc9, 0x0b, 0x30,
+	0x5f, 0xc1, 0x9e, 0xef, 0xe0, 0x9e, 0xd3, 0xf4, 0x2c, 0x3b, 0x08, 0xab, 0xdc, 0x80, 0x5c, 0x3f,
+	0x8b, 0x05, 0xee, 0x45, 0x98, 0x68, 0xf1, 0x0c, 0x1a, 0x91, 0xf1, 0xeb, 0xd3, 0x1b, 0x2c, 0xe9,
+	0x37, 0xa2, 0xb0, 0x69, 0x71, 0xa4, 0x6c, 0xc0, 0xbc, 0x9e, 0xae, 0xf1, 0xd3, 0x48, 0x95, 0x20,
+	0xa7, 0x67, 0x18, 0x2b, 0xff, 0x53, 0x80, 0x31, 0x9a, 0x50, 0x6a, 0xe7, 0x9e, 0x8d, 0x72, 0x30,
+	0xe2, 0xf6, 0x76, 0x3f, 0x8f, 0x5b, 0x1e, 0x4b, 0xa3, 0x60, 0x89, 0x74, 0x00, 0xfc, 0xa0, 0x6b,
+	0x31, 0xdd, 0x03, 0x54, 0xb7, 0xb4, 0xe1, 0xdf, 0xd3, 0x8d, 0xe0, 0x9e, 0x6e, 0x18, 0xc1, 0x3d,
+	0x2d, 0xce, 0x7f, 0x7c, 0x92, 0x9f, 0x6a, 0xef, 0xbe, 0x24, 0x47, 0xbb, 0xe4, 0x77, 0xfe, 0x91,
+	0x17, 0x34, 0x4e, 0x0c, 0xfa, 0x2f, 0x38, 0xbf, 0xdf, 0x74, 0xf7, 0x71, 0x9b, 0x25, 0x39, 0x4d,
+	0xb8, 0xe2, 0x74, 0xb0, 0x95, 0x12, 0x4d, 0x82, 0x90, 0xb5, 0x71, 0x1f, 0x48, 0x4d, 0x45, 0x2f,
+	0x84, 0x09, 0x75, 0x6e, 0x75, 0x30, 0xe6, 0x04, 0xca, 0xd7, 0x09, 0xaf, 0x08, 0x1f, 0x9f, 0xe4,
+	0x87, 0x89, 0x98, 0x6b, 0x72, 0x90, 0x71, 0xb2, 0x03, 0x10, 0x21, 0xd0, 0x35, 0x18, 0x75, 0xb0,
+	0x6b, 0xf7, 0x9c, 0x16, 0x66, 0xde, 0xbc, 0x10, 0x0a, 0xd2, 0x18, 0x43, 0x0b, 0x21, 0xe8, 0x79,
+	0x18, 0xef, 0x62, 0xe7, 0xd0, 0x72, 0x5d, 0xcb, 0xee, 0xb8, 0xb9, 0x81, 0xd5, 0xc1, 0xf5, 0x49,
+	0x4e, 0x75, 0x3d, 0xe4, 0x69, 0x3c, 0x4e, 0x7e, 0x0b, 0xa6, 0x0b, 0x3d, 0x6f, 0x1f, 0x77, 0x3c,
+	0xab, 0xc5, 0xd5, 0xab, 0xff, 0x00, 0xb0, 0xad, 0x76, 0xcb, 0x74, 0xc9, 0xed, 0xf7, 0xbd, 0x5d,
+	0x9c, 0x38, 0x3d, 0xc9, 0x8f, 0x91, 0x38, 0xea, 0x84, 0xa8, 0x8d, 0x11, 0x00, 0xfd, 0x89, 0x16,
+	0x60, 0xd4, 0x0a, 0xbc, 0x34, 0xe0, 0x47, 0xc6, 0x62, 0xce, 0xc8, 0xc3, 0x78, 0x1b, 0x1f, 0x59,
+	0x2d, 0x6c, 0xb6, 0xec, 0x36, 0x66, 0x97, 0x16, 0x7c, 0x52, 0xc9, 0x6e, 0x63, 0xf9, 0x79, 0x98,
+	0x89, 0x1b, 0xf0, 0x68, 0xe5, 0x6f, 0x0a, 0x26, 0xee, 0xec, 0xdb, 0x85, 0x43, 0x35, 0xc8, 0xf9,
+	0xf7, 0x04, 0x98, 0x0c, 0x28, 0x4c, 0x84, 0x04, 0xa3, 0xe4, 0xf6, 0x74, 0x9a, 0x87, 0xec, 0x08,
+	0x5a, 0xb8, 0xfe, 0x6c, 0x32, 0xe6, 0xe9, 0x30, 0xf2, 0x83, 0x99, 0x91, 0x0f, 0xa3, 0xad, 0xc3,
+	0x52, 0x05, 0x7b, 0x9a, 0x7d, 0x80, 0xdd, 0x2d, 0xdb, 0xe1, 0xe2, 0xc3, 0x42, 0xf0, 0x1c, 0x40,
+	0x14, 0x28, 0x6a, 0x7f, 0x46, 0x3c, 0x39, 0x98, 0x5c, 0x86, 0xe5, 0x0c, 0xa1, 0xcc, 0x27, 0x17,
+	0x61, 0xc8, 0x21, 0xdc, 0x9c, 0x40, 0x2d, 0x9c, 0x88, 0x52, 0xca, 0x3e, 0xc0, 0x9a, 0xcf, 0x93,
+	0x1d, 0x18, 0xa2, 0x22, 0xd0, 0x66, 0x1c, 0xbd, 0x10, 0x43, 0xbb, 0xfe, 0xbf, 0x4a, 0xc7, 0x73,
+	0x8e, 0xd9, 0x4e, 0xe9, 0x06, 0x40, 0x44, 0x44, 0x22, 0x0c, 0xde, 0xc7, 0xc7, 0xcc, 0xf7, 0xe4,
+	0x27, 0x9a, 0x81, 0xa1, 0xa3, 0xe6, 0x41, 0x0f, 0x53, 0x8f, 0x8f, 0x6a, 0xfe, 0xe2, 0xa5, 0x81,
+	0x1b, 0x82, 0xfc, 0xae, 0x00, 0xe3, 0x64, 0x6b, 0xd1, 0xea, 0xb4, 0xad, 0xce, 0x1e, 0x7a, 0x19,
+	0x46, 0x70, 0xc7, 0x73, 0xac, 0x50, 0xf9, 0x5a, 0x4c, 0x39, 0x83, 0x6d, 0x28, 0x3e, 0xc6, 0x37,
+	0x22, 0xd8, 0x21, 0xbd, 0x02, 0xe7, 0x79, 0x46, 0x8a, 0x21, 0x4f, 0xf2, 0x86, 0x8c, 0x5f, 0x9f,
+	0x8c, 0x9f, 0x8c, 0x37, 0x4c, 0x85, 0xd1, 0xe0, 0xba, 0xa1, 0x2b, 0x70, 0xce, 0x3b, 0xee, 0x62,
+	0x16, 0x8d, 0xd9, 0xbe, 0xfb, 0x68, 0x1c, 0x77, 0xb1, 0x46, 0x21, 0x08, 0xc1, 0x39, 0x9a, 0x78,
+	0xfe, 0x7d, 0xa0, 0xbf, 0xe5, 0xaf, 0x09, 0x30, 0xd4, 0x70, 0xb1, 0xe3, 0xa2, 0x97, 0x61, 0x2c,
+	0x48, 0xc5, 0xe0, 0x7c, 0xcb, 0xa1, 0x34, 0x0a, 0xd9, 0x68, 0x04, 0x7c, 0xff, 0x6c, 0x11, 0x5e,
+	0xba, 0x09, 0x93, 0x71, 0xe6, 0x63, 0x39, 0xfa, 0x01, 0x0c, 0x57, 0x1c, 0xbb, 0xd7, 0x75, 0xd1,
+	0x73, 0x30, 0xbc, 0x47, 0x7f, 0x31, 0x0b, 0x16, 0x43, 0x0b, 0x7c, 0x00, 0xfb, 0xcf, 0xd7, 0xcf,
+	0xa0, 0xd2, 0x8b, 0x30, 0xce, 0x91, 0x1f, 0x4b, 0xf3, 0xdb, 0x02, 0x9c, 0x23, 0xee, 0x0d, 0x7d,
+	0x23, 0x44, 0xbe, 0xf9, 0x84, 0xf5, 0x0b, 0xdd, 0x84, 0xc9, 0xa0, 0x04, 0x9a, 0xde, 0x71, 0x70,
+	0xf5, 0x32, 0x63, 0x33, 0xe1, 0x70, 0x2b, 0x57, 0x7e, 0x00, 0x22, 0x29, 0x3e, 0xb6, 0x63, 0xbd,
+	0x11, 0x96, 0xbe, 0x7f, 0x4f, 0xdd, 0xfd, 0x95, 0x00, 0x17, 0x38, 0xd5, 0xec, 0x76, 0xae, 0x00,
+	0x34, 0x03, 0x62, 0x9b, 0x6a, 0x1f, 0xd5, 0x38, 0x0a, 0x7a, 0x16, 0xc6, 0xdc, 0xa6, 0x67, 0xb9,
+	0xb4, 0x0d, 0x39, 0x43, 0x55, 0x84, 0x42, 0xd7, 0x60, 0x84, 0x52, 0x3b, 0x7b, 0xb9, 0xc1, 0xec,
+	0x0d, 0x01, 0x06, 0x2d, 0xc1, 0x58, 0xd7, 0xb1, 0x3a, 0x2d, 0xab, 0xdb, 0x3c, 0xf0, 0xdb, 0x27,
+	0x2d, 0x22, 0xc8, 0x5b, 0x30, 0x5b, 0xc1, 0x5e, 0xb4, 0xcf, 0xfd, 0x64, 0x4e, 0x93, 0xbb, 0xb0,
+	0x16, 0x97, 0x43, 0x8a, 0x55, 0xa0, 0xe5, 0x13, 0x06, 0x22, 0x66, 0xf9, 0x40, 0xd2, 0x72, 0x0c,
+	0x73, 0x49, 0xcb, 0x99, 0xcf, 0x13, 0x01, 0x14, 0x1e, 0x31, 0xf1, 0x66, 0x82, 0xd2, 0x38, 0x40,
+	0xbb, 0x46, 0x7f, 0x21, 0xbf, 0x09, 0xb9, 0x1d, 0xbb, 0x6d, 0xdd, 0x3b, 0xe6, 0x6a, 0xd4, 0x67,
+	0x71, 0x9e, 0x48, 0xfd, 0x20, 0xaf, 0x7e, 0x11, 0x16, 0x52, 0xd4, 0xb3, 0x66, 0xca, 0x0f, 0xde,
+	0xa7, 0x36, 0x4c, 0xbe, 0x05, 0x73, 0x49, 0x39, 0xcc, 0x95, 0x1b, 0x30, 0xb2, 0xeb, 0x93, 0x98,
+	0x9c, 0x99, 0xb4, 0x9a, 0xad, 0x05, 0x20, 0xf9, 0x73, 0x30, 0xae, 0x63, 0xea, 0x4f, 0xda, 0xdf,
+	0xcd, 0xc0, 0x50, 0xc7, 0xee, 0xb4, 0x82, 0xba, 0xe0, 0x2f, 0x08, 0x95, 0xf6, 0xdf, 0xcc, 0x07,
+	0xfe, 0x02, 0x5d, 0x82, 0xc9, 0x96, 0xdd, 0x39, 0xc2, 0x0e, 0xd9, 0x6d, 0x62, 0xc7, 0xa1, 0xad,
+	0xc5, 0xa8, 0x36, 0x11, 0x51, 0x15, 0xc7, 0x91, 0x67, 0x61, 0xba, 0x82, 0x3d, 0xd2, 0xb4, 0x6c,
+	0xdb, 0x7b, 0x56, 0xd8, 0x20, 0xdf, 0x81, 0x99, 0x38, 0x99, 0x1d, 0xe0, 0x0a, 0x8c, 0x1d, 0x10,
+	0x82, 0xd9, 0x73, 0x0e, 0x72, 0x42, 0xf4, 0x3e, 0x42, 0x51, 0x0d, 0x6d, 0x5b, 0x1b, 0xa5, 0xec,
+	0x86, 0x43, 0x03, 0xe0, 0x37, 0x47, 0xcc, 0x2c, 0xba, 0x20, 0x01, 0x60, 0x82, 0xcb, 0xb4, 0xc5,
+	0x89, 0x69, 0x7d, 0x6f, 0x00, 0xa4, 0x34, 0x2e, 0x53, 0xbe, 0xe8, 0x3f, 0x13, 0xfc, 0x46, 0x89,
+	0xeb, 0x57, 0x48, 0x9b, 0x84, 0xfe, 0x07, 0x44, 0xff, 0xfd, 0xa3, 0x45, 0x5b, 0x0d, 0x6a, 0xa0,
+	0xff, 0xc2, 0x34, 0x7d, 0x7a, 0x92, 0x9f, 0xba, 0xcd, 0xf1, 0x88, 0x9d, 0x53, 0x3c, 0x98, 0x98,
+	0x7b, 0x07, 0x16, 0x92, 0xfb, 0xcd, 0x96, 0x7d, 0xd8, 0x3d, 0xc0, 0x1e, 0xeb, 0xca, 0x8a, 0x8b,
+	0xa7, 0x27, 0xf9, 0xf9, 0x84, 0xa0, 0x12, 0x83, 0x68, 0xf3, 0x09, 0x81, 0x01, 0x23, 0xd9, 0xe0,
+	0x9d, 0x4b, 0x36, 0x78, 0xa4, 0x91, 0xa3, 0x2d, 0x12, 0x76, 0x4d, 0xab, 0x93, 0x1b, 0x5a, 0x15,
+	0xd6, 0x07, 0xb5, 0x31, 0x46, 0x51, 0x3b, 0xa4, 0x49, 0xb3, 0x3a, 0x1e, 0x76, 0x8e, 0x9a, 0x07,
+	0xf4, 0xc5, 0x6a, 0x50, 0x0b, 0xd7, 0xb2, 0x47, 0xc3, 0xa4, 0xd9, 0xbb, 0x89, 0xd7, 0x56, 0x9a,
+	0xfc, 0xbb, 0x76, 0xf0, 0x1a, 0xe0, 0x2f, 0xd0, 0x02, 0x0c, 0x7a, 0x9e, 0xef, 0x95, 0xc1, 0xe2,
+	0xc8, 0xe9, 0x49, 0x7e, 0xd0, 0x30, 0xb6, 0x35, 0x42, 0x7b, 0xbc, 0xc6, 0xec, 0x1a, 0xcc, 0x26,
+	0xb4, 0xb2, 0x00, 0xcd, 0xc0, 0x10, 0xdf, 0x8d, 0xfa, 0x0b, 0x79, 0x03, 0xe6, 0x34, 0x7c, 0x64,
+	0xdf, 0xc7, 0xa4, 0x9c, 0x27, 0xcd, 0x4c, 0xc1, 0x2f, 0xc0, 0x7c, 0x1f, 0x9e, 0xdd, 0xd0, 0x1d,
+	0xfa, 0x82, 0xe5, 0x3f, 0x5e, 0xb7, 0x6c, 0x87, 0x3c, 0xe4, 0x03, 0x59, 0x67, 0xf5, 0xb2, 0x73,
+	0xe1, 0x73, 0xdc, 0xaf, 0x45, 0x6c, 0xc5, 0xde, 0xac, 0x12, 0xe2, 0x98, 0xaa, 0xdb, 0x30, 0xe3,
+	0x57, 0x8a, 0x1d, 0x7c, 0xb8, 0x8b, 0x1d, 0x97, 0xb3, 0x99, 0xee, 0x0e, 0x6c, 0xa6, 0x0b, 0xf2,
+	0x94, 0x6f, 0xb6, 0xdb, 0x4c, 0x3c, 0xf9, 0x49, 0x74, 0x3a, 0xf8, 0xd0, 0x3e, 0xc2, 0xac, 0x00,
+	0xb1, 0x95, 0x3c, 0x0f, 0xb3, 0x09, 0xb9, 0x4c, 0x21, 0x02, 0xb1, 0x12, 0x18, 0x13, 0x5c, 0x88,
+	0x9b, 0xb0, 0x14, 0xd2, 0xd2, 0x9e, 0x00, 0xb1, 0x12, 0x28, 0x24, 0x4b, 0xfa, 0xd3, 0x70, 0x81,
+	0x93, 0xc8, 0x62, 0x34, 0x17, 0xeb, 0x69, 0x22, 0x5f, 0x5c, 0x86, 0xa9, 0x0a, 0xf6, 0x68, 0x67,
+	0x75, 0xe6, 0x51, 0xe5, 0x67, 0x40, 0x8c, 0x80, 0x4c, 0xe8, 0x52, 0xb2, 0x5b, 0x1b, 0xe3, 0xda,
+	0x31, 0xe2, 0x66, 0xe5, 0x81, 0xe7, 0x34, 0x5b, 0x5e, 0x18, 0xd1, 0xf0, 0x84, 0x15, 0x58, 0x48,
+	0xe1, 0x31, 0xb1, 0x57, 0x61, 0x98, 0xa6, 0x44, 0xd0, 0x7f, 0xa1, 0x78, 0x56, 0x92, 0x9a, 0xa8,
+	0x31, 0x84, 0x5c, 0x22, 0x59, 0xe3, 0x7a, 0xb6, 0xd3, 0x9f, 0x66, 0xeb, 0x7c, 0x9a, 0xa5, 0x4b,
+	0x61, 0xa9, 0x27, 0x41, 0xae, 0x5f, 0x08, 0x8b, 0xcf, 0x4d, 0x58, 0x49, 0xa4, 0xe5, 0x63, 0xa4,
+	0xa0, 0xbc, 0x06, 0xf9, 0xcc, 0xdd, 0x4c, 0xc1, 0x2a, 0xac, 0x94, 0x31, 0x29, 0x19, 0x0a, 0xbd,
+	0xfb, 0xed, 0x7e, 0x67, 0xad, 0x41, 0x3e, 0x13, 0xe1, 0x0b, 0xb9, 0xfa, 0xe1, 0x14, 0x40, 0xf4,
+	0x44, 0x46, 0x73, 0x80, 0xea, 0x8a, 0xb6, 0xa3, 0xea, 0xba, 0x5a, 0xab, 0x9a, 0x8d, 0xea, 0xab,
+	0xd5, 0xda, 0x9d, 0xaa, 0xf8, 0x04, 0x5a, 0x84, 0xf9, 0xd2, 0x76, 0x43, 0x37, 0x14, 0xcd, 0xdc,
+	0xa9, 0x95, 0xd5, 0xad, 0xbb, 0x66, 0x51, 0xad, 0x96, 0xd5, 0x6a, 0x45, 0x17, 0xdb, 0x28, 0x07,
+	0x33, 0x01, 0xb3, 0xa2, 0x18, 0x11, 0x87, 0x54, 0xe0, 0x39, 0x9e, 0x53, 0x2f, 0x94, 0x6e, 0x95,
+	0xcd, 0xed, 0x5a, 0x45, 0x17, 0x7f, 0x26, 0xa0, 0x05, 0x98, 0x0d, 0x98, 0x85, 0x86, 0x71, 0xcb,
+	0x2c, 0x94, 0x0c, 0xf5, 0x76, 0xc1, 0x50, 0xc4, 0x7b, 0xbc, 0x3a, 0xca, 0x2a, 0x2b, 0x21, 0x73,
+	0xaf, 0x8f, 0x49, 0x24, 0x97, 0x6a, 0xd5, 0x2d, 0xb5, 0x22, 0xee, 0xf7, 0x31, 0xf5, 0x88, 0x69,
+	0xa1, 0x35, 0x58, 0xea, 0xdb, 0xa9, 0xd5, 0x8a, 0x35, 0xc3, 0x34, 0x6a, 0xaf, 0x2a, 0x55, 0xf1,
+	0xfb, 0x02, 0xba, 0x04, 0x6b, 0x31, 0x08, 0x3b, 0x6d, 0x45, 0xab, 0x35, 0xea, 0xe6, 0x8e, 0xb2,
+	0x53, 0x54, 0x34, 0x5d, 0x3c, 0x4c, 0xb5, 0x81, 0x62, 0x74, 0xb1, 0x83, 0x56, 0x61, 0x29, 0x9d,
+	0x69, 0x36, 0x74, 0xb2, 0xdd, 0x46, 0x79, 0x58, 0x8c, 0x21, 0x94, 0xd7, 0x0c, 0xad, 0x50, 0x62,
+	0x66, 0xe8, 0x62, 0x17, 0xad, 0x80, 0x14, 0x03, 0x68, 0x8a, 0x6e, 0xd4, 0x34, 0x85, 0xd9, 0xf9,
+	0x3a, 0xda, 0x84, 0xab, 0x7d, 0x2a, 0xa2, 0xc0, 0xe9, 0xe6, 0x56, 0x4d, 0x33, 0xeb, 0x9a, 0x5a,
+	0x2d, 0xa9, 0xf5, 0xc2, 0xb6, 0xf8, 0x43, 0x01, 0x5d, 0x06, 0x39, 0xe1, 0xd1, 0x6d, 0xc5, 0x50,
+	0x4c, 0xe5, 0xb5, 0xba, 0xaa, 0x29, 0xe5, 0x40, 0xf1, 0x0f, 0x04, 0xf4, 0x24, 0xe4, 0x13, 0x9a,
+	0x6f, 0xd7, 0x5e, 0x55, 0xa8, 0xe5, 0x01, 0xea, 0x47, 0x02, 0xba, 0x08, 0x2b, 0x71, 0x54, 0xcd,
+	0x28, 0x18, 0x8a, 0xa9, 0xd5, 0x42, 0x5f, 0xfe, 0x54, 0xe0, 0x4f, 0xa9, 0x54, 0x0d, 0x45, 0xab,
+	0x6b, 0xaa, 0xae, 0x44, 0x61, 0x76, 0x78, 0x47, 0x71, 0x80, 0x5b, 0x4a, 0x41, 0x33, 0x8a, 0x4a,
+	0xc1, 0x10, 0xdd, 0x0c, 0x11, 0x7e, 0xc4, 0xcb, 0x8a, 0xe8, 0xa1, 0x35, 0x58, 0x4e, 0x01, 0x70,
+	0xf9, 0xd2, 0xe3, 0x65, 0xa8, 0x65, 0xa5, 0x6a, 0xa8, 0xc6, 0x5d, 0x3e, 0x2d, 0x8e, 0x52, 0x01,
+	0x5c, 0x52, 0x7d, 0x21, 0x15, 0x50, 0xd2, 0x14, 0x72, 0x62, 0xb5, 0x5c, 0x17, 0x1f, 0xa4, 0x02,
+	0x1a, 0xf5, 0x72, 0x00, 0x38, 0xe6, 0xe3, 0x19, 0x02, 0xb6, 0x55, 0xdd, 0x20, 0x6c, 0x5d, 0x7c,
+	0x03, 0x2d, 0x41, 0x2e, 0xd5, 0x04, 0xb2, 0xfb, 0x8b, 0xa9, 0xe2, 0x59, 0x00, 0x09, 0xe0, 0x4b,
+	0xe8, 0x32, 0x5c, 0xcc, 0x32, 0x90, 0x34, 0x47, 0x66, 0x69, 0x5b, 0x55, 0xaa, 0x86, 0xf8, 0x66,
+	0x2a, 0x90, 0x19, 0xca, 0x03, 0xbf, 0x8c, 0x9e, 0x02, 0xb9, 0x0f, 0x48, 0x0d, 0xe6, 0x60, 0xba,
+	0xf8, 0x16, 0xba, 0x04, 0xab, 0xa9, 0x86, 0xf3, 0xd2, 0xbe, 0x22, 0xa0, 0x75, 0xb8, 0x98, 0x75,
+	0x02, 0x1e, 0xf9, 0x55, 0x01, 0xcd, 0x03, 0x0a, 0x90, 0x65, 0xa5, 0xd8, 0xa8, 0x98, 0xe5, 0xc6,
+	0x4e, 0x5d, 0xfc, 0xba, 0x80, 0x96, 0x23, 0x17, 0x6d, 0xab, 0x25, 0xa5, 0xca, 0xa7, 0xd2, 0x37,
+	0x52, 0xd9, 0x61, 0x9a, 0x7c, 0x53, 0x40, 0xab, 0xb0, 0x98, 0x64, 0x17, 0xca, 0x65, 0x93, 0xd1,
+	0xc4, 0x6f, 0xc5, 0x52, 0x3a, 0x40, 0x30, 0xcf, 0x04, 0xa0, 0x6f, 0xa7, 0x82, 0xd8, 0x31, 0x02,
+	0xd0, 0x77, 0x04, 0x24, 0xc3, 0x72, 0x12, 0x44, 0x5d, 0xc7, 0x88, 0xba, 0xf8, 0x5d, 0x01, 0x49,
+	0x51, 0xf1, 0x63, 0x81, 0xd2, 0x95, 0x92, 0xa6, 0x18, 0xe2, 0xdb, 0xa4, 0x30, 0xce, 0x44, 0xfb,
+	0x75, 0x83, 0x71, 0x74, 0xf1, 0x1d, 0x01, 0x21, 0x98, 0xf0, 0x57, 0x4c, 0xad, 0xf8, 0x63, 0x01,
+	0x4d, 0xc3, 0x24, 0xa3, 0xa9, 0x55, 0xbd, 0xae, 0x94, 0x0c, 0xf1, 0x27, 0x09, 0x37, 0x52, 0x03,
+	0x0b, 0xdb, 0xdb, 0xe2, 0xf7, 0x04, 0xbe, 0x24, 0x93, 0x4b, 0xa0, 0x29, 0xf5, 0x9a, 0xf9, 0xbf,
+	0x8d, 0x9a, 0x51, 0x10, 0x7f, 0x4e, 0x6e, 0x6c, 0x98, 0xa6, 0x4a, 0x59, 0x35, 0xcc, 0x6a, 0xcd,
+	0x50, 0xb7, 0xd4, 0x52, 0xc1, 0x20, 0x45, 0x45, 0x7c, 0x37, 0x06, 0xa0, 0xa6, 0xc5, 0x01, 0xbf,
+	0x10, 0xd0, 0x24, 0x8c, 0x51, 0x91, 0x9a, 0x52, 0x28, 0x8b, 0xef, 0x0b, 0x68, 0x0a, 0x80, 0xae,
+	0xef, 0x68, 0xaa, 0xa1, 0x88, 0xbf, 0xa6, 0x87, 0xa3, 0x84, 0xe4, 0x63, 0xe4, 0x37, 0x02, 0x12,
+	0x61, 0x9c, 0xb2, 0xd8, 0xd1, 0x7e, 0x2b, 0xa0, 0x1c, 0x4c, 0x53, 0x0a, 0x3b, 0x98, 0x59, 0xaa,
+	0xed, 0xec, 0xa8, 0x86, 0xf8, 0x3b, 0x01, 0xcd, 0x82, 0x48, 0x39, 0xbe, 0x63, 0x7d, 0xf2, 0xef,
+	0xe9, 0xb1, 0x39, 0x11, 0x01, 0xe3, 0x0f, 0x11, 0x83, 0x39, 0xbb, 0xa8, 0x15, 0xaa, 0xa5, 0x5b,
+	0xe2, 0x1f, 0x13, 0x82, 0x18, 0xf9, 0x83, 0x3e, 0x41, 0x8c, 0xf1, 0x27, 0x01, 0xcd, 0xc1, 0x85,
+	0x98, 0x49, 0x5b, 0xea, 0xb6, 0x22, 0xfe, 0x99, 0x46, 0x21, 0x92, 0x43, 0x89, 0x1f, 0xd2, 0xa4,
+	0xa4, 0x44, 0x92, 0x6a, 0x75, 0xb5, 0xae, 0x6c, 0xab, 0x55, 0x85, 0xba, 0x46, 0xd1, 0xc4, 0xbf,
+	0xd0, 0xa4, 0x64, 0xce, 0xda, 0xa9, 0xdd, 0x56, 0xfa, 0x10, 0x7f, 0xcd, 0x10, 0x40, 0x7d, 0xa9,
+	0x89, 0x7f, 0xa3, 0xc6, 0x84, 0x54, 0xaa, 0xf8, 0x95, 0x5a, 0x51, 0xfc, 0xe5, 0xc0, 0xd5, 0x1a,
+	0x9c, 0xe7, 0xa7, 0x34, 0xe4, 0x51, 0xab, 0x29, 0x7a, 0xad, 0xa1, 0x95, 0x14, 0xd3, 0xb8, 0x5b,
+	0x57, 0xb8, 0x27, 0xfb, 0x38, 0x8c, 0x04, 0xa9, 0x2b, 0xa0, 0x51, 0x38, 0x47, 0xd4, 0x89, 0x03,
+	0x68, 0x02, 0xc6, 0xc8, 0xf9, 0x68, 0x7e, 0x88, 0x83, 0xd7, 0xff, 0x7e, 0x01, 0x06, 0x0b, 0x75,
+	0x15, 0x15, 0x60, 0x34, 0xf8, 0xae, 0x86, 0x72, 0x61, 0x5f, 0x94, 0xf8, 0x38, 0x27, 0x2d, 0xa4,
+	0x70, 0x58, 0xd3, 0xf2, 0x04, 0xaa, 0x00, 0x44, 0x9f, 0xd4, 0x90, 0x14, 0x42, 0xfb, 0x3e, 0xbe,
+	0x49, 0x8b, 0xa9, 0xbc, 0x50, 0xd0, 0x5d, 0xda, 0x58, 0xc6, 0xbe, 0x73, 0xa0, 0xd5, 0x70, 0x4b,
+	0xc6, 0xa7, 0x1c, 0x69, 0xed, 0x0c, 0x04, 0x2f, 0x5a, 0xcf, 0x16, 0xad, 0x3f, 0x54, 0xb4, 0x9e,
+	0x2d, 0x7a, 0x07, 0xce, 0xf3, 0xe3, 0x79, 0xb4, 0x14, 0xf9, 0xaa, 0xff, 0xb3, 0x81, 0xb4, 0x9c,
+	0xc1, 0x0d, 0xc5, 0x95, 0x61, 0x2c, 0x9c, 0x7a, 0xa1, 0x85, 0x18, 0x9a, 0x1f, 0xc2, 0x49, 0x52,
+	0x1a, 0x2b, 0x94, 0xa2, 0xc3, 0x64, 0x7c, 0x98, 0x83, 0x56, 0x78, 0x37, 0xf5, 0xcf, 0xa7, 0xa4,
+	0x7c, 0x26, 0x3f, 0x14, 0x7a, 0x1f, 0xa4, 0x38, 0x8f, 0x7f, 0x23, 0x41, 0x57, 0x33, 0x04, 0xa4,
+	0xbc, 0xb6, 0x3c, 0x8a, 0xb2, 0x97, 0x61, 0xd8, 0xff, 0x58, 0x81, 0xe6, 0x42, 0x70, 0xec, 0x7b,
+	0x86, 0x34, 0xdf, 0x47, 0x0f, 0x37, 0xef, 0x87, 0x83, 0x9c, 0xf8, 0x90, 0x1f, 0x5d, 0xe2, 0x15,
+	0x67, 0x7e, 0x59, 0x90, 0x9e, 0x7a, 0x18, 0x2c, 0xd4, 0xf4, 0xff, 0x70, 0xa1, 0x6f, 0x9e, 0x84,
+	0xa2, 0xbc, 0xc9, 0x1a, 0x75, 0x49, 0xf2, 0x59, 0x90, 0x44, 0x18, 0x79, 0xd1, 0x2b, 0x49, 0xcb,
+	0x12, 0x72, 0xf3, 0x99, 0x7c, 0x3e, 0x61, 0xf9, 0xd1, 0x0e, 0x97, 0xb0, 0x29, 0x83, 0x20, 0x69,
+	0x39, 0x83, 0x1b, 0x8a, 0x33, 0x01, 0xf5, 0x8f, 0x6c, 0x90, 0x9c, 0xdc, 0xd6, 0x3f, 0xed, 0x91,
+	0x2e, 0x9e, 0x89, 0x09, 0x15, 0xd4, 0x61, 0x22, 0x36, 0x6d, 0x40, 0xcb, 0xf1, 0x33, 0x26, 0x66,
+	0x1f, 0xd2, 0x4a, 0x16, 0x3b, 0x94, 0x78, 0x1b, 0xa6, 0x12, 0xef, 0x62, 0x28, 0xcf, 0xcd, 0xf3,
+	0xd2, 0x46, 0x15, 0xd2, 0x6a, 0x36, 0x20, 0x94, 0xdb, 0xe9, 0x1b, 0x5c, 0x04, 0xef, 0x78, 0xe8,
+	0x72, 0xd6, 0xf6, 0xc4, 0x3b, 0xa4, 0xb4, 0xfe, 0x70, 0x60, 0xa2, 0xaa, 0xc5, 0xc6, 0x17, 0xf1,
+	0xaa, 0x96, 0x36, 0x28, 0x91, 0xd6, 0xce, 0x40, 0xf0, 0x4e, 0x8f, 0x4d, 0x29, 0x38, 0xa7, 0xa7,
+	0x4d, 0x45, 0xa4, 0x95, 0x2c, 0x36, 0x5f, 0xd8, 0xc2, 0x61, 0x04, 0x57, 0xd8, 0x92, 0x23, 0x0f,
+	0x49, 0x4a, 0x63, 0x71, 0xf7, 0x6d, 0x36, 0x75, 0x20, 0x12, 0xbf, 0xd9, 0x99, 0x03, 0x93, 0x87,
+	0x48, 0x2f, 0xc0, 0x68, 0x30, 0xda, 0xe0, 0x9e, 0x86, 0x89, 0xb1, 0x88, 0xb4, 0x90, 0xc2, 0xe1,
+	0x0b, 0x42, 0xdf, 0x3c, 0x83, 0x2b, 0x08, 0x59, 0x73, 0x10, 0x49, 0x3e, 0x0b, 0xc2, 0x47, 0x3c,
+	0x39, 0x9f, 0x40, 0x7c, 0x66, 0xa6, 0xce, 0x3f, 0xa4, 0xb5, 0x33, 0x10, 0x7c, 0xf2, 0x66, 0xcc,
+	0x16, 0xb8, 0xe4, 0x3d, 0x7b, 0x3e, 0x21, 0xad, 0x3f, 0x1c, 0x18, 0xbb, 0x84, 0xf1, 0x3f, 0x9d,
+	0xe1, 0x2f, 0x61, 0xea, 0x5f, 0xe3, 0x48, 0xab, 0xd9, 0x80, 0x40, 0x6e, 0xf1, 0xc6, 0xfb, 0xa7,
+	0x2b, 0xc2, 0x07, 0xa7, 0x2b, 0xc2, 0x47, 0xa7, 0x2b, 0xc2, 0xff, 0x5d, 0xdd, 0xb3, 0xbc, 0xfd,
+	0xde, 0xee, 0x46, 0xcb, 0x3e, 0xdc, 0x24, 0xdf, 0xc6, 0x8f, 0xdb, 0xd8, 0xe1, 0x7f, 0x1d, 0x5d,
+	0xdf, 0x74, 0x9d, 0x16, 0xfd, 0xdb, 0xa6, 0xdd, 0x61, 0xfa, 0x55, 0xfb, 0xb9, 0x7f, 0x0d, 0x00,
+	0x25, 0x5c, 0xa9, 0x35, 0xef, 0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3407,6 +3664,7 @@ type APIClient interface {
 	ModifyRoleBinding(ctx context.Context, in *ModifyRoleBindingRequest, opts ...grpc.CallOption) (*ModifyRoleBindingResponse, error)
 	GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*GetRoleBindingResponse, error)
 	GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error)
+	GetOIDCDeviceLogin(ctx context.Context, in *GetOIDCDeviceLoginRequest, opts ...grpc.CallOption) (*GetOIDCDeviceLoginResponse, error)
 	GetRobotToken(ctx context.Context, in *GetRobotTokenRequest, opts ...grpc.CallOption) (*GetRobotTokenResponse, error)
 	RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error)
 	RevokeAuthTokensForUser(ctx context.Context, in *RevokeAuthTokensForUserRequest, opts ...grpc.CallOption) (*RevokeAuthTokensForUserResponse, error)
@@ -3546,6 +3804,15 @@ func (c *aPIClient) GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) GetOIDCDeviceLogin(ctx context.Context, in *GetOIDCDeviceLoginRequest, opts ...grpc.CallOption) (*GetOIDCDeviceLoginResponse, error) {
+	out := new(GetOIDCDeviceLoginResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetOIDCDeviceLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) GetRobotToken(ctx context.Context, in *GetRobotTokenRequest, opts ...grpc.CallOption) (*GetRobotTokenResponse, error) {
 	out := new(GetRobotTokenResponse)
 	err := c.cc.Invoke(ctx, "/auth_v2.API/GetRobotToken", in, out, opts...)
@@ -3672,6 +3939,7 @@ type APIServer interface {
 	ModifyRoleBinding(context.Context, *ModifyRoleBindingRequest) (*ModifyRoleBindingResponse, error)
 	GetRoleBinding(context.Context, *GetRoleBindingRequest) (*GetRoleBindingResponse, error)
 	GetOIDCLogin(context.Context, *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error)
+	GetOIDCDeviceLogin(context.Context, *GetOIDCDeviceLoginRequest) (*GetOIDCDeviceLoginResponse, error)
 	GetRobotToken(context.Context, *GetRobotTokenRequest) (*GetRobotTokenResponse, error)
 	RevokeAuthToken(context.Context, *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error)
 	RevokeAuthTokensForUser(context.Context, *RevokeAuthTokensForUserRequest) (*RevokeAuthTokensForUserResponse, error)
@@ -3729,6 +3997,9 @@ func (*UnimplementedAPIServer) GetRoleBinding(ctx context.Context, req *GetRoleB
 func (*UnimplementedAPIServer) GetOIDCLogin(ctx context.Context, req *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCLogin not implemented")
 }
+func (*UnimplementedAPIServer) GetOIDCDeviceLogin(ctx context.Context, req *GetOIDCDeviceLoginRequest) (*GetOIDCDeviceLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCDeviceLogin not implemented")
+}
 func (*UnimplementedAPIServer) GetRobotToken(ctx context.Context, req *GetRobotTokenRequest) (*GetRobotTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRobotToken not implemented")
 }
@@ -4004,6 +4275,24 @@ func _API_GetOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetOIDCDeviceLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOIDCDeviceLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetOIDCDeviceLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetOIDCDeviceLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetOIDCDeviceLogin(ctx, req.(*GetOIDCDeviceLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_GetRobotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRobotTokenRequest)
 	if err := dec(in); err != nil {
@@ -4276,6 +4565,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetOIDCLogin",
 			Handler:    _API_GetOIDCLogin_Handler,
 		},
+		{
+			MethodName: "GetOIDCDeviceLogin",
+			Handler:    _API_GetOIDCDeviceLogin_Handler,
+		},
 		{
 			MethodName: "GetRobotToken",
 			Handler:    _API_GetRobotToken_Handler,
@@ -4766,6 +5059,20 @@ func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.HashedToken) > 0 {
 		i -= len(m.HashedToken)
 		copy(dAtA[i:], m.HashedToken)
@@ -4793,6 +5100,63 @@ func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Permissions) > 0 {
+		dAtA5 := make([]byte, len(m.Permissions)*10)
+		var j4 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintAuth(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AuthenticateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4817,6 +5181,13 @@ func (m *AuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DeviceCode) > 0 {
+		i -= len(m.DeviceCode)
+		copy(dAtA[i:], m.DeviceCode)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.DeviceCode)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.IdToken) > 0 {
 		i -= len(m.IdToken)
 		copy(dAtA[i:], m.IdToken)
@@ -4919,13 +5290,27 @@ func (m *WhoAmIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Expiration != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err4 != nil {
-			return 0, err4
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n4
-		i = encodeVarintAuth(dAtA, i, uint64(n4))
+		i -= n7
+		i = encodeVarintAuth(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5276,38 +5661,38 @@ func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ResourceTypes) > 0 {
-		dAtA7 := make([]byte, len(m.ResourceTypes)*10)
-		var j6 int
+		dAtA10 := make([]byte, len(m.ResourceTypes)*10)
+		var j9 int
 		for _, num := range m.ResourceTypes {
 			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j6++
+				j9++
 			}
-			dAtA7[j6] = uint8(num)
-			j6++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintAuth(dAtA, i, uint64(j6))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintAuth(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Permissions) > 0 {
-		dAtA9 := make([]byte, len(m.Permissions)*10)
-		var j8 int
+		dAtA12 := make([]byte, len(m.Permissions)*10)
+		var j11 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j11++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintAuth(dAtA, i, uint64(j8))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintAuth(dAtA, i, uint64(j11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5346,20 +5731,20 @@ func (m *AuthorizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Permissions) > 0 {
-		dAtA11 := make([]byte, len(m.Permissions)*10)
-		var j10 int
+		dAtA14 := make([]byte, len(m.Permissions)*10)
+		var j13 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j10++
+				j13++
 			}
-			dAtA11[j10] = uint8(num)
-			j10++
+			dAtA14[j13] = uint8(num)
+			j13++
 		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintAuth(dAtA, i, uint64(j10))
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintAuth(dAtA, i, uint64(j13))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5410,38 +5795,38 @@ func (m *AuthorizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Missing) > 0 {
-		dAtA14 := make([]byte, len(m.Missing)*10)
-		var j13 int
+		dAtA17 := make([]byte, len(m.Missing)*10)
+		var j16 int
 		for _, num := range m.Missing {
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j16++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintAuth(dAtA, i, uint64(j13))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintAuth(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Satisfied) > 0 {
-		dAtA16 := make([]byte, len(m.Satisfied)*10)
-		var j15 int
+		dAtA19 := make([]byte, len(m.Satisfied)*10)
+		var j18 int
 		for _, num := range m.Satisfied {
 			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j15++
+				j18++
 			}
-			dAtA16[j15] = uint8(num)
-			j15++
+			dAtA19[j18] = uint8(num)
+			j18++
 		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintAuth(dAtA, i, uint64(j15))
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintAuth(dAtA, i, uint64(j18))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5577,20 +5962,20 @@ func (m *GetPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		}
 	}
 	if len(m.Permissions) > 0 {
-		dAtA20 := make([]byte, len(m.Permissions)*10)
-		var j19 int
+		dAtA23 := make([]byte, len(m.Permissions)*10)
+		var j22 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j22++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA23[j22] = uint8(num)
+			j22++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintAuth(dAtA, i, uint64(j19))
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintAuth(dAtA, i, uint64(j22))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -5876,6 +6261,98 @@ func (m *GetOIDCLoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetOIDCDeviceLoginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOIDCDeviceLoginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOIDCDeviceLoginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetOIDCDeviceLoginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOIDCDeviceLoginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOIDCDeviceLoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Interval != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiresIn != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ExpiresIn))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DeviceCode) > 0 {
+		i -= len(m.DeviceCode)
+		copy(dAtA[i:], m.DeviceCode)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.DeviceCode)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VerificationURLComplete) > 0 {
+		i -= len(m.VerificationURLComplete)
+		copy(dAtA[i:], m.VerificationURLComplete)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.VerificationURLComplete)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VerificationURL) > 0 {
+		i -= len(m.VerificationURL)
+		copy(dAtA[i:], m.VerificationURL)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.VerificationURL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserCode) > 0 {
+		i -= len(m.UserCode)
+		copy(dAtA[i:], m.UserCode)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.UserCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetRobotTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5900,6 +6377,20 @@ func (m *GetRobotTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.TTL != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.TTL))
 		i--
@@ -6792,6 +7283,35 @@ func (m *TokenInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TokenScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6812,6 +7332,10 @@ func (m *AuthenticateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	l = len(m.DeviceCode)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6860,6 +7384,12 @@ func (m *WhoAmIResponse) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7275,6 +7805,52 @@ func (m *GetOIDCLoginResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetOIDCDeviceLoginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOIDCDeviceLoginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserCode)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.VerificationURL)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.VerificationURLComplete)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.DeviceCode)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.ExpiresIn != 0 {
+		n += 1 + sovAuth(uint64(m.ExpiresIn))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovAuth(uint64(m.Interval))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GetRobotTokenRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7288,6 +7864,12 @@ func (m *GetRobotTokenRequest) Size() (n int) {
 	if m.TTL != 0 {
 		n += 1 + sovAuth(uint64(m.TTL))
 	}
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8730,17 +9312,207 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.HashedToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &TokenScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -8845,6 +9617,38 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.IdToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9098,6 +9902,40 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &TokenScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11708,7 +12546,7 @@ func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *GetOIDCDeviceLoginRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11731,7 +12569,275 @@ func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRobotTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOIDCDeviceLoginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOIDCDeviceLoginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetOIDCDeviceLoginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOIDCDeviceLoginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOIDCDeviceLoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationURLComplete", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationURLComplete = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			m.ExpiresIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRobotTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: GetRobotTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -11788,6 +12894,40 @@ func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &TokenScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
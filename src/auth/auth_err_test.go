@@ -43,6 +43,19 @@ func TestIsErrBadToken(t *testing.T) {
 	require.True(t, IsErrBadToken(grpcify(ErrBadToken)))
 }
 
+func TestIsErrAuthorizationPending(t *testing.T) {
+	require.False(t, IsErrAuthorizationPending(nil))
+	require.True(t, IsErrAuthorizationPending(ErrAuthorizationPending))
+	require.True(t, IsErrAuthorizationPending(grpcify(ErrAuthorizationPending)))
+	require.False(t, IsErrAuthorizationPending(ErrSlowDown))
+}
+
+func TestIsErrSlowDown(t *testing.T) {
+	require.False(t, IsErrSlowDown(nil))
+	require.True(t, IsErrSlowDown(ErrSlowDown))
+	require.True(t, IsErrSlowDown(grpcify(ErrSlowDown)))
+}
+
 func TestIsErrNotAuthorized(t *testing.T) {
 	require.False(t, IsErrNotAuthorized(nil))
 	require.True(t, IsErrNotAuthorized(&ErrNotAuthorized{
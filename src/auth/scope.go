@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// ParseTokenScopes parses token scopes of the form
+// "repo:<name>:<permissions>" or "cluster:<permissions>", where permissions
+// is a comma separated list. Each permission is either the name of a
+// Permission (e.g. "REPO_WRITE") or the name without the resource type's
+// prefix (e.g. "write" in a repo scope). Scopes on the same resource are
+// merged.
+func ParseTokenScopes(ss []string) ([]*TokenScope, error) {
+	var scopes []*TokenScope
+	byResource := make(map[string]*TokenScope)
+	for _, s := range ss {
+		var resource Resource
+		var permissions string
+		parts := strings.Split(s, ":")
+		switch {
+		case len(parts) == 2 && strings.EqualFold(parts[0], "cluster"):
+			resource.Type = ResourceType_CLUSTER
+			permissions = parts[1]
+		case len(parts) == 3 && strings.EqualFold(parts[0], "repo") && parts[1] != "":
+			resource.Type = ResourceType_REPO
+			resource.Name = parts[1]
+			permissions = parts[2]
+		default:
+			return nil, errors.Errorf("invalid token scope %q, must be of the form \"repo:<name>:<permissions>\" or \"cluster:<permissions>\"", s)
+		}
+		key := resource.Type.String() + ":" + resource.Name
+		scope, ok := byResource[key]
+		if !ok {
+			scope = &TokenScope{Resource: &resource}
+			byResource[key] = scope
+			scopes = append(scopes, scope)
+		}
+		for _, p := range strings.Split(permissions, ",") {
+			permission, err := parseScopePermission(resource.Type, p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid token scope %q", s)
+			}
+			scope.Permissions = append(scope.Permissions, permission)
+		}
+	}
+	return scopes, nil
+}
+
+func parseScopePermission(resourceType ResourceType, s string) (Permission, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if p, ok := Permission_value[name]; ok && p != int32(Permission_PERMISSION_UNKNOWN) {
+		return Permission(p), nil
+	}
+	if p, ok := Permission_value[resourceType.String()+"_"+name]; ok {
+		return Permission(p), nil
+	}
+	return Permission_PERMISSION_UNKNOWN, errors.Errorf("unknown permission %q", s)
+}
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestParseTokenScopes(t *testing.T) {
+	scopes, err := ParseTokenScopes([]string{"repo:images:write", "cluster:auth_get_groups", "repo:images:read,repo_list_commit"})
+	require.NoError(t, err)
+	require.Equal(t, []*TokenScope{
+		{
+			Resource:    &Resource{Type: ResourceType_REPO, Name: "images"},
+			Permissions: []Permission{Permission_REPO_WRITE, Permission_REPO_READ, Permission_REPO_LIST_COMMIT},
+		},
+		{
+			Resource:    &Resource{Type: ResourceType_CLUSTER},
+			Permissions: []Permission{Permission_CLUSTER_AUTH_GET_GROUPS},
+		},
+	}, scopes)
+
+	for _, s := range []string{"images:write", "repo::write", "repo:images:frobnicate", "cluster:x:CLUSTER_AUTH_GET_GROUPS", "repo:images:"} {
+		_, err := ParseTokenScopes([]string{s})
+		require.YesError(t, err, s)
+	}
+}
@@ -3,7 +3,9 @@ package client
 import (
 	"context"
 	"io"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/internal/clientsdk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
@@ -110,6 +112,72 @@ func (c APIClient) ListRepoByType(repoType string) (_ []*pfs.RepoInfo, retErr er
 	return clientsdk.ListRepoInfo(client)
 }
 
+// ListRepoUsage returns info about Repos of the given type, including the
+// storage used by the heads of their branches.
+// The if repoType is empty, all Repos will be included
+func (c APIClient) ListRepoUsage(repoType string) (_ []*pfs.RepoInfo, retErr error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	request := &pfs.ListRepoRequest{Type: repoType, Usage: true}
+	client, err := c.PfsAPIClient.ListRepo(
+		ctx,
+		request,
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return clientsdk.ListRepoInfo(client)
+}
+
+// SetRepoQuota sets the storage quota of a repo. Commits that would put the
+// repo over its quota finish with an error. Setting both limits to 0 removes
+// the quota.
+func (c APIClient) SetRepoQuota(repoName string, maxBytes, maxFiles int64) error {
+	return c.setRepoQuota(&pfs.RepoQuota{
+		Repo:     NewRepo(repoName),
+		MaxBytes: maxBytes,
+		MaxFiles: maxFiles,
+	})
+}
+
+// SetRepoPrefixQuota sets a storage quota shared by all repos whose names
+// start with 'prefix'. Setting both limits to 0 removes the quota.
+func (c APIClient) SetRepoPrefixQuota(prefix string, maxBytes, maxFiles int64) error {
+	return c.setRepoQuota(&pfs.RepoQuota{
+		Prefix:   prefix,
+		MaxBytes: maxBytes,
+		MaxFiles: maxFiles,
+	})
+}
+
+func (c APIClient) setRepoQuota(quota *pfs.RepoQuota) error {
+	_, err := c.PfsAPIClient.SetRepoQuota(
+		c.Ctx(),
+		&pfs.SetRepoQuotaRequest{Quota: quota},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListRepoQuota returns all of the repo and prefix quotas.
+func (c APIClient) ListRepoQuota() ([]*pfs.RepoQuota, error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PfsAPIClient.ListRepoQuota(ctx, &pfs.ListRepoQuotaRequest{})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	var quotas []*pfs.RepoQuota
+	for {
+		quota, err := client.Recv()
+		if errors.Is(err, io.EOF) {
+			return quotas, nil
+		} else if err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		quotas = append(quotas, quota)
+	}
+}
+
 // DeleteRepo deletes a repo and reclaims the storage space it was using. Note
 // that as of 1.0 we do not reclaim the blocks that the Repo was referencing,
 // this is because they may also be referenced by other Repos and deleting them
@@ -321,6 +389,27 @@ func (c APIClient) CreateBranchTrigger(repoName string, branchName string, commi
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ProtectBranch sets the protection rules of an existing branch, keeping its
+// head, provenance and trigger. Passing nil clears the branch's protection.
+func (c APIClient) ProtectBranch(repoName string, branchName string, protection *pfs.BranchProtection) error {
+	branchInfo, err := c.InspectBranch(repoName, branchName)
+	if err != nil {
+		return err
+	}
+	if protection == nil {
+		protection = &pfs.BranchProtection{}
+	}
+	_, err = c.PfsAPIClient.CreateBranch(
+		c.Ctx(),
+		&pfs.CreateBranchRequest{
+			Branch:     branchInfo.Branch,
+			Provenance: branchInfo.DirectProvenance,
+			Protection: protection,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // InspectBranch returns information on a specific PFS branch
 func (c APIClient) InspectBranch(repoName string, branchName string) (*pfs.BranchInfo, error) {
 	branchInfo, err := c.PfsAPIClient.InspectBranch(
@@ -530,6 +619,34 @@ func (c APIClient) Fsck(fix bool, cb func(*pfs.FsckResponse) error) error {
 	return nil
 }
 
+// SweepOrphans deletes objects in chunk storage which have been untracked for
+// longer than gracePeriod. If dryRun is true, the orphaned objects are
+// reported, but not deleted.
+func (c APIClient) SweepOrphans(dryRun bool, gracePeriod time.Duration, cb func(*pfs.SweepOrphansResponse) error) error {
+	sweepClient, err := c.PfsAPIClient.SweepOrphans(c.Ctx(), &pfs.SweepOrphansRequest{
+		DryRun:      dryRun,
+		GracePeriod: types.DurationProto(gracePeriod),
+	})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		resp, err := sweepClient.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := cb(resp); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
 // FsckFastExit performs checks on pfs, similar to Fsck, except that it returns the
 // first fsck error it encounters and exits.
 func (c APIClient) FsckFastExit() error {
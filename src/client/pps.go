@@ -397,6 +397,40 @@ func (c APIClient) SubscribeJob(pipelineName string, details bool, cb func(*pps.
 	}
 }
 
+// WatchEvents calls cb with each change to repos, branches, commits, jobs and
+// pipelines after cursor (or from now, if cursor is empty), until cb returns
+// an error. To resume watching, pass the cursor of the last event that cb
+// was called with. If repos or pipelines are set, only their events are
+// watched.
+func (c APIClient) WatchEvents(cursor string, repos []*pfs.Repo, pipelines []*pps.Pipeline, cb func(*pps.WatchEvent) error) error {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PpsAPIClient.Watch(
+		ctx,
+		&pps.WatchRequest{
+			Cursor:    cursor,
+			Repos:     repos,
+			Pipelines: pipelines,
+		})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		event, err := client.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := cb(event); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
 // DeleteJob deletes a job.
 func (c APIClient) DeleteJob(pipelineName string, jobID string) error {
 	_, err := c.PpsAPIClient.DeleteJob(
@@ -770,6 +804,47 @@ func (c APIClient) StopPipeline(name string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// PromotePipeline makes the candidate version of a pipeline, created by
+// updating it with a CandidateSpec, its current version.
+func (c APIClient) PromotePipeline(name string) error {
+	_, err := c.PpsAPIClient.PromotePipeline(
+		c.Ctx(),
+		&pps.PromotePipelineRequest{
+			Pipeline: NewPipeline(name),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// RollbackPipeline updates a pipeline to the spec of its version 'version'.
+// If reprocess is true, all of the pipeline's datums are reprocessed.
+func (c APIClient) RollbackPipeline(name string, version uint64, reprocess bool) error {
+	_, err := c.PpsAPIClient.RollbackPipeline(
+		c.Ctx(),
+		&pps.RollbackPipelineRequest{
+			Pipeline:  NewPipeline(name),
+			Version:   version,
+			Reprocess: reprocess,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// RollbackPipelineReuseOutputs updates a pipeline to the spec of its version
+// 'version', and restores that version's salt, so that unchanged datums only
+// keep their outputs if they were processed with it.
+func (c APIClient) RollbackPipelineReuseOutputs(name string, version uint64) error {
+	_, err := c.PpsAPIClient.RollbackPipeline(
+		c.Ctx(),
+		&pps.RollbackPipelineRequest{
+			Pipeline:     NewPipeline(name),
+			Version:      version,
+			ReuseOutputs: true,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // RunPipeline runs a pipeline. It can be passed a list of commit provenance.
 // This will trigger a new job provenant on those commits, effectively running the pipeline on the data in those commits.
 func (c APIClient) RunPipeline(name string, provenance []*pfs.Commit, jobID string) error {
@@ -841,6 +916,42 @@ func (c APIClient) ListSecret() ([]*pps.SecretInfo, error) {
 	return secretInfos.SecretInfo, nil
 }
 
+// CreateNotification creates a cluster-wide notification, or replaces it if
+// update is true.
+func (c APIClient) CreateNotification(notification *pps.Notification, update bool) error {
+	_, err := c.PpsAPIClient.CreateNotification(
+		c.Ctx(),
+		&pps.CreateNotificationRequest{
+			Notification: notification,
+			Update:       update,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// DeleteNotification deletes a cluster-wide notification.
+func (c APIClient) DeleteNotification(name string) error {
+	_, err := c.PpsAPIClient.DeleteNotification(
+		c.Ctx(),
+		&pps.DeleteNotificationRequest{
+			Name: name,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListNotification returns info about all cluster-wide notifications.
+func (c APIClient) ListNotification() ([]*pps.NotificationInfo, error) {
+	notificationInfos, err := c.PpsAPIClient.ListNotification(
+		c.Ctx(),
+		&pps.ListNotificationRequest{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return notificationInfos.NotificationInfo, nil
+}
+
 // CreatePipelineService creates a new pipeline service.
 func (c APIClient) CreatePipelineService(
 	name string,
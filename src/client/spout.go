@@ -0,0 +1,69 @@
+package client
+
+import (
+	"io"
+	"os"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// WithSpoutCommit is used by spout code with a marker to commit output along
+// with a checkpoint of its position in the stream. It starts a commit on
+// 'branch' of the spout's output repo and a commit on the marker branch in the
+// same commitset, and calls 'cb' to write the output commit. The checkpoint
+// returned by 'cb' is written to the marker file 'marker', and then both
+// commits are finished in a single transaction, so that a restarted spout
+// only sees the checkpoint at /pfs/<marker> if the output was committed. If
+// 'cb' returns an error, nothing is committed.
+func (c APIClient) WithSpoutCommit(branch, marker string, cb func(*pfs.Commit) (io.Reader, error)) (retErr error) {
+	repo, ok := os.LookupEnv(PPSPipelineNameEnv)
+	if !ok {
+		return errors.Errorf("WithSpoutCommit must be called from within a spout (%s is not set)", PPSPipelineNameEnv)
+	}
+	txnInfo, err := c.RunBatchInTransaction(func(tb *TransactionBuilder) error {
+		if _, err := tb.StartCommit(repo, branch); err != nil {
+			return err
+		}
+		_, err := tb.StartCommit(repo, ppsconsts.SpoutMarkerBranch)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	outputCommit, markerCommit := txnInfo.Responses[0].Commit, txnInfo.Responses[1].Commit
+	defer func() {
+		if retErr == nil {
+			return
+		}
+		// Discard anything that was written, so that the next commit isn't
+		// blocked on these ones.
+		for _, commit := range []*pfs.Commit{outputCommit, markerCommit} {
+			if err := c.ClearCommit(repo, commit.Branch.Name, commit.ID); err != nil {
+				return
+			}
+		}
+		c.finishSpoutCommit(repo, outputCommit, markerCommit)
+	}()
+	checkpoint, err := cb(outputCommit)
+	if err != nil {
+		return err
+	}
+	if err := c.PutFile(markerCommit, marker, checkpoint); err != nil {
+		return err
+	}
+	return c.finishSpoutCommit(repo, outputCommit, markerCommit)
+}
+
+func (c APIClient) finishSpoutCommit(repo string, commits ...*pfs.Commit) error {
+	_, err := c.RunBatchInTransaction(func(tb *TransactionBuilder) error {
+		for _, commit := range commits {
+			if err := tb.FinishCommit(repo, commit.Branch.Name, commit.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
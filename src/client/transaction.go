@@ -341,6 +341,12 @@ func (c *pfsBuilderClient) InspectRepo(ctx context.Context, req *pfs.InspectRepo
 func (c *pfsBuilderClient) ListRepo(ctx context.Context, req *pfs.ListRepoRequest, opts ...grpc.CallOption) (pfs.API_ListRepoClient, error) {
 	return nil, unsupportedError("ListRepo")
 }
+func (c *pfsBuilderClient) SetRepoQuota(ctx context.Context, req *pfs.SetRepoQuotaRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("SetRepoQuota")
+}
+func (c *pfsBuilderClient) ListRepoQuota(ctx context.Context, req *pfs.ListRepoQuotaRequest, opts ...grpc.CallOption) (pfs.API_ListRepoQuotaClient, error) {
+	return nil, unsupportedError("ListRepoQuota")
+}
 func (c *pfsBuilderClient) InspectCommit(ctx context.Context, req *pfs.InspectCommitRequest, opts ...grpc.CallOption) (*pfs.CommitInfo, error) {
 	return nil, unsupportedError("InspectCommit")
 }
@@ -398,6 +404,9 @@ func (c *pfsBuilderClient) DeleteAll(ctx context.Context, req *types.Empty, opts
 func (c *pfsBuilderClient) Fsck(ctx context.Context, req *pfs.FsckRequest, opts ...grpc.CallOption) (pfs.API_FsckClient, error) {
 	return nil, unsupportedError("Fsck")
 }
+func (c *pfsBuilderClient) SweepOrphans(ctx context.Context, req *pfs.SweepOrphansRequest, opts ...grpc.CallOption) (pfs.API_SweepOrphansClient, error) {
+	return nil, unsupportedError("SweepOrphans")
+}
 func (c *pfsBuilderClient) CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (pfs.API_CreateFileSetClient, error) {
 	return nil, unsupportedError("CreateFileSet")
 }
@@ -462,6 +471,12 @@ func (c *ppsBuilderClient) StartPipeline(ctx context.Context, req *pps.StartPipe
 func (c *ppsBuilderClient) StopPipeline(ctx context.Context, req *pps.StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("StopPipeline")
 }
+func (c *ppsBuilderClient) PromotePipeline(ctx context.Context, req *pps.PromotePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("PromotePipeline")
+}
+func (c *ppsBuilderClient) RollbackPipeline(ctx context.Context, req *pps.RollbackPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("RollbackPipeline")
+}
 func (c *ppsBuilderClient) RunPipeline(ctx context.Context, req *pps.RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RunPipeline")
 }
@@ -489,6 +504,18 @@ func (c *ppsBuilderClient) InspectSecret(ctx context.Context, req *pps.InspectSe
 func (c *ppsBuilderClient) ListSecret(ctx context.Context, in *types.Empty, opt ...grpc.CallOption) (*pps.SecretInfos, error) {
 	return nil, unsupportedError("ListSecret")
 }
+func (c *ppsBuilderClient) CreateNotification(ctx context.Context, req *pps.CreateNotificationRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateNotification")
+}
+func (c *ppsBuilderClient) DeleteNotification(ctx context.Context, req *pps.DeleteNotificationRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteNotification")
+}
+func (c *ppsBuilderClient) ListNotification(ctx context.Context, req *pps.ListNotificationRequest, opts ...grpc.CallOption) (*pps.NotificationInfos, error) {
+	return nil, unsupportedError("ListNotification")
+}
+func (c *ppsBuilderClient) Watch(ctx context.Context, req *pps.WatchRequest, opts ...grpc.CallOption) (pps.API_WatchClient, error) {
+	return nil, unsupportedError("Watch")
+}
 func (c *ppsBuilderClient) RunLoadTest(ctx context.Context, req *pfs.RunLoadTestRequest, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error) {
 	return nil, unsupportedError("RunLoadTest")
 }
@@ -541,6 +568,9 @@ func (c *authBuilderClient) GetRobotToken(ctx context.Context, req *auth.GetRobo
 func (c *authBuilderClient) GetOIDCLogin(ctx context.Context, req *auth.GetOIDCLoginRequest, opts ...grpc.CallOption) (*auth.GetOIDCLoginResponse, error) {
 	return nil, unsupportedError("GetOIDCLogin")
 }
+func (c *authBuilderClient) GetOIDCDeviceLogin(ctx context.Context, req *auth.GetOIDCDeviceLoginRequest, opts ...grpc.CallOption) (*auth.GetOIDCDeviceLoginResponse, error) {
+	return nil, unsupportedError("GetOIDCDeviceLogin")
+}
 func (c *authBuilderClient) RevokeAuthToken(ctx context.Context, req *auth.RevokeAuthTokenRequest, opts ...grpc.CallOption) (*auth.RevokeAuthTokenResponse, error) {
 	return nil, unsupportedError("RevokeAuthToken")
 }
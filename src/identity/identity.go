@@ -7,6 +7,11 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// DeviceCallbackRedirectURI must be one of an OIDC client's redirect URIs for
+// it to log users in with the device authorization grant (e.g. with
+// `pachctl auth login --device`).
+const DeviceCallbackRedirectURI = "/device/callback"
+
 var (
 	// ErrInvalidID is returned if the client or connector ID does not exist
 	ErrInvalidID = status.Error(codes.Internal, "ID does not exist")
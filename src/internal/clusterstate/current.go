@@ -2,4 +2,4 @@ package clusterstate
 
 // DesiredClusterState is the set of migrations to apply to run pachd at the current version.
 // New migrations should be appended to the end.
-var DesiredClusterState = state_2_0_0
+var DesiredClusterState = state_2_1_0
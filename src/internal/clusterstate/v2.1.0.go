@@ -0,0 +1,42 @@
+package clusterstate
+
+import (
+	"context"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/work"
+	"github.com/pachyderm/pachyderm/v2/src/server/auth"
+)
+
+var state_2_1_0 migrations.State = state_2_0_0.
+	Apply("create pfs repo quotas collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.CollectionsV1()...)
+	}).
+	Apply("create storage orphaned objects table", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupOrphanedObjectsV0(env.Tx)
+	}).
+	Apply("add tiers to storage chunk objects", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupTiersV0(env.Tx)
+	}).
+	Apply("add replication to storage chunk objects", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupReplicationV0(env.Tx)
+	}).
+	Apply("create work task queue tables", func(ctx context.Context, env migrations.Env) error {
+		return work.SetupPostgresV0(ctx, env.Tx)
+	}).
+	Apply("create pps notification tables", func(ctx context.Context, env migrations.Env) error {
+		return ppsdb.SetupNotificationsV0(ctx, env.Tx)
+	}).
+	Apply("create collections event log", func(ctx context.Context, env migrations.Env) error {
+		collections := []col.PostgresCollection{}
+		collections = append(collections, pfsdb.CollectionsV0()...)
+		collections = append(collections, ppsdb.CollectionsV0()...)
+		return col.SetupPostgresEventLogV0(ctx, env.Tx, collections...)
+	}).
+	Apply("create auth token scopes table", func(ctx context.Context, env migrations.Env) error {
+		return auth.CreateAuthTokenScopesTable(ctx, env.Tx)
+	})
@@ -210,8 +210,9 @@ func ParseBranch(arg string) (*pfs.Branch, error) {
 	return commit.Branch, nil
 }
 
-// ParseJob takes an argument of the form "pipeline@job-id" and returns
-// the corresponding *pps.Job.
+// ParseJob takes an argument of the form "pipeline@job-id" (or
+// "pipeline@branch=job-id", for the jobs of a shadow candidate) and returns the
+// corresponding *pps.Job.
 func ParseJob(arg string) (*pps.Job, error) {
 	parts := strings.SplitN(arg, "@", 2)
 	if parts[0] == "" {
@@ -220,7 +221,11 @@ func ParseJob(arg string) (*pps.Job, error) {
 	if len(parts) != 2 {
 		return nil, errors.Errorf("invalid format \"%s\": expected pipeline@job-id", arg)
 	}
-	return client.NewJob(parts[0], parts[1]), nil
+	job := client.NewJob(parts[0], parts[1])
+	if branchAndID := strings.SplitN(parts[1], "=", 2); len(branchAndID) == 2 {
+		job.Branch, job.ID = branchAndID[0], branchAndID[1]
+	}
+	return job, nil
 }
 
 // ParseBranches converts all arguments to *pfs.Commit structs using the
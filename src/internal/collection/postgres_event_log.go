@@ -0,0 +1,341 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+)
+
+const (
+	eventLogChannel = "pwc_event_log"
+	// eventLogBatchSize is the number of events read from the event log at a
+	// time.
+	eventLogBatchSize = 1000
+	// eventLogPollInterval bounds how long watchers of the event log wait for
+	// a notification before reading it again, which covers dropped
+	// notifications and transactions that could not be read yet.
+	eventLogPollInterval = 5 * time.Second
+)
+
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+//
+// SetupPostgresEventLogV0 creates the event log, which records every change
+// to the given collections.
+//
+// Events are read in the order of the transactions' IDs, and only once
+// every transaction with a lower ID has finished. Unlike reading them in the
+// order they were inserted (or committed), this means a reader never passes
+// an event from a transaction that has not committed yet, so it can resume
+// from the last event it read without missing any.
+func SetupPostgresEventLogV0(ctx context.Context, sqlTx *sqlx.Tx, collections ...PostgresCollection) error {
+	schema := `
+	CREATE TABLE collections.event_log (
+		xid BIGINT NOT NULL DEFAULT txid_current(),
+		id BIGSERIAL NOT NULL,
+		createdat TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		tablename TEXT NOT NULL,
+		operation TEXT NOT NULL,
+		key TEXT NOT NULL,
+		proto BYTEA NOT NULL,
+		PRIMARY KEY(xid, id)
+	);
+
+	CREATE INDEX ON collections.event_log (createdat);
+
+	CREATE TABLE collections.event_log_horizon (
+		xid BIGINT NOT NULL,
+		id BIGINT NOT NULL
+	);
+
+	INSERT INTO collections.event_log_horizon (xid, id) VALUES (0, 0);
+
+	CREATE FUNCTION collections.event_log_trigger_fn() RETURNS TRIGGER AS $$
+	DECLARE
+		row record;
+	BEGIN
+		CASE tg_op
+		WHEN 'INSERT', 'UPDATE' THEN
+			row := new;
+		WHEN 'DELETE' THEN
+			row := old;
+		ELSE
+			RAISE EXCEPTION 'Unrecognized tg_op: "%"', tg_op;
+		END CASE;
+		INSERT INTO collections.event_log (tablename, operation, key, proto) VALUES (tg_table_name, tg_op, row.key, row.proto);
+		PERFORM pg_notify('` + eventLogChannel + `', '');
+		RETURN row;
+	END;
+	$$ LANGUAGE 'plpgsql';
+	`
+	if _, err := sqlTx.ExecContext(ctx, schema); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, pgc := range collections {
+		col := pgc.(*postgresCollection)
+		trigger := fmt.Sprintf(`
+	CREATE TRIGGER event_log_trigger
+		AFTER INSERT OR UPDATE OR DELETE ON collections.%s
+		FOR EACH ROW EXECUTE PROCEDURE collections.event_log_trigger_fn();
+	`, col.table)
+		if _, err := sqlTx.ExecContext(ctx, trigger); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// EventLogCursor is the position of an event in the event log.
+type EventLogCursor struct {
+	XID, ID int64
+}
+
+func (c EventLogCursor) String() string {
+	return fmt.Sprintf("%d.%d", c.XID, c.ID)
+}
+
+func (c EventLogCursor) before(other EventLogCursor) bool {
+	return c.XID < other.XID || (c.XID == other.XID && c.ID < other.ID)
+}
+
+// ParseEventLogCursor parses a cursor returned by EventLogCursor.String.
+func ParseEventLogCursor(s string) (EventLogCursor, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 2 {
+		return EventLogCursor{}, errors.Errorf("invalid cursor %q", s)
+	}
+	xid, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return EventLogCursor{}, errors.Errorf("invalid cursor %q", s)
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return EventLogCursor{}, errors.Errorf("invalid cursor %q", s)
+	}
+	return EventLogCursor{XID: xid, ID: id}, nil
+}
+
+// ErrEventLogCursorExpired is returned when watching the event log from a
+// cursor that is older than the events that have been swept.
+var ErrEventLogCursorExpired = errors.New("cursor has expired, events after it have been deleted")
+
+// EventLogEvent is a change to a collection.
+type EventLogEvent struct {
+	Cursor EventLogCursor
+	Time   time.Time
+	// Collection is the name of the collection that was changed.
+	Collection string
+	Type       watch.EventType
+	Key        string
+	// Value is the value that was put, or the last value of a deleted key.
+	Value proto.Message
+
+	// skip is set for events of collections that the event log wasn't
+	// created for. They are not passed to Watch's callback, but still move
+	// its cursor forward.
+	skip bool
+}
+
+// PostgresEventLog reads the event log of a set of collections.
+type PostgresEventLog struct {
+	db        *sqlx.DB
+	listener  PostgresListener
+	templates map[string]proto.Message
+}
+
+// NewPostgresEventLog creates a reader of the event log for the given
+// collections. Events of other collections are skipped.
+func NewPostgresEventLog(db *sqlx.DB, listener PostgresListener, collections ...PostgresCollection) *PostgresEventLog {
+	templates := make(map[string]proto.Message)
+	for _, pgc := range collections {
+		col := pgc.(*postgresCollection)
+		templates[col.table] = col.template
+	}
+	return &PostgresEventLog{
+		db:        db,
+		listener:  listener,
+		templates: templates,
+	}
+}
+
+// Head returns a cursor that watching from sends the events of the
+// transactions that are running or have not started yet.
+func (el *PostgresEventLog) Head(ctx context.Context) (EventLogCursor, error) {
+	var xmin int64
+	if err := el.db.GetContext(ctx, &xmin, `SELECT txid_snapshot_xmin(txid_current_snapshot())`); err != nil {
+		return EventLogCursor{}, errors.EnsureStack(err)
+	}
+	return EventLogCursor{XID: xmin - 1, ID: math.MaxInt64}, nil
+}
+
+// Watch calls cb with each event after cursor, in order, and then with each
+// new event, until cb returns an error or ctx is canceled. If cb returns
+// errutil.ErrBreak, Watch returns nil.
+func (el *PostgresEventLog) Watch(ctx context.Context, cursor EventLogCursor, cb func(*EventLogEvent) error) error {
+	n := newEventLogNotifier()
+	if err := el.listener.Register(n); err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := el.listener.Unregister(n); err != nil {
+			log.Errorf("errored unregistering from the event log: %v", err)
+		}
+	}()
+	ticker := time.NewTicker(eventLogPollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			events, err := el.read(ctx, cursor)
+			if err != nil {
+				return err
+			}
+			for _, event := range events {
+				cursor = event.Cursor
+				if event.skip {
+					continue
+				}
+				if err := cb(event); err != nil {
+					if errors.Is(err, errutil.ErrBreak) {
+						return nil
+					}
+					return err
+				}
+			}
+			if len(events) < eventLogBatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		case <-n.notify:
+		case err := <-n.err:
+			// The listener drops its notifiers when it loses its connection, so
+			// register again (polling covers anything missed in between).
+			log.Errorf("lost notifications for the event log: %v", err)
+			if err := el.listener.Register(n); err != nil {
+				return errors.EnsureStack(err)
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+func (el *PostgresEventLog) read(ctx context.Context, cursor EventLogCursor) ([]*EventLogEvent, error) {
+	var events []*EventLogEvent
+	if err := dbutil.WithTx(ctx, el.db, func(tx *sqlx.Tx) error {
+		events = nil
+		var horizon EventLogCursor
+		if err := tx.QueryRowxContext(ctx, `SELECT xid, id FROM collections.event_log_horizon`).Scan(&horizon.XID, &horizon.ID); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if cursor.before(horizon) {
+			return ErrEventLogCursorExpired
+		}
+		rows, err := tx.QueryxContext(ctx, `
+			SELECT xid, id, createdat, tablename, operation, key, proto FROM collections.event_log
+			WHERE (xid, id) > ($1, $2) AND xid < txid_snapshot_xmin(txid_current_snapshot())
+			ORDER BY xid, id LIMIT $3
+		`, cursor.XID, cursor.ID, eventLogBatchSize)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var event EventLogEvent
+			var operation string
+			var data []byte
+			if err := rows.Scan(&event.Cursor.XID, &event.Cursor.ID, &event.Time, &event.Collection, &operation, &event.Key, &data); err != nil {
+				return errors.EnsureStack(err)
+			}
+			template, ok := el.templates[event.Collection]
+			if !ok {
+				event.skip = true
+				events = append(events, &event)
+				continue
+			}
+			event.Value = proto.Clone(template)
+			if err := proto.Unmarshal(data, event.Value); err != nil {
+				return errors.EnsureStack(err)
+			}
+			event.Type = watch.EventPut
+			if operation == "DELETE" {
+				event.Type = watch.EventDelete
+			}
+			events = append(events, &event)
+		}
+		return errors.EnsureStack(rows.Err())
+	}, dbutil.WithReadOnly()); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Sweep deletes the events that are older than before. Watching from a
+// cursor before the deleted events returns ErrEventLogCursorExpired.
+func (el *PostgresEventLog) Sweep(ctx context.Context, before time.Time) error {
+	return dbutil.WithTx(ctx, el.db, func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			WITH deleted AS (
+				DELETE FROM collections.event_log WHERE createdat < $1 RETURNING xid, id
+			), newest AS (
+				SELECT xid, id FROM deleted ORDER BY xid DESC, id DESC LIMIT 1
+			)
+			UPDATE collections.event_log_horizon h SET xid = newest.xid, id = newest.id
+			FROM newest WHERE (newest.xid, newest.id) > (h.xid, h.id)
+		`, before)
+		return errors.EnsureStack(err)
+	})
+}
+
+// eventLogNotifier is a Notifier which coalesces notifications for the event
+// log, as the event log is read from the cursor regardless of how many
+// events were added.
+type eventLogNotifier struct {
+	id     string
+	notify chan struct{}
+	err    chan error
+}
+
+func newEventLogNotifier() *eventLogNotifier {
+	return &eventLogNotifier{
+		id:     uuid.NewWithoutDashes(),
+		notify: make(chan struct{}, 1),
+		err:    make(chan error, 1),
+	}
+}
+
+func (n *eventLogNotifier) ID() string {
+	return n.id
+}
+
+func (n *eventLogNotifier) Channel() string {
+	return eventLogChannel
+}
+
+func (n *eventLogNotifier) Notify(*Notification) {
+	select {
+	case n.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (n *eventLogNotifier) Error(err error) {
+	select {
+	case n.err <- err:
+	default:
+	}
+}
@@ -0,0 +1,93 @@
+package collection_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+)
+
+func TestPostgresEventLog(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	db, dsn := newTestDirectDB(t)
+	listener := col.NewPostgresListener(dsn)
+	t.Cleanup(func() {
+		require.NoError(t, listener.Close())
+	})
+	testCol := col.NewPostgresCollection("test_items", db, listener, &col.TestItem{}, []*col.Index{TestSecondaryIndex})
+	require.NoError(t, dbutil.WithTx(ctx, db, func(sqlTx *sqlx.Tx) error {
+		if err := col.CreatePostgresSchema(ctx, sqlTx); err != nil {
+			return err
+		}
+		if err := col.SetupPostgresV0(ctx, sqlTx); err != nil {
+			return err
+		}
+		if err := col.SetupPostgresCollections(ctx, sqlTx, testCol); err != nil {
+			return err
+		}
+		return col.SetupPostgresEventLogV0(ctx, sqlTx, testCol)
+	}))
+	put := func(id string) {
+		require.NoError(t, dbutil.WithTx(ctx, db, func(sqlTx *sqlx.Tx) error {
+			return testCol.ReadWrite(sqlTx).Put(id, makeProto(id))
+		}))
+	}
+	el := col.NewPostgresEventLog(db, listener, testCol)
+	read := func(cursor col.EventLogCursor, n int) []*col.EventLogEvent {
+		var events []*col.EventLogEvent
+		require.NoError(t, el.Watch(ctx, cursor, func(event *col.EventLogEvent) error {
+			events = append(events, event)
+			if len(events) == n {
+				return errutil.ErrBreak
+			}
+			return nil
+		}))
+		return events
+	}
+
+	put("a")
+	put("b")
+	require.NoError(t, dbutil.WithTx(ctx, db, func(sqlTx *sqlx.Tx) error {
+		return testCol.ReadWrite(sqlTx).Delete("a")
+	}))
+	events := read(col.EventLogCursor{}, 3)
+	require.Equal(t, "a", events[0].Key)
+	require.Equal(t, watch.EventPut, events[0].Type)
+	require.Equal(t, "a", events[0].Value.(*col.TestItem).ID)
+	require.Equal(t, "b", events[1].Key)
+	require.Equal(t, "a", events[2].Key)
+	require.Equal(t, watch.EventDelete, events[2].Type)
+	require.Equal(t, "a", events[2].Value.(*col.TestItem).ID)
+
+	// Watching can be resumed from the cursor of any event.
+	cursor, err := col.ParseEventLogCursor(events[0].Cursor.String())
+	require.NoError(t, err)
+	require.Equal(t, "b", read(cursor, 1)[0].Key)
+
+	// Watching from the head only returns new events.
+	head, err := el.Head(ctx)
+	require.NoError(t, err)
+	put("c")
+	require.Equal(t, "c", read(head, 1)[0].Key)
+
+	// Watching from before swept events fails, but watching from after them
+	// still works.
+	require.NoError(t, el.Sweep(ctx, time.Now().Add(time.Hour)))
+	err = el.Watch(ctx, col.EventLogCursor{}, func(*col.EventLogEvent) error { return nil })
+	require.True(t, errors.Is(err, col.ErrEventLogCursorExpired))
+	err = el.Watch(ctx, head, func(*col.EventLogEvent) error { return nil })
+	require.True(t, errors.Is(err, col.ErrEventLogCursorExpired))
+	head, err = el.Head(ctx)
+	require.NoError(t, err)
+	put("d")
+	require.Equal(t, "d", read(head, 1)[0].Key)
+}
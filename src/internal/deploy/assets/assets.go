@@ -127,6 +127,18 @@ const (
 
 	// PutFileConcurrencyLimitEnvVar is the environment variable for the PutFile concurrency limit.
 	PutFileConcurrencyLimitEnvVar = "STORAGE_PUT_FILE_CONCURRENCY_LIMIT"
+
+	// ColdTierURLEnvVar is the environment variable for the object store URL of the chunk storage cold tier.
+	ColdTierURLEnvVar = "STORAGE_COLD_TIER_URL"
+
+	// ReplicaURLEnvVar is the environment variable for the object store URL of the chunk storage replica.
+	ReplicaURLEnvVar = "STORAGE_REPLICA_URL"
+
+	// FailoverEnvVar is the environment variable which makes chunk storage read from the replica.
+	FailoverEnvVar = "STORAGE_FAILOVER"
+
+	// WorkBackendEnvVar is the environment variable for the work task queue backend.
+	WorkBackendEnvVar = "WORK_BACKEND"
 )
 
 const (
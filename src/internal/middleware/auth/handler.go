@@ -39,7 +39,9 @@ func unauthenticated(ctx context.Context, _ authiface.APIServer, fullMethod stri
 func authenticated(ctx context.Context, authApi authiface.APIServer, fullMethod string) (string, error) {
 	r, err := authApi.WhoAmI(ctx, &auth.WhoAmIRequest{})
 	var username string
-	if err == nil {
+	// The usernames of scoped tokens aren't cached, as the cached username
+	// would have all of the user's permissions rather than the token's.
+	if err == nil && len(r.Scopes) == 0 {
 		username = r.Username
 	}
 	return username, err
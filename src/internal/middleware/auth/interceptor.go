@@ -30,12 +30,13 @@ var authHandlers = map[string]authHandler{
 
 	// Activate only has an effect when auth is not enabled
 	// Authenticate, Authorize and WhoAmI check auth status themselves
-	// GetOIDCLogin is necessary to authenticate
-	"/auth_v2.API/Activate":     unauthenticated,
-	"/auth_v2.API/Authenticate": unauthenticated,
-	"/auth_v2.API/Authorize":    unauthenticated,
-	"/auth_v2.API/WhoAmI":       unauthenticated,
-	"/auth_v2.API/GetOIDCLogin": unauthenticated,
+	// GetOIDCLogin and GetOIDCDeviceLogin are necessary to authenticate
+	"/auth_v2.API/Activate":           unauthenticated,
+	"/auth_v2.API/Authenticate":       unauthenticated,
+	"/auth_v2.API/Authorize":          unauthenticated,
+	"/auth_v2.API/WhoAmI":             unauthenticated,
+	"/auth_v2.API/GetOIDCLogin":       unauthenticated,
+	"/auth_v2.API/GetOIDCDeviceLogin": unauthenticated,
 
 	// TODO: restrict GetClusterRoleBinding to cluster admins?
 	"/auth_v2.API/CreateRoleBinding":     authenticated,
@@ -126,6 +127,8 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/InspectRepo":        authDisabledOr(authenticated),
 	"/pfs_v2.API/ListRepo":           authDisabledOr(authenticated),
 	"/pfs_v2.API/DeleteRepo":         authDisabledOr(authenticated),
+	"/pfs_v2.API/SetRepoQuota":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_SET_REPO_QUOTA)),
+	"/pfs_v2.API/ListRepoQuota":      authDisabledOr(authenticated),
 	"/pfs_v2.API/StartCommit":        authDisabledOr(authenticated),
 	"/pfs_v2.API/FinishCommit":       authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectCommit":      authDisabledOr(authenticated),
@@ -150,6 +153,7 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/DiffFile":           authDisabledOr(authenticated),
 	"/pfs_v2.API/DeleteAll":          authDisabledOr(authenticated),
 	"/pfs_v2.API/Fsck":               authDisabledOr(authenticated),
+	"/pfs_v2.API/SweepOrphans":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DEBUG_DUMP)),
 	"/pfs_v2.API/CreateFileSet":      authDisabledOr(authenticated),
 	"/pfs_v2.API/GetFileSet":         authDisabledOr(authenticated),
 	"/pfs_v2.API/AddFileSet":         authDisabledOr(authenticated),
@@ -164,36 +168,42 @@ var authHandlers = map[string]authHandler{
 
 	// TODO: Add per-repo permissions checks for these
 	// TODO: split GetLogs into master and not-master and add check for pipeline permissions
-	"/pps_v2.API/InspectJob":      authDisabledOr(authenticated),
-	"/pps_v2.API/ListJob":         authDisabledOr(authenticated),
-	"/pps_v2.API/ListJobStream":   authDisabledOr(authenticated),
-	"/pps_v2.API/SubscribeJob":    authDisabledOr(authenticated),
-	"/pps_v2.API/DeleteJob":       authDisabledOr(authenticated),
-	"/pps_v2.API/StopJob":         authDisabledOr(authenticated),
-	"/pps_v2.API/InspectJobSet":   authDisabledOr(authenticated),
-	"/pps_v2.API/ListJobSet":      authDisabledOr(authenticated),
-	"/pps_v2.API/InspectDatum":    authDisabledOr(authenticated),
-	"/pps_v2.API/ListDatum":       authDisabledOr(authenticated),
-	"/pps_v2.API/ListDatumStream": authDisabledOr(authenticated),
-	"/pps_v2.API/RestartDatum":    authDisabledOr(authenticated),
-	"/pps_v2.API/CreatePipeline":  authDisabledOr(authenticated),
-	"/pps_v2.API/InspectPipeline": authDisabledOr(authenticated),
-	"/pps_v2.API/DeletePipeline":  authDisabledOr(authenticated),
-	"/pps_v2.API/StartPipeline":   authDisabledOr(authenticated),
-	"/pps_v2.API/StopPipeline":    authDisabledOr(authenticated),
-	"/pps_v2.API/RunPipeline":     authDisabledOr(authenticated),
-	"/pps_v2.API/RunCron":         authDisabledOr(authenticated),
-	"/pps_v2.API/GetLogs":         authDisabledOr(authenticated),
-	"/pps_v2.API/GarbageCollect":  authDisabledOr(authenticated),
-	"/pps_v2.API/UpdateJobState":  authDisabledOr(authenticated),
-	"/pps_v2.API/ListPipeline":    authDisabledOr(authenticated),
-	"/pps_v2.API/ActivateAuth":    clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
-	"/pps_v2.API/DeleteAll":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
+	"/pps_v2.API/InspectJob":       authDisabledOr(authenticated),
+	"/pps_v2.API/ListJob":          authDisabledOr(authenticated),
+	"/pps_v2.API/ListJobStream":    authDisabledOr(authenticated),
+	"/pps_v2.API/SubscribeJob":     authDisabledOr(authenticated),
+	"/pps_v2.API/DeleteJob":        authDisabledOr(authenticated),
+	"/pps_v2.API/StopJob":          authDisabledOr(authenticated),
+	"/pps_v2.API/InspectJobSet":    authDisabledOr(authenticated),
+	"/pps_v2.API/ListJobSet":       authDisabledOr(authenticated),
+	"/pps_v2.API/InspectDatum":     authDisabledOr(authenticated),
+	"/pps_v2.API/ListDatum":        authDisabledOr(authenticated),
+	"/pps_v2.API/ListDatumStream":  authDisabledOr(authenticated),
+	"/pps_v2.API/RestartDatum":     authDisabledOr(authenticated),
+	"/pps_v2.API/CreatePipeline":   authDisabledOr(authenticated),
+	"/pps_v2.API/InspectPipeline":  authDisabledOr(authenticated),
+	"/pps_v2.API/DeletePipeline":   authDisabledOr(authenticated),
+	"/pps_v2.API/StartPipeline":    authDisabledOr(authenticated),
+	"/pps_v2.API/StopPipeline":     authDisabledOr(authenticated),
+	"/pps_v2.API/PromotePipeline":  authDisabledOr(authenticated),
+	"/pps_v2.API/RollbackPipeline": authDisabledOr(authenticated),
+	"/pps_v2.API/RunPipeline":      authDisabledOr(authenticated),
+	"/pps_v2.API/RunCron":          authDisabledOr(authenticated),
+	"/pps_v2.API/GetLogs":          authDisabledOr(authenticated),
+	"/pps_v2.API/GarbageCollect":   authDisabledOr(authenticated),
+	"/pps_v2.API/UpdateJobState":   authDisabledOr(authenticated),
+	"/pps_v2.API/ListPipeline":     authDisabledOr(authenticated),
+	"/pps_v2.API/ActivateAuth":     clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
+	"/pps_v2.API/DeleteAll":        authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
 
 	"/pps_v2.API/CreateSecret":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_CREATE_SECRET)),
 	"/pps_v2.API/ListSecret":         authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_SECRETS)),
 	"/pps_v2.API/DeleteSecret":       authDisabledOr(clusterPermissions(auth.Permission_SECRET_DELETE)),
 	"/pps_v2.API/InspectSecret":      authDisabledOr(clusterPermissions(auth.Permission_SECRET_INSPECT)),
+	"/pps_v2.API/CreateNotification": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_EDIT_NOTIFICATIONS)),
+	"/pps_v2.API/DeleteNotification": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_EDIT_NOTIFICATIONS)),
+	"/pps_v2.API/ListNotification":   authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_NOTIFICATIONS)),
+	"/pps_v2.API/Watch":              authDisabledOr(authenticated),
 	"/pps_v2.API/RunLoadTest":        authDisabledOr(authenticated),
 	"/pps_v2.API/RunLoadTestDefault": authDisabledOr(authenticated),
 
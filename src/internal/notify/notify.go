@@ -0,0 +1,151 @@
+// Package notify sends notification events about pipelines, jobs and datums
+// to the sinks configured in notifications.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// SignatureHeader is the header that the hex encoded HMAC-SHA256 of a
+	// webhook request's body is sent in.
+	SignatureHeader = "X-Pachyderm-Signature"
+	// EventTypeHeader is the header that the type of a webhook request's
+	// event is sent in.
+	EventTypeHeader = "X-Pachyderm-Event"
+	// EventIDHeader is the header that the ID of a webhook request's event is
+	// sent in. It is the same for all attempts at sending the event, so
+	// receivers can use it to deduplicate events.
+	EventIDHeader = "X-Pachyderm-Event-Id"
+)
+
+// Sink sends notification events to a destination.
+type Sink interface {
+	Send(ctx context.Context, event *pps.NotificationEvent) error
+}
+
+// SecretGetter returns the value of a key in a secret.
+type SecretGetter func(ctx context.Context, secret, key string) ([]byte, error)
+
+// NewSink creates the sink for a notification.
+func NewSink(ctx context.Context, n *pps.Notification, getSecret SecretGetter) (Sink, error) {
+	switch {
+	case n.Webhook != nil:
+		var key []byte
+		if n.Webhook.Secret != "" {
+			var err error
+			key, err = getSecret(ctx, n.Webhook.Secret, n.Webhook.SecretKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return NewWebhook(n.Webhook.URL, key), nil
+	default:
+		return nil, errors.Errorf("notification %q has no sink", n.Name)
+	}
+}
+
+type webhook struct {
+	client *http.Client
+	url    string
+	key    []byte
+}
+
+// NewWebhook creates a sink that sends events to url in HTTP POST requests
+// with a JSON body. If key is set, the requests are signed with it.
+func NewWebhook(url string, key []byte) Sink {
+	return &webhook{
+		client: &http.Client{},
+		url:    url,
+		key:    key,
+	}
+}
+
+func (w *webhook) Send(ctx context.Context, event *pps.NotificationEvent) error {
+	buf := &bytes.Buffer{}
+	if err := (&jsonpb.Marshaler{}).Marshal(buf, event); err != nil {
+		return errors.EnsureStack(err)
+	}
+	body := buf.Bytes()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, event.Type.String())
+	req.Header.Set(EventIDHeader, event.ID)
+	if w.key != nil {
+		req.Header.Set(SignatureHeader, Sign(w.key, body))
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("webhook returned %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body with key.
+func Sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var (
+	defaultJobStates      = []pps.JobState{pps.JobState_JOB_FAILURE, pps.JobState_JOB_KILLED}
+	defaultPipelineStates = []pps.PipelineState{pps.PipelineState_PIPELINE_FAILURE, pps.PipelineState_PIPELINE_CRASHING}
+)
+
+// Matches returns true if an event should be sent to a notification.
+func Matches(n *pps.Notification, event *pps.NotificationEvent) bool {
+	if len(n.Pipelines) > 0 && !containsString(n.Pipelines, event.Pipeline.GetName()) {
+		return false
+	}
+	jobStates, pipelineStates := n.JobStates, n.PipelineStates
+	if len(jobStates) == 0 && len(pipelineStates) == 0 && !n.DatumFailures {
+		jobStates, pipelineStates = defaultJobStates, defaultPipelineStates
+	}
+	switch event.Type {
+	case pps.NotificationEventType_JOB_STATE_CHANGED:
+		for _, state := range jobStates {
+			if state == event.JobState {
+				return true
+			}
+		}
+	case pps.NotificationEventType_PIPELINE_STATE_CHANGED:
+		for _, state := range pipelineStates {
+			if state == event.PipelineState {
+				return true
+			}
+		}
+	case pps.NotificationEventType_DATUM_FAILED:
+		return n.DatumFailures
+	}
+	return false
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
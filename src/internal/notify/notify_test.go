@@ -0,0 +1,99 @@
+package notify
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestWebhook(t *testing.T) {
+	key := []byte("secret")
+	// The handler only passes requests to the test goroutine, as require
+	// can't be called from other goroutines.
+	type request struct {
+		header http.Header
+		body   []byte
+		err    error
+	}
+	requests := make(chan request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err == nil && r.Header.Get(SignatureHeader) != Sign(key, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		requests <- request{header: r.Header, body: body, err: err}
+	}))
+	defer srv.Close()
+	event := &pps.NotificationEvent{
+		ID:       "id",
+		Type:     pps.NotificationEventType_JOB_STATE_CHANGED,
+		Pipeline: &pps.Pipeline{Name: "pipeline"},
+		JobState: pps.JobState_JOB_FAILURE,
+	}
+	n := &pps.Notification{
+		Name:    "test",
+		Webhook: &pps.Webhook{URL: srv.URL, Secret: "webhook", SecretKey: "key"},
+	}
+	sink, err := NewSink(context.Background(), n, func(_ context.Context, secret, k string) ([]byte, error) {
+		require.Equal(t, "webhook", secret)
+		require.Equal(t, "key", k)
+		return key, nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), event))
+	req := <-requests
+	require.NoError(t, req.err)
+	sent := &pps.NotificationEvent{}
+	require.NoError(t, jsonpb.UnmarshalString(string(req.body), sent))
+	require.Equal(t, event, sent)
+	require.Equal(t, event.ID, req.header.Get(EventIDHeader))
+	require.Equal(t, event.Type.String(), req.header.Get(EventTypeHeader))
+	// Requests signed with the wrong key are rejected.
+	require.YesError(t, NewWebhook(srv.URL, []byte("wrong")).Send(context.Background(), event))
+}
+
+func TestMatches(t *testing.T) {
+	jobEvent := func(pipeline string, state pps.JobState) *pps.NotificationEvent {
+		return &pps.NotificationEvent{
+			Type:     pps.NotificationEventType_JOB_STATE_CHANGED,
+			Pipeline: &pps.Pipeline{Name: pipeline},
+			JobState: state,
+		}
+	}
+	pipelineEvent := &pps.NotificationEvent{
+		Type:          pps.NotificationEventType_PIPELINE_STATE_CHANGED,
+		Pipeline:      &pps.Pipeline{Name: "a"},
+		PipelineState: pps.PipelineState_PIPELINE_CRASHING,
+	}
+	datumEvent := &pps.NotificationEvent{
+		Type:     pps.NotificationEventType_DATUM_FAILED,
+		Pipeline: &pps.Pipeline{Name: "a"},
+	}
+	// By default, failures are matched.
+	n := &pps.Notification{}
+	require.True(t, Matches(n, jobEvent("a", pps.JobState_JOB_FAILURE)))
+	require.True(t, Matches(n, jobEvent("a", pps.JobState_JOB_KILLED)))
+	require.False(t, Matches(n, jobEvent("a", pps.JobState_JOB_SUCCESS)))
+	require.True(t, Matches(n, pipelineEvent))
+	require.False(t, Matches(n, datumEvent))
+	// Explicit states replace the defaults.
+	n = &pps.Notification{JobStates: []pps.JobState{pps.JobState_JOB_SUCCESS}}
+	require.False(t, Matches(n, jobEvent("a", pps.JobState_JOB_FAILURE)))
+	require.True(t, Matches(n, jobEvent("a", pps.JobState_JOB_SUCCESS)))
+	require.False(t, Matches(n, pipelineEvent))
+	n = &pps.Notification{DatumFailures: true}
+	require.True(t, Matches(n, datumEvent))
+	require.False(t, Matches(n, jobEvent("a", pps.JobState_JOB_FAILURE)))
+	// Pipelines limits the events to those pipelines.
+	n = &pps.Notification{Pipelines: []string{"b"}}
+	require.False(t, Matches(n, jobEvent("a", pps.JobState_JOB_FAILURE)))
+	require.True(t, Matches(n, jobEvent("b", pps.JobState_JOB_FAILURE)))
+}
@@ -0,0 +1,48 @@
+package obj
+
+import (
+	"context"
+	"io"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var fallbackMetric = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "pachyderm",
+	Subsystem: "pfs_object_storage_fallback",
+	Name:      "gets_total",
+	Help:      "Number of object storage gets served from the secondary object store because the object was missing from the primary",
+})
+
+var _ Client = &fallbackClient{}
+
+type fallbackClient struct {
+	Client
+	secondary Client
+}
+
+// NewFallbackClient returns primary, with gets of objects which do not exist
+// in primary served from secondary instead. Objects are only written to, and
+// deleted from, primary.
+func NewFallbackClient(primary, secondary Client) Client {
+	return &fallbackClient{Client: primary, secondary: secondary}
+}
+
+func (c *fallbackClient) Get(ctx context.Context, name string, w io.Writer) error {
+	err := c.Client.Get(ctx, name, w)
+	if !pacherr.IsNotExist(err) {
+		return err
+	}
+	fallbackMetric.Inc()
+	return c.secondary.Get(ctx, name, w)
+}
+
+func (c *fallbackClient) Exists(ctx context.Context, name string) (bool, error) {
+	exists, err := c.Client.Exists(ctx, name)
+	if err != nil || exists {
+		return exists, err
+	}
+	return c.secondary.Exists(ctx, name)
+}
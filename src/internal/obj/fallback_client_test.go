@@ -0,0 +1,33 @@
+package obj
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestFallbackClient(t *testing.T) {
+	t.Parallel()
+	TestSuite(t, func(t testing.TB) Client {
+		return NewFallbackClient(newTestLocalClient(t), newTestLocalClient(t))
+	})
+}
+
+func TestFallbackClientReadsSecondary(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	primary, secondary := newTestLocalClient(t), newTestLocalClient(t)
+	c := NewFallbackClient(primary, secondary)
+	require.NoError(t, secondary.Put(ctx, "replicated", strings.NewReader("foo")))
+	requireExists(t, c, "replicated", true)
+	buf := &bytes.Buffer{}
+	require.NoError(t, c.Get(ctx, "replicated", buf))
+	require.Equal(t, "foo", buf.String())
+	// Objects are only written to the primary.
+	require.NoError(t, c.Put(ctx, "new", strings.NewReader("bar")))
+	requireExists(t, primary, "new", true)
+	requireExists(t, secondary, "new", false)
+}
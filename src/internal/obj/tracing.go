@@ -3,6 +3,7 @@ package obj
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
@@ -31,8 +32,26 @@ var (
 		Name:      "written_bytes_total",
 		Help:      "Number of bytes written to object storage, by storage type",
 	}, []string{"provider"})
+
+	objectOperationDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_object_storage",
+		Name:      "operation_duration_seconds",
+		Help:      "Time spent on object storage operations, by storage type, operation name and result",
+		Buckets:   []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"provider", "op", "result"})
 )
 
+// observeOperation records the duration of an object storage operation that
+// began at 'start'.
+func observeOperation(provider, op string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	objectOperationDurationMetric.WithLabelValues(provider, op, result).Observe(time.Since(start).Seconds())
+}
+
 func prettyProvider(provider string) string {
 	switch provider {
 	case "s3", Amazon:
@@ -68,6 +87,7 @@ type tracingObjClient struct {
 // Writer implements the corresponding method in the Client interface
 func (o *tracingObjClient) Put(ctx context.Context, name string, r io.Reader) (retErr error) {
 	objectOperationMetric.WithLabelValues(o.provider, "put").Inc()
+	defer func(start time.Time) { observeOperation(o.provider, "put", start, retErr) }(time.Now())
 	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Put", "name", name)
 	defer func() {
 		tracing.FinishAnySpan(span, "err", retErr)
@@ -83,6 +103,7 @@ func (o *tracingObjClient) Put(ctx context.Context, name string, r io.Reader) (r
 // Get implements the corresponding method in the Client interface
 func (o *tracingObjClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
 	objectOperationMetric.WithLabelValues(o.provider, "get").Inc()
+	defer func(start time.Time) { observeOperation(o.provider, "get", start, retErr) }(time.Now())
 	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Get", "name", name)
 	defer func() {
 		tracing.FinishAnySpan(span, "err", retErr)
@@ -98,6 +119,7 @@ func (o *tracingObjClient) Get(ctx context.Context, name string, w io.Writer) (r
 // Delete implements the corresponding method in the Client interface
 func (o *tracingObjClient) Delete(ctx context.Context, name string) (retErr error) {
 	objectOperationMetric.WithLabelValues(o.provider, "delete").Inc()
+	defer func(start time.Time) { observeOperation(o.provider, "delete", start, retErr) }(time.Now())
 	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Delete",
 		"name", name)
 	defer func() {
@@ -109,6 +131,7 @@ func (o *tracingObjClient) Delete(ctx context.Context, name string) (retErr erro
 // Walk implements the corresponding method in the Client interface
 func (o *tracingObjClient) Walk(ctx context.Context, prefix string, fn func(name string) error) (retErr error) {
 	objectOperationMetric.WithLabelValues(o.provider, "walk").Inc()
+	defer func(start time.Time) { observeOperation(o.provider, "walk", start, retErr) }(time.Now())
 	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Walk",
 		"prefix", prefix)
 	defer func() {
@@ -120,6 +143,7 @@ func (o *tracingObjClient) Walk(ctx context.Context, prefix string, fn func(name
 // Exists implements the corresponding method in the Client interface
 func (o *tracingObjClient) Exists(ctx context.Context, name string) (retVal bool, retErr error) {
 	objectOperationMetric.WithLabelValues(o.provider, "exists").Inc()
+	defer func(start time.Time) { observeOperation(o.provider, "exists", start, retErr) }(time.Now())
 	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Exists",
 		"name", name)
 	defer func() {
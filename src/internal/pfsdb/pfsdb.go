@@ -15,9 +15,10 @@ import (
 )
 
 const (
-	reposCollectionName    = "repos"
-	branchesCollectionName = "branches"
-	commitsCollectionName  = "commits"
+	reposCollectionName      = "repos"
+	branchesCollectionName   = "branches"
+	commitsCollectionName    = "commits"
+	repoQuotasCollectionName = "repo_quotas"
 )
 
 var ReposTypeIndex = &col.Index{
@@ -175,6 +176,47 @@ func Branches(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection
 	)
 }
 
+// RepoQuotasPrefixIndex indexes quotas on whether they apply to a repo name
+// prefix (as opposed to a single repo).
+var RepoQuotasPrefixIndex = &col.Index{
+	Name: "prefix",
+	Extract: func(val proto.Message) string {
+		if val.(*pfs.RepoQuota).Repo != nil {
+			return "false"
+		}
+		return "true"
+	},
+}
+
+var repoQuotasIndexes = []*col.Index{RepoQuotasPrefixIndex}
+
+// RepoQuotaKey returns the key of a quota, which depends on whether it applies
+// to a single repo or to a repo name prefix.
+func RepoQuotaKey(quota *pfs.RepoQuota) string {
+	if quota.Repo != nil {
+		return "repo/" + RepoKey(quota.Repo)
+	}
+	return "prefix/" + quota.Prefix
+}
+
+// RepoQuotas returns a collection of repo quotas
+func RepoQuotas(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		repoQuotasCollectionName,
+		db,
+		listener,
+		&pfs.RepoQuota{},
+		repoQuotasIndexes,
+		col.WithKeyGen(func(key interface{}) (string, error) {
+			if quota, ok := key.(*pfs.RepoQuota); !ok {
+				return "", errors.New("key must be a repo quota")
+			} else {
+				return RepoQuotaKey(quota), nil
+			}
+		}),
+	)
+}
+
 // AllCollections returns a list of all the PFS collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -187,3 +229,11 @@ func CollectionsV0() []col.PostgresCollection {
 		col.NewPostgresCollection(branchesCollectionName, nil, nil, nil, branchesIndexes),
 	}
 }
+
+// CollectionsV1 returns the PFS collections added after 2.0.0 for
+// postgres-initialization purposes.
+func CollectionsV1() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(repoQuotasCollectionName, nil, nil, nil, repoQuotasIndexes),
+	}
+}
@@ -18,4 +18,8 @@ const (
 
 	// SpoutMarkerBranch is the branch that spouts use for keeping track of spout marker files
 	SpoutMarkerBranch = "marker"
+
+	// CandidateSpecBranch is the branch of a pipeline's spec repo that holds the
+	// spec commit of its candidate version, if it has one
+	CandidateSpecBranch = "candidate"
 )
@@ -0,0 +1,198 @@
+package ppsdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+//
+// SetupNotificationsV0 creates the tables that store cluster-wide
+// notifications and the outbox of notification events waiting to be sent.
+func SetupNotificationsV0(ctx context.Context, tx *sqlx.Tx) error {
+	const schema = `
+	CREATE SCHEMA pps;
+
+	CREATE TABLE pps.notifications (
+		name VARCHAR(4096) NOT NULL PRIMARY KEY,
+		notification BYTEA NOT NULL
+	);
+
+	CREATE TABLE pps.notification_events (
+		id BIGSERIAL NOT NULL PRIMARY KEY,
+		notification VARCHAR(4096) NOT NULL,
+		pipeline VARCHAR(4096) NOT NULL DEFAULT '',
+		sink BYTEA NOT NULL,
+		event BYTEA NOT NULL,
+		attempts INT NOT NULL DEFAULT 0,
+		next_attempt TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		last_error TEXT NOT NULL DEFAULT '',
+		dead_lettered BOOLEAN NOT NULL DEFAULT FALSE
+	);
+
+	CREATE INDEX notification_events_pending ON pps.notification_events (next_attempt) WHERE NOT dead_lettered;
+	`
+	_, err := tx.ExecContext(ctx, schema)
+	return errors.EnsureStack(err)
+}
+
+// PutNotification creates a cluster-wide notification, or replaces it if
+// update is true.
+func PutNotification(ctx context.Context, tx *sqlx.Tx, n *pps.Notification, update bool) error {
+	data, err := proto.Marshal(n)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO pps.notifications (name, notification) VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET notification = $2 WHERE $3
+	`, n.Name, data, update)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if affected == 0 {
+		return errors.Errorf("notification %q already exists", n.Name)
+	}
+	return nil
+}
+
+// DeleteNotification deletes a cluster-wide notification and the events
+// waiting to be sent to it.
+func DeleteNotification(ctx context.Context, tx *sqlx.Tx, name string) error {
+	res, err := tx.ExecContext(ctx, `DELETE FROM pps.notifications WHERE name = $1`, name)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if affected == 0 {
+		return errors.Errorf("notification %q not found", name)
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM pps.notification_events WHERE notification = $1 AND pipeline = ''`, name)
+	return errors.EnsureStack(err)
+}
+
+// ListNotifications returns the cluster-wide notifications.
+func ListNotifications(ctx context.Context, tx sqlx.QueryerContext) ([]*pps.Notification, error) {
+	var datas [][]byte
+	if err := sqlx.SelectContext(ctx, tx, &datas, `SELECT notification FROM pps.notifications ORDER BY name`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var ns []*pps.Notification
+	for _, data := range datas {
+		n := &pps.Notification{}
+		if err := proto.Unmarshal(data, n); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		ns = append(ns, n)
+	}
+	return ns, nil
+}
+
+// NotificationEventCounts returns the number of pending and dead-lettered
+// events of a cluster-wide notification.
+func NotificationEventCounts(ctx context.Context, tx sqlx.QueryerContext, name string) (pending, deadLettered int64, _ error) {
+	row := tx.QueryRowxContext(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE NOT dead_lettered),
+			COUNT(*) FILTER (WHERE dead_lettered)
+		FROM pps.notification_events WHERE notification = $1 AND pipeline = ''
+	`, name)
+	if err := row.Scan(&pending, &deadLettered); err != nil {
+		return 0, 0, errors.EnsureStack(err)
+	}
+	return pending, deadLettered, nil
+}
+
+// AddNotificationEvent adds an event to the outbox of events waiting to be
+// sent to a notification. pipeline is set for notifications from a
+// pipeline's spec. The notification is stored with the event, so the event is
+// sent to the notification as it was when the event happened.
+func AddNotificationEvent(ctx context.Context, tx *sqlx.Tx, pipeline string, n *pps.Notification, event *pps.NotificationEvent) error {
+	sink, err := proto.Marshal(n)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO pps.notification_events (notification, pipeline, sink, event) VALUES ($1, $2, $3, $4)
+	`, n.Name, pipeline, sink, data)
+	return errors.EnsureStack(err)
+}
+
+// PendingNotificationEvent is an event waiting to be sent to a notification.
+type PendingNotificationEvent struct {
+	ID           int64
+	Notification *pps.Notification
+	Event        *pps.NotificationEvent
+	Attempts     int
+}
+
+// PendingNotificationEvents returns up to limit events that are due to be
+// sent, oldest first.
+func PendingNotificationEvents(ctx context.Context, db sqlx.QueryerContext, limit int) ([]*PendingNotificationEvent, error) {
+	var rows []struct {
+		ID       int64  `db:"id"`
+		Sink     []byte `db:"sink"`
+		Event    []byte `db:"event"`
+		Attempts int    `db:"attempts"`
+	}
+	if err := sqlx.SelectContext(ctx, db, &rows, `
+		SELECT id, sink, event, attempts FROM pps.notification_events
+		WHERE NOT dead_lettered AND next_attempt <= CURRENT_TIMESTAMP
+		ORDER BY id LIMIT $1
+	`, limit); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var res []*PendingNotificationEvent
+	for _, row := range rows {
+		pne := &PendingNotificationEvent{
+			ID:           row.ID,
+			Notification: &pps.Notification{},
+			Event:        &pps.NotificationEvent{},
+			Attempts:     row.Attempts,
+		}
+		if err := proto.Unmarshal(row.Sink, pne.Notification); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		if err := proto.Unmarshal(row.Event, pne.Event); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		res = append(res, pne)
+	}
+	return res, nil
+}
+
+// CompleteNotificationEvent removes an event that has been sent.
+func CompleteNotificationEvent(ctx context.Context, db sqlx.ExecerContext, id int64) error {
+	_, err := db.ExecContext(ctx, `DELETE FROM pps.notification_events WHERE id = $1`, id)
+	return errors.EnsureStack(err)
+}
+
+// FailNotificationEvent records a failed attempt at sending an event. The
+// event is retried after backoff, unless deadLetter is true, in which case it
+// is kept (and counted in ListNotification) but no longer retried.
+func FailNotificationEvent(ctx context.Context, db sqlx.ExecerContext, id int64, sendErr error, backoff time.Duration, deadLetter bool) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE pps.notification_events
+		SET attempts = attempts + 1, next_attempt = $2, last_error = $3, dead_lettered = $4
+		WHERE id = $1
+	`, id, time.Now().Add(backoff), sendErr.Error(), deadLetter)
+	return errors.EnsureStack(err)
+}
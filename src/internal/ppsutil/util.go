@@ -32,6 +32,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
@@ -47,6 +48,17 @@ func PipelineRepo(pipeline *pps.Pipeline) *pfs.Repo {
 	return client.NewRepo(pipeline.Name)
 }
 
+// ValidateSpoutMarker validates the marker of a spout, which names the file
+// under /pfs that the spout's last checkpoint is written to. It must be a plain
+// file name, other than "out", since the worker deletes /pfs/<marker> when it
+// restores the checkpoint.
+func ValidateSpoutMarker(marker string) error {
+	if marker == "" || marker == "." || marker == ".." || marker == "out" || strings.Contains(marker, "/") {
+		return errors.Errorf("spout marker %q must be a file name other than \"out\"", marker)
+	}
+	return nil
+}
+
 // PipelineRcName generates the name of the k8s replication controller that
 // manages a pipeline's workers
 func PipelineRcName(name string, version uint64) string {
@@ -116,13 +128,13 @@ func GetLimitsResourceList(limits *pps.ResourceSpec) (*v1.ResourceList, error) {
 // FailPipeline updates the pipeline's state to failed and sets the failure reason
 func FailPipeline(ctx context.Context, db *sqlx.DB, pipelinesCollection col.PostgresCollection, specCommit *pfs.Commit, reason string) error {
 	return SetPipelineState(ctx, db, pipelinesCollection, specCommit,
-		nil, pps.PipelineState_PIPELINE_FAILURE, reason)
+		nil, pps.PipelineState_PIPELINE_FAILURE, reason, nil)
 }
 
 // CrashingPipeline updates the pipeline's state to crashing and sets the reason
 func CrashingPipeline(ctx context.Context, db *sqlx.DB, pipelinesCollection col.PostgresCollection, specCommit *pfs.Commit, reason string) error {
 	return SetPipelineState(ctx, db, pipelinesCollection, specCommit,
-		nil, pps.PipelineState_PIPELINE_CRASHING, reason)
+		nil, pps.PipelineState_PIPELINE_CRASHING, reason, nil)
 }
 
 // PipelineTransitionError represents an error transitioning a pipeline from
@@ -177,9 +189,12 @@ func logSetPipelineState(pipeline string, from []pps.PipelineState, to pps.Pipel
 // the states in 'from' (if not nil) to 'to'. It will annotate any trace in
 // 'ctx' with information about 'pipeline' that it reads.
 //
+// If 'onChange' is not nil, it is called in the same transaction after the
+// pipeline's state is changed, with the state the pipeline was moved from.
+//
 // This function logs a lot for a library function, but it's mostly (maybe
 // exclusively?) called by the PPS master
-func SetPipelineState(ctx context.Context, db *sqlx.DB, pipelinesCollection col.PostgresCollection, specCommit *pfs.Commit, from []pps.PipelineState, to pps.PipelineState, reason string) (retErr error) {
+func SetPipelineState(ctx context.Context, db *sqlx.DB, pipelinesCollection col.PostgresCollection, specCommit *pfs.Commit, from []pps.PipelineState, to pps.PipelineState, reason string, onChange func(*sqlx.Tx, *pps.PipelineInfo, pps.PipelineState) error) (retErr error) {
 	pipeline := specCommit.Branch.Repo.Name
 	logSetPipelineState(pipeline, from, to, reason)
 	var resultMessage string
@@ -233,9 +248,16 @@ func SetPipelineState(ctx context.Context, db *sqlx.DB, pipelinesCollection col.
 			}
 		}
 		resultMessage = fmt.Sprintf("SetPipelineState moved pipeline %s from %s to %s", pipeline, pipelineInfo.State, to)
+		prevState := pipelineInfo.State
 		pipelineInfo.State = to
 		pipelineInfo.Reason = reason
-		return pipelines.Put(specCommit, pipelineInfo)
+		if err := pipelines.Put(specCommit, pipelineInfo); err != nil {
+			return err
+		}
+		if onChange != nil && prevState != to {
+			return onChange(sqlTx, pipelineInfo, prevState)
+		}
+		return nil
 	})
 	if resultMessage != "" {
 		if warn {
@@ -290,6 +312,11 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		Metadata:              pipelineInfo.Details.Metadata,
 		ReprocessSpec:         pipelineInfo.Details.ReprocessSpec,
 		Autoscaling:           pipelineInfo.Details.Autoscaling,
+		Priority:              pipelineInfo.Details.Priority,
+		ConcurrentJobs:        pipelineInfo.Details.ConcurrentJobs,
+		RetryPolicy:           pipelineInfo.Details.RetryPolicy,
+		Notifications:         pipelineInfo.Details.Notifications,
+		AutoscalingSpec:       pipelineInfo.Details.AutoscalingSpec,
 	}
 }
 
@@ -444,7 +471,18 @@ func FindPipelineSpecCommit(ctx context.Context, pfsServer pfsServer.APIServer,
 // FindPipelineSpecCommitInTransaction finds the spec commit corresponding to the pipeline version present in the commit given
 // by startID. If startID is blank, find the current pipeline version
 func FindPipelineSpecCommitInTransaction(txnCtx *txncontext.TransactionContext, pfsServer pfsServer.APIServer, pipeline, startID string) (*pfs.Commit, error) {
-	curr := client.NewSystemRepo(pipeline, pfs.SpecRepoType).NewCommit("master", startID)
+	return findSpecCommitInTransaction(txnCtx, pfsServer, pipeline, "master", startID)
+}
+
+// FindCandidateSpecCommitInTransaction finds the spec commit of the candidate
+// version of a pipeline. It returns a not found error if the pipeline has no
+// candidate.
+func FindCandidateSpecCommitInTransaction(txnCtx *txncontext.TransactionContext, pfsServer pfsServer.APIServer, pipeline string) (*pfs.Commit, error) {
+	return findSpecCommitInTransaction(txnCtx, pfsServer, pipeline, ppsconsts.CandidateSpecBranch, "")
+}
+
+func findSpecCommitInTransaction(txnCtx *txncontext.TransactionContext, pfsServer pfsServer.APIServer, pipeline, branch, startID string) (*pfs.Commit, error) {
+	curr := client.NewSystemRepo(pipeline, pfs.SpecRepoType).NewCommit(branch, startID)
 	commitInfo, err := pfsServer.InspectCommitInTransaction(txnCtx,
 		&pfs.InspectCommitRequest{Commit: curr})
 	if err != nil {
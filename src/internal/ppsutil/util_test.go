@@ -0,0 +1,18 @@
+package ppsutil
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestValidateSpoutMarker(t *testing.T) {
+	for _, marker := range []string{"marker", "checkpoint.json", ".marker", "..marker"} {
+		require.NoError(t, ValidateSpoutMarker(marker), "marker %q", marker)
+	}
+	// restoring the checkpoint deletes /pfs/<marker>, so it must be a file
+	// directly under /pfs
+	for _, marker := range []string{"", ".", "..", "/", "out", "a/b", "../etc", "marker/"} {
+		require.YesError(t, ValidateSpoutMarker(marker), "marker %q", marker)
+	}
+}
@@ -47,6 +47,8 @@ type GlobalConfiguration struct {
 	EnterpriseEtcdPrefix string `env:"PACHYDERM_ENTERPRISE_ETCD_PREFIX,default=pachyderm_enterprise"`
 	Metrics              bool   `env:"METRICS,default=true"`
 	MetricsEndpoint      string `env:"METRICS_ENDPOINT,default="`
+	// WorkBackend is where work task queues are stored, either "etcd" or "postgres".
+	WorkBackend string `env:"WORK_BACKEND,default=etcd"`
 
 	// SessionDurationMinutes it how long auth tokens are valid for, defaults to 30 days (30 * 24 * 60)
 	SessionDurationMinutes int `env:"SESSION_DURATION_MINUTES,default=43200"`
@@ -92,6 +94,14 @@ type PachdSpecificConfiguration struct {
 	MemoryRequest              string `env:"PACHD_MEMORY_REQUEST,default=1T"`
 	WorkerUsesRoot             bool   `env:"WORKER_USES_ROOT,default=false"`
 	RequireCriticalServersOnly bool   `env:"REQUIRE_CRITICAL_SERVERS_ONLY,default=false"`
+	// PPSWorkerRuntime is where pipeline workers run, either "kubernetes" or
+	// "local" (as subprocesses of pachd, for development and testing)
+	PPSWorkerRuntime string `env:"PPS_WORKER_RUNTIME,default=kubernetes"`
+	// PPSLocalWorkerBinary is the worker binary that the local runtime runs
+	PPSLocalWorkerBinary string `env:"PPS_LOCAL_WORKER_BINARY,default=worker"`
+	// PPSLocalWorkerRoot is the directory that the local runtime keeps worker
+	// files and logs in (a temporary directory if unset)
+	PPSLocalWorkerRoot string `env:"PPS_LOCAL_WORKER_ROOT,default="`
 	// TODO: Merge this with the worker specific pod name (PPS_POD_NAME) into a global configuration pod name.
 	PachdPodName string `env:"PACHD_POD_NAME,required"`
 }
@@ -109,6 +119,11 @@ type StorageConfiguration struct {
 	StorageFileSetsMaxOpen         int    `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize           int    `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
 	StorageMemoryCacheSize         int    `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	StorageColdTierURL             string `env:"STORAGE_COLD_TIER_URL"`
+	StorageColdTierMinAge          string `env:"STORAGE_COLD_TIER_MIN_AGE,default=720h"`
+	StorageReplicaURL              string `env:"STORAGE_REPLICA_URL"`
+	StorageFailover                bool   `env:"STORAGE_FAILOVER,default=false"`
+	StorageOrphanSweepInterval     string `env:"STORAGE_ORPHAN_SWEEP_INTERVAL"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -124,6 +139,12 @@ type WorkerSpecificConfiguration struct {
 	PPSWorkerIP string `env:"PPS_WORKER_IP,required"`
 	// The name of this pod
 	PodName string `env:"PPS_POD_NAME,required"`
+	// The IP address that the worker's GRPC server listens on (all addresses if
+	// unset). Workers run by the local runtime share a host, so each one
+	// listens on its own loopback address.
+	PPSWorkerBindIP string `env:"PPS_WORKER_BIND_IP,default="`
+	// The directory that the worker downloads inputs to and runs user code in
+	PPSWorkerRootPath string `env:"PPS_WORKER_ROOT_PATH,default=/"`
 }
 
 // FeatureFlags contains the configuration for feature flags.  XXX: if you're
@@ -24,7 +24,7 @@ type Client interface {
 // trackedClient allows manipulation of individual chunks, by maintaining consistency between
 // a tracker and an kv.Store
 type trackedClient struct {
-	store   kv.Store
+	stores  *tierStores
 	db      *sqlx.DB
 	tracker track.Tracker
 	renewer *track.Renewer
@@ -34,12 +34,16 @@ type trackedClient struct {
 // NewClient returns a client which will write to objc, mdstore, and tracker.  Name is used
 // for the set of temporary objects
 func NewClient(store kv.Store, db *sqlx.DB, tr track.Tracker, name string) Client {
+	return newClient(&tierStores{hot: store}, db, tr, name)
+}
+
+func newClient(stores *tierStores, db *sqlx.DB, tr track.Tracker, name string) Client {
 	var renewer *track.Renewer
 	if name != "" {
 		renewer = track.NewRenewer(tr, name, defaultChunkTTL)
 	}
 	c := &trackedClient{
-		store:   store,
+		stores:  stores,
 		db:      db,
 		tracker: tr,
 		renewer: renewer,
@@ -96,7 +100,7 @@ func (c *trackedClient) Create(ctx context.Context, md Metadata, chunkData []byt
 		return chunkID, nil
 	}
 	key := chunkKey(chunkID, gen)
-	if err := c.store.Put(ctx, key, chunkData); err != nil {
+	if err := c.stores.hot.Put(ctx, key, chunkData); err != nil {
 		return nil, err
 	}
 	_, err := c.db.ExecContext(ctx, `
@@ -112,9 +116,9 @@ func (c *trackedClient) Create(ctx context.Context, md Metadata, chunkData []byt
 
 // Get writes data for a chunk with ID chunkID to w.
 func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback) (retErr error) {
-	var gen uint64
-	err := c.db.Get(&gen, `
-	SELECT gen
+	var ent Entry
+	err := c.db.Get(&ent, `
+	SELECT chunk_id, gen, tier
 	FROM storage.chunk_objects
 	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
 	LIMIT 1
@@ -125,8 +129,8 @@ func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback
 		}
 		return err
 	}
-	key := chunkKey(chunkID, gen)
-	return c.store.Get(ctx, key, cb)
+	key := chunkKey(chunkID, ent.Gen)
+	return c.stores.getFromTier(ctx, ent.Tier, key, cb)
 }
 
 // Close closes the client, stopping the background renewal of created objects
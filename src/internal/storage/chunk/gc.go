@@ -4,6 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/metrics"
 	"github.com/sirupsen/logrus"
 )
 
@@ -41,8 +44,9 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 
 // RunOnce runs 1 cycle of garbage collection.
 func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
+	defer func(start time.Time) { gcCycleDurationMetric.Observe(time.Since(start).Seconds()) }(time.Now())
 	rows, err := gc.s.db.QueryxContext(ctx, `
-	SELECT chunk_id, gen, uploaded FROM storage.chunk_objects
+	SELECT chunk_id, gen, uploaded, tier FROM storage.chunk_objects
 	WHERE tombstone = true
 	`)
 	if err != nil {
@@ -61,7 +65,9 @@ func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
 		if !ent.Uploaded {
 			gc.log.Warnf("possibility for untracked chunk %s", chunkPath(ent.ChunkID, ent.Gen))
 		}
-		if err := gc.deleteOne(ctx, ent); err != nil {
+		err := gc.deleteOne(ctx, ent)
+		gcDeletedMetric.WithLabelValues(metrics.ResultLabel(err)).Inc()
+		if err != nil {
 			return err
 		}
 		gc.log.WithFields(logrus.Fields{
@@ -73,14 +79,26 @@ func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
 }
 
 func (gc *GarbageCollector) deleteOne(ctx context.Context, ent Entry) error {
-	if err := gc.deleteObject(ctx, ent.ChunkID, ent.Gen); err != nil {
+	if err := gc.deleteObject(ctx, ent.Tier, ent.ChunkID, ent.Gen); err != nil {
 		return err
 	}
 	return gc.deleteEntry(ctx, ent.ChunkID, ent.Gen)
 }
 
-func (gc *GarbageCollector) deleteObject(ctx context.Context, chunkID ID, gen uint64) error {
-	return gc.s.store.Delete(ctx, chunkKey(chunkID, gen))
+func (gc *GarbageCollector) deleteObject(ctx context.Context, tier Tier, chunkID ID, gen uint64) error {
+	store := gc.s.stores.get(tier)
+	if store == nil {
+		return errors.Errorf("chunk %s is stored in the %s tier, which is not configured", chunkPath(chunkID, gen), tier)
+	}
+	if err := store.Delete(ctx, chunkKey(chunkID, gen)); err != nil {
+		return err
+	}
+	if gc.s.stores.replica != nil {
+		if err := gc.s.stores.replica.Delete(ctx, chunkKey(chunkID, gen)); err != nil && !pacherr.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
 }
 
 func (gc *GarbageCollector) deleteEntry(ctx context.Context, chunkID ID, gen uint64) error {
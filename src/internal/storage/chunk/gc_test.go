@@ -52,6 +52,59 @@ func TestGC(t *testing.T) {
 	require.Equal(t, 0, count)
 }
 
+func TestSweepOrphans(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	oc, s := NewTestStorage(t, db, tracker)
+
+	writeRandom(ctx, t, s)
+	tracked, err := countObjects(ctx, oc)
+	require.NoError(t, err)
+	orphan := chunkPath(Hash([]byte("orphan")), 1<<40)
+	require.NoError(t, oc.Put(ctx, orphan, strings.NewReader("orphan")))
+
+	sweep := func(gracePeriod time.Duration, dryRun bool) []Orphan {
+		var orphans []Orphan
+		require.NoError(t, s.SweepOrphans(ctx, gracePeriod, dryRun, func(o Orphan) error {
+			orphans = append(orphans, o)
+			return nil
+		}))
+		return orphans
+	}
+	// grace periods short enough to catch uploads in progress are rejected
+	require.YesError(t, s.SweepOrphans(ctx, 0, true, func(Orphan) error { return nil }))
+	// a dry run reports the orphan without recording or deleting it
+	orphans := sweep(MinOrphanGracePeriod, true)
+	require.Equal(t, 1, len(orphans))
+	require.False(t, orphans[0].Deleted)
+	var recorded int
+	require.NoError(t, db.GetContext(ctx, &recorded, `SELECT count(*) FROM storage.orphaned_objects`))
+	require.Equal(t, 0, recorded)
+	// the orphan is recorded, but not deleted until the grace period has passed
+	orphans = sweep(MinOrphanGracePeriod, false)
+	require.Equal(t, 1, len(orphans))
+	require.Equal(t, orphan, orphans[0].Path)
+	require.False(t, orphans[0].Deleted)
+	_, err = db.ExecContext(ctx, `UPDATE storage.orphaned_objects SET first_seen = first_seen - make_interval(secs => $1)`, (2 * MinOrphanGracePeriod).Seconds())
+	require.NoError(t, err)
+	// a dry run still doesn't delete it once the grace period has passed
+	orphans = sweep(MinOrphanGracePeriod, true)
+	require.Equal(t, 1, len(orphans))
+	require.False(t, orphans[0].Deleted)
+	count, err := countObjects(ctx, oc)
+	require.NoError(t, err)
+	require.Equal(t, tracked+1, count)
+	// only the orphan is deleted
+	orphans = sweep(MinOrphanGracePeriod, false)
+	require.Equal(t, 1, len(orphans))
+	require.True(t, orphans[0].Deleted)
+	count, err = countObjects(ctx, oc)
+	require.NoError(t, err)
+	require.Equal(t, tracked, count)
+	require.Equal(t, 0, len(sweep(MinOrphanGracePeriod, false)))
+}
+
 func countObjects(ctx context.Context, client obj.Client) (int, error) {
 	var count int
 	if err := client.Walk(ctx, "", func(string) error {
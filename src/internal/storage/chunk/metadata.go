@@ -59,10 +59,12 @@ var (
 
 // Entry is an chunk object mapping
 type Entry struct {
-	ChunkID   ID     `db:"chunk_id"`
-	Gen       uint64 `db:"gen"`
-	Uploaded  bool   `db:"uploaded"`
-	Tombstone bool   `db:"tombstone"`
+	ChunkID    ID     `db:"chunk_id"`
+	Gen        uint64 `db:"gen"`
+	Uploaded   bool   `db:"uploaded"`
+	Tombstone  bool   `db:"tombstone"`
+	Tier       Tier   `db:"tier"`
+	Replicated bool   `db:"replicated"`
 }
 
 // SetupPostgresStoreV0 sets up tables in db
@@ -91,6 +93,49 @@ func SetupPostgresStoreV0(tx *sqlx.Tx) error {
 	return errors.EnsureStack(err)
 }
 
+// SetupOrphanedObjectsV0 sets up the table used to track objects that have been
+// found in object storage without a corresponding chunk entry.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupOrphanedObjectsV0(tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	CREATE TABLE storage.orphaned_objects (
+		path VARCHAR(4096) NOT NULL,
+		first_seen TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		last_seen TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+		PRIMARY KEY(path)
+	)
+	`)
+	return errors.EnsureStack(err)
+}
+
+// SetupTiersV0 records the tier that each chunk's object is stored in.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupTiersV0(tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	ALTER TABLE storage.chunk_objects ADD COLUMN tier VARCHAR(16) NOT NULL DEFAULT 'hot';
+
+	CREATE INDEX chunk_objects_tier_created_at ON storage.chunk_objects (tier, created_at)
+	`)
+	return errors.EnsureStack(err)
+}
+
+// SetupReplicationV0 records whether each chunk's object has been copied to the
+// replica.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupReplicationV0(tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	ALTER TABLE storage.chunk_objects ADD COLUMN replicated BOOLEAN NOT NULL DEFAULT FALSE;
+
+	CREATE INDEX chunk_objects_unreplicated ON storage.chunk_objects (created_at)
+	WHERE uploaded = TRUE AND tombstone = FALSE AND replicated = FALSE
+	`)
+	return errors.EnsureStack(err)
+}
+
 // KeyStore is a store for named secret keys
 type KeyStore interface {
 	Create(ctx context.Context, name string, data []byte) error
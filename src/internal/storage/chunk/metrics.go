@@ -0,0 +1,78 @@
+package chunk
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	cacheRequestsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "cache_requests_total",
+		Help:      "Number of chunk reads, by whether the chunk was found in the cache (hit or miss)",
+	}, []string{"result"})
+
+	gcDeletedMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "gc_deleted_total",
+		Help:      "Number of chunk objects processed by garbage collection, by result (success or error)",
+	}, []string{"result"})
+
+	tierBytesMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "tier_bytes",
+		Help:      "Number of bytes of chunks stored in each tier (hot or cold), as of the last tiering cycle",
+	}, []string{"tier"})
+
+	tierMovedBytesMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "tier_moved_bytes_total",
+		Help:      "Number of bytes of chunks moved to the cold tier",
+	})
+
+	replicatedBytesMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "replicated_bytes_total",
+		Help:      "Number of bytes of chunks copied to the replica",
+	})
+	restoredBytesMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "restored_bytes_total",
+		Help:      "Number of bytes of chunks uploaded while failed over that were copied back from the replica",
+	})
+
+	replicationBacklogChunksMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "replication_backlog_chunks",
+		Help:      "Number of uploaded chunks which have not been copied to the replica",
+	})
+
+	replicationBacklogBytesMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "replication_backlog_bytes",
+		Help:      "Number of bytes of uploaded chunks which have not been copied to the replica",
+	})
+
+	replicationLagMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "replication_lag_seconds",
+		Help:      "Age of the oldest uploaded chunk which has not been copied to the replica",
+	})
+
+	gcCycleDurationMetric = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_storage",
+		Name:      "gc_cycle_duration_seconds",
+		Help:      "Time spent on a single cycle of chunk garbage collection",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	})
+)
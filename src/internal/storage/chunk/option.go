@@ -7,6 +7,7 @@ import (
 
 	"github.com/chmduquesne/rollinghash/buzhash64"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -30,6 +31,32 @@ func WithObjectCache(fastLayer obj.Client, size int) StorageOption {
 	}
 }
 
+// WithColdTier configures a cold tier that chunks which are no longer
+// referenced by recent data can be moved to by a Tierer.
+func WithColdTier(objC obj.Client) StorageOption {
+	return func(s *Storage) {
+		s.coldObjClient = objC
+	}
+}
+
+// WithReplica configures a replica that a Replicator copies every chunk to,
+// and that chunks are read from if they are missing from their tier.
+func WithReplica(objC obj.Client) StorageOption {
+	return func(s *Storage) {
+		s.replicaObjClient = objC
+	}
+}
+
+// WithFailover serves all chunks from the replica configured by WithReplica,
+// for when the primary object storage is unavailable. Chunks uploaded while
+// failed over are copied back to the primary object storage by the Replicator
+// once failover is disabled.
+func WithFailover() StorageOption {
+	return func(s *Storage) {
+		s.failover = true
+	}
+}
+
 // WithSecret sets the secret used to generate chunk encryption keys
 func WithSecret(secret []byte) StorageOption {
 	return func(s *Storage) {
@@ -85,5 +112,35 @@ func StorageOptions(conf *serviceenv.StorageConfiguration) ([]StorageOption, err
 		diskCache = obj.TracingObjClient("DiskCache", diskCache)
 		opts = append(opts, WithObjectCache(diskCache, conf.StorageDiskCacheSize))
 	}
+	if conf.StorageColdTierURL != "" {
+		url, err := obj.ParseURL(conf.StorageColdTierURL)
+		if err != nil {
+			return nil, err
+		}
+		coldObjC, err := obj.NewClientFromURLAndSecret(url)
+		if err != nil {
+			return nil, err
+		}
+		coldObjC = obj.TracingObjClient("ColdTier", coldObjC)
+		opts = append(opts, WithColdTier(coldObjC))
+	}
+	if conf.StorageReplicaURL != "" {
+		url, err := obj.ParseURL(conf.StorageReplicaURL)
+		if err != nil {
+			return nil, err
+		}
+		replicaObjC, err := obj.NewClientFromURLAndSecret(url)
+		if err != nil {
+			return nil, err
+		}
+		replicaObjC = obj.TracingObjClient("Replica", replicaObjC)
+		opts = append(opts, WithReplica(replicaObjC))
+	}
+	if conf.StorageFailover {
+		if conf.StorageReplicaURL == "" {
+			return nil, errors.New("storage failover requires a replica to be configured")
+		}
+		opts = append(opts, WithFailover())
+	}
 	return opts, nil
 }
@@ -0,0 +1,189 @@
+package chunk
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/sirupsen/logrus"
+)
+
+// MinOrphanGracePeriod is the shortest grace period that SweepOrphans accepts.
+// Uploads in progress have an entry with uploaded = false which is only
+// considered expired after the grace period, so it must be comfortably longer
+// than any upload.
+const MinOrphanGracePeriod = time.Hour
+
+// Orphan is an object in chunk storage which is not referenced by a live
+// chunk entry.
+type Orphan struct {
+	Tier Tier
+	Path string
+	// Since is when the object was first seen to be orphaned.
+	Since   time.Time
+	Deleted bool
+}
+
+// SweepOrphans walks each tier of chunk storage, and deletes the objects which
+// have not been tracked by an entry in storage.chunk_objects for at least
+// gracePeriod. An object is only tracked in the tier that its entry records.
+// Untracked objects are only left behind when the database is out of sync with
+// object storage (e.g. after the database has been restored from a backup), so
+// the first time an object is seen without an entry it is recorded, and it is
+// deleted by a later sweep. Entries for uploads that never completed are also
+// deleted, along with any object that was written, once they are older than
+// gracePeriod. If dryRun is true, nothing is written or deleted, and every
+// orphan is reported with Deleted unset. cb is called with each orphan that is
+// found.
+func (s *Storage) SweepOrphans(ctx context.Context, gracePeriod time.Duration, dryRun bool, cb func(Orphan) error) error {
+	if gracePeriod < MinOrphanGracePeriod {
+		return errors.Errorf("orphan grace period (%v) must be at least %v", gracePeriod, MinOrphanGracePeriod)
+	}
+	if s.stores.failover {
+		return errors.New("orphaned objects cannot be swept while failed over to the replica")
+	}
+	var start time.Time
+	if err := s.db.GetContext(ctx, &start, `SELECT CURRENT_TIMESTAMP`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, tier := range []Tier{TierHot, TierCold} {
+		store := s.stores.get(tier)
+		if store == nil {
+			continue
+		}
+		if err := store.Walk(ctx, []byte(prefix), func(key []byte) error {
+			p := string(key)
+			chunkID, gen, err := parseChunkPath(p)
+			if err != nil {
+				logrus.Warnf("skipping object in chunk storage: %v", err)
+				return nil
+			}
+			orphan, err := s.checkOrphan(ctx, tier, p, chunkID, gen, gracePeriod, dryRun)
+			if err != nil {
+				return err
+			}
+			if orphan == nil {
+				return nil
+			}
+			if orphan.Deleted {
+				if err := s.deleteOrphan(ctx, tier, p, chunkID, gen); err != nil {
+					return err
+				}
+			}
+			return cb(*orphan)
+		}); err != nil {
+			return err
+		}
+	}
+	if dryRun {
+		return nil
+	}
+	// Forget about orphaned objects that no longer exist.
+	_, err := s.db.ExecContext(ctx, `
+	DELETE FROM storage.orphaned_objects
+	WHERE last_seen < $1
+	`, start)
+	return errors.EnsureStack(err)
+}
+
+// checkOrphan returns a non-nil Orphan if the object at p in 'tier' is
+// orphaned. The Orphan's Deleted field is set if it has been orphaned for
+// longer than gracePeriod, unless dryRun is true, in which case the orphan is
+// only looked up rather than recorded.
+func (s *Storage) checkOrphan(ctx context.Context, tier Tier, p string, chunkID ID, gen uint64, gracePeriod time.Duration, dryRun bool) (*Orphan, error) {
+	var ent struct {
+		Entry
+		CreatedAt time.Time `db:"created_at"`
+		Expired   bool      `db:"expired"`
+	}
+	err := s.db.GetContext(ctx, &ent, `
+	SELECT chunk_id, gen, uploaded, tombstone, tier, created_at,
+		created_at <= CURRENT_TIMESTAMP - make_interval(secs => $3) AS expired
+	FROM storage.chunk_objects
+	WHERE chunk_id = $1 AND gen = $2
+	`, chunkID, gen, gracePeriod.Seconds())
+	switch {
+	case err == sql.ErrNoRows || (err == nil && ent.Uploaded && ent.Tier != tier):
+		// Either the object is not tracked at all, or it is a copy left
+		// behind by an interrupted move between tiers.
+		var orphan struct {
+			FirstSeen time.Time `db:"first_seen"`
+			Expired   bool      `db:"expired"`
+		}
+		if dryRun {
+			if err := s.db.GetContext(ctx, &orphan.FirstSeen, `
+			SELECT COALESCE(
+				(SELECT first_seen FROM storage.orphaned_objects WHERE path = $1),
+				CURRENT_TIMESTAMP
+			)
+			`, orphanKey(tier, p)); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			return &Orphan{Tier: tier, Path: p, Since: orphan.FirstSeen}, nil
+		}
+		if err := s.db.GetContext(ctx, &orphan, `
+		INSERT INTO storage.orphaned_objects (path)
+		VALUES ($1)
+		ON CONFLICT (path) DO UPDATE SET last_seen = CURRENT_TIMESTAMP
+		RETURNING first_seen, first_seen <= CURRENT_TIMESTAMP - make_interval(secs => $2) AS expired
+		`, orphanKey(tier, p), gracePeriod.Seconds()); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return &Orphan{Tier: tier, Path: p, Since: orphan.FirstSeen, Deleted: orphan.Expired}, nil
+	case err != nil:
+		return nil, errors.EnsureStack(err)
+	}
+	// Tombstoned entries are handled by the GarbageCollector.
+	if ent.Uploaded || ent.Tombstone {
+		return nil, nil
+	}
+	return &Orphan{Tier: tier, Path: p, Since: ent.CreatedAt, Deleted: ent.Expired && !dryRun}, nil
+}
+
+func (s *Storage) deleteOrphan(ctx context.Context, tier Tier, p string, chunkID ID, gen uint64) error {
+	if err := s.stores.get(tier).Delete(ctx, []byte(p)); err != nil && !pacherr.IsNotExist(err) {
+		return err
+	}
+	if _, err := s.db.ExecContext(ctx, `
+	DELETE FROM storage.chunk_objects
+	WHERE chunk_id = $1 AND gen = $2 AND uploaded = FALSE AND tombstone = FALSE AND tier = $3
+	`, chunkID, gen, tier); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err := s.db.ExecContext(ctx, `
+	DELETE FROM storage.orphaned_objects
+	WHERE path = $1
+	`, orphanKey(tier, p))
+	return errors.EnsureStack(err)
+}
+
+// orphanKey is the key used to record an orphaned object at p in 'tier'.
+// Objects in the hot tier are recorded by their path alone.
+func orphanKey(tier Tier, p string) string {
+	if tier == TierHot {
+		return p
+	}
+	return string(tier) + ":" + p
+}
+
+// parseChunkPath is the inverse of chunkPath.
+func parseChunkPath(p string) (ID, uint64, error) {
+	name := strings.TrimPrefix(p, prefix+"/")
+	parts := strings.Split(name, ".")
+	if name == p || len(parts) != 2 {
+		return nil, 0, errors.Errorf("%q is not a chunk path", p)
+	}
+	chunkID, err := IDFromHex(parts[0])
+	if err != nil || len(chunkID) == 0 {
+		return nil, 0, errors.Errorf("%q is not a chunk path", p)
+	}
+	gen, err := strconv.ParseUint(parts[1], 16, 64)
+	if err != nil {
+		return nil, 0, errors.Errorf("%q is not a chunk path", p)
+	}
+	return chunkID, gen, nil
+}
@@ -0,0 +1,154 @@
+package chunk
+
+import (
+	"context"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/sirupsen/logrus"
+)
+
+const replicationBatchSize = 1000
+
+// Replicator asynchronously copies uploaded chunks to the replica. Chunks which
+// were uploaded while failed over are only in the replica, so once the primary
+// object storage is back in use the Replicator copies them back to their tier.
+type Replicator struct {
+	s      *Storage
+	period time.Duration
+	log    *logrus.Logger
+}
+
+// NewReplicator returns a Replicator operating on s.
+func NewReplicator(s *Storage) *Replicator {
+	return &Replicator{s: s, period: 10 * time.Second, log: logrus.StandardLogger()}
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (r *Replicator) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(r.period)
+	defer ticker.Stop()
+	for {
+		if _, err := r.RunUntilEmpty(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			r.log.Errorf("during chunk replication: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunUntilEmpty calls RunOnce until every uploaded chunk has been replicated.
+// It returns the number of chunks replicated.
+func (r *Replicator) RunUntilEmpty(ctx context.Context) (int, error) {
+	var total int
+	for {
+		n, err := r.RunOnce(ctx)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n == 0 {
+			return total, nil
+		}
+	}
+}
+
+// RunOnce replicates a batch of the oldest unreplicated chunks, and returns the
+// number of chunks replicated.
+func (r *Replicator) RunOnce(ctx context.Context) (int, error) {
+	if r.s.stores.failover {
+		return 0, errors.New("chunks cannot be replicated while failed over to the replica")
+	}
+	if r.s.stores.replica == nil {
+		return 0, errors.New("replica is not configured")
+	}
+	var ents []replicaEntry
+	if err := r.s.db.SelectContext(ctx, &ents, `
+	SELECT chunk_id, gen, tier, size FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND replicated = FALSE
+	ORDER BY created_at
+	LIMIT $1
+	`, replicationBatchSize); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	for i, ent := range ents {
+		if err := r.s.replicate(ctx, ent); err != nil {
+			return i, err
+		}
+	}
+	return len(ents), r.s.reportReplicationLag(ctx)
+}
+
+type replicaEntry struct {
+	Entry
+	Size int64 `db:"size"`
+}
+
+func (s *Storage) replicate(ctx context.Context, ent replicaEntry) error {
+	key := chunkKey(ent.ChunkID, ent.Gen)
+	err := s.stores.getFromPrimary(ctx, ent.Tier, key, func(data []byte) error {
+		return s.stores.replica.Put(ctx, key, data)
+	})
+	switch {
+	case err == nil:
+		replicatedBytesMetric.Add(float64(ent.Size))
+	case pacherr.IsNotExist(err):
+		// The chunk was uploaded while failed over, restore it to its tier.
+		if err := s.stores.replica.Get(ctx, key, func(data []byte) error {
+			return s.stores.putToPrimary(ctx, ent.Tier, key, data)
+		}); err != nil {
+			return err
+		}
+		restoredBytesMetric.Add(float64(ent.Size))
+	default:
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, `
+	UPDATE storage.chunk_objects
+	SET replicated = TRUE
+	WHERE chunk_id = $1 AND gen = $2
+	`, ent.ChunkID, ent.Gen)
+	return errors.EnsureStack(err)
+}
+
+func (s *Storage) reportReplicationLag(ctx context.Context) error {
+	var backlog struct {
+		Chunks int64   `db:"chunks"`
+		Bytes  int64   `db:"bytes"`
+		Lag    float64 `db:"lag"`
+	}
+	if err := s.db.GetContext(ctx, &backlog, `
+	SELECT COUNT(*) AS chunks, COALESCE(SUM(size), 0) AS bytes,
+		COALESCE(EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - MIN(created_at)), 0) AS lag
+	FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND replicated = FALSE
+	`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	replicationBacklogChunksMetric.Set(float64(backlog.Chunks))
+	replicationBacklogBytesMetric.Set(float64(backlog.Bytes))
+	replicationLagMetric.Set(backlog.Lag)
+	return nil
+}
+
+// UnreplicatedChunks returns the number of uploaded chunks which have not been
+// copied to the replica.
+func (s *Storage) UnreplicatedChunks(ctx context.Context) (int64, error) {
+	var n int64
+	if err := s.db.GetContext(ctx, &n, `
+	SELECT COUNT(*) FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND replicated = FALSE
+	`); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	return n, nil
+}
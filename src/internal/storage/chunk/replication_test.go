@@ -0,0 +1,64 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	units "github.com/docker/go-units"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+)
+
+func TestReplication(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	replicaC := dockertestenv.NewTestObjClient(t)
+	primaryC, chunks := NewTestStorage(t, db, tr, WithReplica(replicaC))
+	seed := time.Now().UTC().UnixNano()
+	msg := fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
+	random := rand.New(rand.NewSource(seed))
+	as := generateAnnotations(random, test{1 * units.KB, 10 * units.MB})
+	writeAnnotations(t, chunks, as, msg)
+	n, err := chunks.UnreplicatedChunks(ctx)
+	require.NoError(t, err)
+	require.True(t, n > 0)
+
+	replicator := NewReplicator(chunks)
+	replicated, err := replicator.RunUntilEmpty(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int(n), replicated)
+	n, err = chunks.UnreplicatedChunks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), n)
+	primaryCount, err := countObjects(ctx, primaryC)
+	require.NoError(t, err)
+	replicaCount, err := countObjects(ctx, replicaC)
+	require.NoError(t, err)
+	require.Equal(t, primaryCount, replicaCount)
+
+	// reads are served from the replica after failing over
+	chunks = NewStorage(primaryC, kv.NewMemCache(10), db, tr, WithReplica(replicaC), WithFailover())
+	readAnnotations(t, chunks, as, msg)
+	_, err = NewReplicator(chunks).RunOnce(ctx)
+	require.YesError(t, err)
+
+	// chunks written while failed over are copied back to the primary
+	as2 := generateAnnotations(random, test{1 * units.KB, 10 * units.MB})
+	writeAnnotations(t, chunks, as2, msg)
+	chunks = NewStorage(primaryC, kv.NewMemCache(10), db, tr, WithReplica(replicaC))
+	_, err = NewReplicator(chunks).RunUntilEmpty(ctx)
+	require.NoError(t, err)
+	primaryCount, err = countObjects(ctx, primaryC)
+	require.NoError(t, err)
+	replicaCount, err = countObjects(ctx, replicaC)
+	require.NoError(t, err)
+	require.Equal(t, primaryCount, replicaCount)
+	readAnnotations(t, NewStorage(primaryC, kv.NewMemCache(10), db, tr), as2, msg)
+}
@@ -21,13 +21,16 @@ const (
 
 // Storage is the abstraction that manages chunk storage.
 type Storage struct {
-	objClient     obj.Client
-	db            *sqlx.DB
-	tracker       track.Tracker
-	store         kv.Store
-	memCache      kv.GetPut
-	deduper       *miscutil.WorkDeduper
-	prefetchLimit int
+	objClient        obj.Client
+	coldObjClient    obj.Client
+	replicaObjClient obj.Client
+	failover         bool
+	db               *sqlx.DB
+	tracker          track.Tracker
+	stores           *tierStores
+	memCache         kv.GetPut
+	deduper          *miscutil.WorkDeduper
+	prefetchLimit    int
 
 	createOpts CreateOptions
 }
@@ -48,15 +51,17 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *sqlx.DB, tracker track.
 	for _, opt := range opts {
 		opt(s)
 	}
-	s.store = kv.NewFromObjectClient(s.objClient)
+	s.stores = newTierStores(s.objClient, s.coldObjClient, s.replicaObjClient, s.failover)
 	s.objClient = nil
+	s.coldObjClient = nil
+	s.replicaObjClient = nil
 	return s
 }
 
 // NewReader creates a new Reader.
 func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
 	// using the empty string for the tmp id to disable the renewer
-	client := NewClient(s.store, s.db, s.tracker, "")
+	client := newClient(s.stores, s.db, s.tracker, "")
 	return newReader(ctx, client, s.memCache, s.deduper, s.prefetchLimit, dataRefs, opts...)
 }
 
@@ -67,13 +72,13 @@ func (s *Storage) NewWriter(ctx context.Context, name string, cb WriterCallback,
 	if name == "" {
 		panic("name must not be empty")
 	}
-	client := NewClient(s.store, s.db, s.tracker, name)
+	client := newClient(s.stores, s.db, s.tracker, name)
 	return newWriter(ctx, client, s.memCache, s.deduper, s.createOpts, cb, opts...)
 }
 
-// List lists all of the chunks in object storage.
+// List lists all of the chunks in the hot tier of object storage.
 func (s *Storage) List(ctx context.Context, cb func(id ID) error) error {
-	return s.store.Walk(ctx, nil, func(key []byte) error {
+	return s.stores.hot.Walk(ctx, nil, func(key []byte) error {
 		return cb(ID(key))
 	})
 }
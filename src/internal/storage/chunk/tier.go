@@ -0,0 +1,261 @@
+package chunk
+
+import (
+	"context"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/sirupsen/logrus"
+)
+
+// Tier identifies the object storage backend that a chunk's object is stored
+// in.
+type Tier string
+
+const (
+	// TierHot is the primary object storage backend, which all chunks are
+	// uploaded to.
+	TierHot Tier = "hot"
+	// TierCold is a cheaper object storage backend that chunks which are no
+	// longer referenced by recent data are moved to.
+	TierCold Tier = "cold"
+)
+
+func (t Tier) other() Tier {
+	if t == TierCold {
+		return TierHot
+	}
+	return TierCold
+}
+
+// tierStores holds the stores for each tier. cold is nil if no cold tier is
+// configured, and replica is nil if chunks are not being replicated. When
+// chunks are being replicated, hot and cold fall back to the replica, and
+// primaryHot and primaryCold are the tiers' own stores.
+type tierStores struct {
+	hot, cold               kv.Store
+	primaryHot, primaryCold kv.Store
+	replica                 kv.Store
+	failover                bool
+}
+
+func newTierStores(hot, cold, replica obj.Client, failover bool) *tierStores {
+	if failover {
+		// The replica holds the chunks from every tier.
+		ts := &tierStores{hot: kv.NewFromObjectClient(replica), failover: true}
+		if cold != nil {
+			ts.cold = ts.hot
+		}
+		return ts
+	}
+	if replica == nil {
+		ts := &tierStores{hot: kv.NewFromObjectClient(hot)}
+		if cold != nil {
+			ts.cold = kv.NewFromObjectClient(cold)
+		}
+		return ts
+	}
+	// Objects which have been lost from a tier are read from the replica.
+	ts := &tierStores{
+		hot:        kv.NewFromObjectClient(obj.NewFallbackClient(hot, replica)),
+		primaryHot: kv.NewFromObjectClient(hot),
+		replica:    kv.NewFromObjectClient(replica),
+	}
+	if cold != nil {
+		ts.cold = kv.NewFromObjectClient(obj.NewFallbackClient(cold, replica))
+		ts.primaryCold = kv.NewFromObjectClient(cold)
+	}
+	return ts
+}
+
+func (ts *tierStores) get(tier Tier) kv.Store {
+	if tier == TierCold {
+		return ts.cold
+	}
+	return ts.hot
+}
+
+// primary returns the store that 'tier' keeps its own objects in, without
+// falling back to the replica.
+func (ts *tierStores) primary(tier Tier) kv.Store {
+	if tier == TierCold {
+		return ts.primaryCold
+	}
+	return ts.primaryHot
+}
+
+// getFromPrimary is like getFromTier, but does not fall back to the replica.
+func (ts *tierStores) getFromPrimary(ctx context.Context, tier Tier, key []byte, cb kv.ValueCallback) error {
+	store := ts.primary(tier)
+	if store == nil {
+		return errors.Errorf("chunk is stored in the %s tier, which is not configured", tier)
+	}
+	err := store.Get(ctx, key, cb)
+	if !pacherr.IsNotExist(err) {
+		return err
+	}
+	if other := ts.primary(tier.other()); other != nil {
+		return other.Get(ctx, key, cb)
+	}
+	return err
+}
+
+// putToPrimary puts the object for a chunk in the store that 'tier' keeps its
+// own objects in.
+func (ts *tierStores) putToPrimary(ctx context.Context, tier Tier, key, data []byte) error {
+	store := ts.primary(tier)
+	if store == nil {
+		return errors.Errorf("chunk is stored in the %s tier, which is not configured", tier)
+	}
+	return store.Put(ctx, key, data)
+}
+
+// getFromTier gets the object for a chunk from 'tier', falling back to the
+// other tier if it is not found, as the chunk may have been moved between the
+// tier being read from the database and the object being read.
+func (ts *tierStores) getFromTier(ctx context.Context, tier Tier, key []byte, cb kv.ValueCallback) error {
+	store := ts.get(tier)
+	if store == nil {
+		return errors.Errorf("chunk is stored in the %s tier, which is not configured", tier)
+	}
+	err := store.Get(ctx, key, cb)
+	if !pacherr.IsNotExist(err) {
+		return err
+	}
+	if other := ts.get(tier.other()); other != nil {
+		return other.Get(ctx, key, cb)
+	}
+	return err
+}
+
+// Tierer moves chunks which are no longer referenced by recent data to the
+// cold tier.
+type Tierer struct {
+	s      *Storage
+	minAge time.Duration
+	hot    func(ctx context.Context, cb func(ID) error) error
+	log    *logrus.Logger
+}
+
+// NewTierer returns a Tierer operating on s, which moves chunks that were
+// uploaded more than minAge ago to the cold tier, unless they are returned by
+// hot. hot should return the chunks referenced by recent data.
+func NewTierer(s *Storage, minAge time.Duration, hot func(ctx context.Context, cb func(ID) error) error) *Tierer {
+	return &Tierer{s: s, minAge: minAge, hot: hot, log: logrus.StandardLogger()}
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (t *Tierer) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		if err := t.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			t.log.Errorf("during chunk tiering: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce runs 1 cycle of tiering.
+func (t *Tierer) RunOnce(ctx context.Context) error {
+	if t.s.stores.cold == nil {
+		return errors.New("cold tier is not configured")
+	}
+	if t.s.stores.failover {
+		return errors.New("chunks cannot be moved between tiers while failed over to the replica")
+	}
+	hot := make(map[string]struct{})
+	if err := t.hot(ctx, func(id ID) error {
+		hot[string(id)] = struct{}{}
+		return nil
+	}); err != nil {
+		return err
+	}
+	// The candidates are read up front, rather than while they are moved, so
+	// that a cursor is not held open for the duration of the copies.
+	var ents []struct {
+		Entry
+		Size int64 `db:"size"`
+	}
+	if err := t.s.db.SelectContext(ctx, &ents, `
+	SELECT chunk_id, gen, tier, size FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND tier = $1
+	AND created_at <= CURRENT_TIMESTAMP - make_interval(secs => $2)
+	`, TierHot, t.minAge.Seconds()); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, ent := range ents {
+		if _, ok := hot[string(ent.ChunkID)]; ok {
+			continue
+		}
+		if err := t.s.moveToCold(ctx, ent.ChunkID, ent.Gen); err != nil {
+			return err
+		}
+		tierMovedBytesMetric.Add(float64(ent.Size))
+		t.log.WithFields(logrus.Fields{
+			"chunk_id": ent.ChunkID,
+			"gen":      ent.Gen,
+		}).Debugf("moved chunk to the cold tier")
+	}
+	return t.s.reportTierBytes(ctx)
+}
+
+// moveToCold copies a chunk's object to the cold tier, records the move, and
+// then deletes the object from the hot tier.
+func (s *Storage) moveToCold(ctx context.Context, chunkID ID, gen uint64) error {
+	key := chunkKey(chunkID, gen)
+	if err := s.stores.hot.Get(ctx, key, func(data []byte) error {
+		return s.stores.cold.Put(ctx, key, data)
+	}); err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, `
+	UPDATE storage.chunk_objects
+	SET tier = $3
+	WHERE chunk_id = $1 AND gen = $2 AND tombstone = FALSE
+	`, chunkID, gen, TierCold)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if n == 0 {
+		// The chunk was deleted while it was being copied, the garbage
+		// collector will delete the hot object.
+		return s.stores.cold.Delete(ctx, key)
+	}
+	return s.stores.hot.Delete(ctx, key)
+}
+
+func (s *Storage) reportTierBytes(ctx context.Context) error {
+	var sizes []struct {
+		Tier Tier  `db:"tier"`
+		Size int64 `db:"size"`
+	}
+	if err := s.db.SelectContext(ctx, &sizes, `
+	SELECT tier, COALESCE(SUM(size), 0) AS size FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE
+	GROUP BY tier
+	`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	tierBytesMetric.Reset()
+	for _, size := range sizes {
+		tierBytesMetric.WithLabelValues(string(size.Tier)).Set(float64(size.Size))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	units "github.com/docker/go-units"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+)
+
+func TestTiering(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	coldC := dockertestenv.NewTestObjClient(t)
+	hotC, chunks := NewTestStorage(t, db, tr, WithColdTier(coldC))
+	seed := time.Now().UTC().UnixNano()
+	msg := fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
+	random := rand.New(rand.NewSource(seed))
+	as := generateAnnotations(random, test{1 * units.KB, 10 * units.MB})
+	writeAnnotations(t, chunks, as, msg)
+	hotCount, err := countObjects(ctx, hotC)
+	require.NoError(t, err)
+	require.True(t, hotCount > 0)
+
+	// recently uploaded chunks are not moved
+	tierer := NewTierer(chunks, time.Hour, func(context.Context, func(ID) error) error { return nil })
+	require.NoError(t, tierer.RunOnce(ctx))
+	coldCount, err := countObjects(ctx, coldC)
+	require.NoError(t, err)
+	require.Equal(t, 0, coldCount)
+
+	// chunks referenced by recent data are not moved
+	_, err = db.ExecContext(ctx, `UPDATE storage.chunk_objects SET created_at = CURRENT_TIMESTAMP - interval '2 hours'`)
+	require.NoError(t, err)
+	var keep ID
+	require.NoError(t, chunks.List(ctx, func(id ID) error {
+		keep, err = parseChunkKey(id)
+		return err
+	}))
+	tierer = NewTierer(chunks, time.Hour, func(_ context.Context, cb func(ID) error) error { return cb(keep) })
+	require.NoError(t, tierer.RunOnce(ctx))
+	hotCount, err = countObjects(ctx, hotC)
+	require.NoError(t, err)
+	require.Equal(t, 1, hotCount)
+	coldCount, err = countObjects(ctx, coldC)
+	require.NoError(t, err)
+	require.True(t, coldCount > 0)
+
+	// reads are served from both tiers
+	chunks = NewStorage(hotC, kv.NewMemCache(10), db, tr, WithColdTier(coldC))
+	readAnnotations(t, chunks, as, msg)
+}
+
+func parseChunkKey(key ID) (ID, error) {
+	chunkID, _, err := parseChunkPath(string(key))
+	return chunkID, err
+}
+
+func TestTierStoresWithoutColdTier(t *testing.T) {
+	ctx := context.Background()
+	hotC, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	replicaC, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	stores := newTierStores(hotC, nil, replicaC, false)
+	key, data := []byte("key"), []byte("data")
+
+	// chunks can't be restored to or read from a cold tier that isn't configured
+	require.YesError(t, stores.putToPrimary(ctx, TierCold, key, data))
+	require.YesError(t, stores.getFromPrimary(ctx, TierCold, key, func([]byte) error { return nil }))
+
+	require.NoError(t, stores.putToPrimary(ctx, TierHot, key, data))
+	var got []byte
+	require.NoError(t, stores.getFromPrimary(ctx, TierHot, key, func(value []byte) error {
+		got = append(got, value...)
+		return nil
+	}))
+	require.Equal(t, data, got)
+}
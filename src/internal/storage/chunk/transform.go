@@ -57,12 +57,21 @@ func Get(ctx context.Context, client Client, cache kv.GetPut, deduper *miscutil.
 	}
 	b := backoff.NewExponentialBackOff()
 	b.InitialInterval = 1 * time.Millisecond
+	var missed bool
 	return backoff.RetryUntilCancel(ctx, func() error {
-		return getFromCache(ctx, cache, ref, cb)
+		err := getFromCache(ctx, cache, ref, cb)
+		if err == nil && !missed {
+			cacheRequestsMetric.WithLabelValues("hit").Inc()
+		}
+		return err
 	}, b, func(err error, _ time.Duration) error {
 		if !pacherr.IsNotExist(err) {
 			return err
 		}
+		if !missed {
+			cacheRequestsMetric.WithLabelValues("miss").Inc()
+			missed = true
+		}
 		return deduper.Do(ctx, ref.Key(), func() error {
 			return client.Get(ctx, ref.Id, func(ctext []byte) error {
 				if err := verifyData(ref.Id, ctext); err != nil {
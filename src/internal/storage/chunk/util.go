@@ -18,7 +18,18 @@ import (
 func NewTestStorage(t testing.TB, db *sqlx.DB, tr track.Tracker, opts ...StorageOption) (obj.Client, *Storage) {
 	objC := dockertestenv.NewTestObjClient(t)
 	db.MustExec(`CREATE SCHEMA IF NOT EXISTS storage`)
-	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV0))
+	require.NoError(t, dbutil.WithTx(context.Background(), db, func(tx *sqlx.Tx) error {
+		if err := SetupPostgresStoreV0(tx); err != nil {
+			return err
+		}
+		if err := SetupOrphanedObjectsV0(tx); err != nil {
+			return err
+		}
+		if err := SetupTiersV0(tx); err != nil {
+			return err
+		}
+		return SetupReplicationV0(tx)
+	}))
 	return objC, NewStorage(objC, kv.NewMemCache(10), db, tr, opts...)
 }
 
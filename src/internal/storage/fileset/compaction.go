@@ -8,6 +8,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/metrics"
 )
 
 // IsCompacted returns true if the filesets are already in compacted form.
@@ -44,7 +45,10 @@ func indexOfCompacted(factor int64, inputs []*Primitive) int {
 // Compact always returns the ID of a primitive fileset.
 // Compact does not renew ids.
 // It is the responsibility of the caller to renew ids.  In some cases they may be permanent and not require renewal.
-func (s *Storage) Compact(ctx context.Context, ids []ID, ttl time.Duration, opts ...index.Option) (*ID, error) {
+func (s *Storage) Compact(ctx context.Context, ids []ID, ttl time.Duration, opts ...index.Option) (_ *ID, retErr error) {
+	defer func(start time.Time) {
+		compactionDurationMetric.WithLabelValues(metrics.ResultLabel(retErr)).Observe(time.Since(start).Seconds())
+	}(time.Now())
 	var size int64
 	w := s.newWriter(ctx, WithTTL(ttl), WithIndexCallback(func(idx *index.Index) error {
 		size += index.SizeBytes(idx)
@@ -57,7 +61,12 @@ func (s *Storage) Compact(ctx context.Context, ids []ID, ttl time.Duration, opts
 	if err := CopyFiles(ctx, w, fs, true); err != nil {
 		return nil, err
 	}
-	return w.Close()
+	id, err := w.Close()
+	if err != nil {
+		return nil, err
+	}
+	compactedBytesMetric.Add(float64(size))
+	return id, nil
 }
 
 // CompactionTask contains everything needed to perform the smallest unit of compaction
@@ -191,6 +191,31 @@ func TestCopy(t *testing.T) {
 	require.Equal(t, initialChunkCount, finalChunkCount)
 }
 
+func TestChunks(t *testing.T) {
+	ctx := context.Background()
+	fileSets := newTestStorage(t)
+	random := rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+	// Enough files that the index spans multiple levels.
+	var files []*testFile
+	for _, fileName := range index.Generate("abcdefg") {
+		files = append(files, &testFile{
+			path:  "/" + fileName,
+			datum: "datum",
+			data:  randutil.Bytes(random, 100),
+		})
+	}
+	id := writeFileSet(t, fileSets, files)
+	// Every chunk in storage belongs to the fileset, including the index chunks.
+	seen := make(map[string]struct{})
+	require.NoError(t, fileSets.Chunks(ctx, []ID{id}, func(id chunk.ID) error {
+		_, ok := seen[string(id)]
+		require.False(t, ok)
+		seen[string(id)] = struct{}{}
+		return nil
+	}))
+	require.Equal(t, countChunks(t, fileSets), int64(len(seen)))
+}
+
 func countChunks(t *testing.T, s *Storage) (count int64) {
 	require.NoError(t, s.ChunkStorage().List(context.Background(), func(chunk.ID) error {
 		count++
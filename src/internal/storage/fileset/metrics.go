@@ -0,0 +1,23 @@
+package fileset
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	compactionDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_fileset_storage",
+		Name:      "compaction_duration_seconds",
+		Help:      "Time spent compacting file sets (or shards of file sets), by result",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"result"})
+
+	compactedBytesMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_fileset_storage",
+		Name:      "compacted_bytes_total",
+		Help:      "Number of bytes written to file sets produced by compaction",
+	})
+)
@@ -220,6 +220,63 @@ func (s *Storage) getPrimitives(ctx context.Context, ids []ID) ([]*Primitive, er
 	return prims, nil
 }
 
+// Chunks calls cb once with each chunk that the filesets reference, including
+// the chunks which hold their multilevel indexes.
+func (s *Storage) Chunks(ctx context.Context, ids []ID, cb func(chunk.ID) error) error {
+	seen := make(map[string]struct{})
+	visit := func(id chunk.ID) (bool, error) {
+		if _, ok := seen[string(id)]; ok {
+			return false, nil
+		}
+		seen[string(id)] = struct{}{}
+		return true, cb(id)
+	}
+	fs, err := s.Open(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if err := fs.Iterate(ctx, func(f File) error {
+		for _, dataRef := range f.Index().File.DataRefs {
+			if _, err := visit(chunk.ID(dataRef.Ref.Id)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	// The index chunks are found by following the references recorded in the
+	// tracker down from the top level indexes. The data chunks have already
+	// been visited, so only the index chunks are looked up.
+	prims, err := s.flattenPrimitives(ctx, ids)
+	if err != nil {
+		return err
+	}
+	var queue []chunk.ID
+	for _, prim := range prims {
+		queue = append(queue, prim.PointsTo()...)
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if ok, err := visit(id); err != nil || !ok {
+			return err
+		}
+		downstream, err := s.tracker.GetDownstream(ctx, id.TrackerID())
+		if err != nil {
+			return err
+		}
+		for _, tid := range downstream {
+			id, err := chunk.ParseTrackerID(tid)
+			if err != nil {
+				return err
+			}
+			queue = append(queue, id)
+		}
+	}
+	return nil
+}
+
 // Concat is a special case of Merge, where the filesets each contain paths for distinct ranges.
 // The path ranges must be non-overlapping and the ranges must be lexigraphically sorted.
 // Concat always returns the ID of a primitive fileset.
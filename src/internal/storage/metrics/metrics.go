@@ -54,6 +54,16 @@ func ReportRequest(f func() error, skip ...int) (retErr error) {
 	return f()
 }
 
+// ResultLabel returns the value of the "result" label of an operation that
+// returned 'err'. Unlike ReportRequest, it doesn't use the error's message, so
+// that the label's cardinality stays bounded.
+func ResultLabel(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
 // ReportRequestWithThroughput functions the same as ReportRequest, but also
 // reports the throughput in a separate metric.
 func ReportRequestWithThroughput(f func() (int64, error)) error {
@@ -7,6 +7,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/metrics"
 	"github.com/sirupsen/logrus"
 )
 
@@ -76,9 +77,12 @@ func (gc *GarbageCollector) RunUntilEmpty(ctx context.Context) error {
 
 // RunOnce run's one cycle of garbage collection.
 func (gc *GarbageCollector) RunOnce(ctx context.Context) (int, error) {
+	defer func(start time.Time) { gcCycleDurationMetric.Observe(time.Since(start).Seconds()) }(time.Now())
 	var n int
 	err := gc.tracker.IterateDeletable(ctx, func(id string) error {
-		if err := gc.deleteObject(ctx, id); err != nil {
+		err := gc.deleteObject(ctx, id)
+		gcDeletedMetric.WithLabelValues(metrics.ResultLabel(err)).Inc()
+		if err != nil {
 			logrus.Errorf("error deleting object (%s): %v", id, err)
 		} else {
 			n++
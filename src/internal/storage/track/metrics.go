@@ -0,0 +1,93 @@
+package track
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/metrics"
+)
+
+var (
+	trackerOperationDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_tracker",
+		Name:      "operation_duration_seconds",
+		Help:      "Time spent on tracker database operations, by operation name and result",
+		Buckets:   []float64{0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 5, 10},
+	}, []string{"op", "result"})
+
+	gcDeletedMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_tracker",
+		Name:      "gc_deleted_total",
+		Help:      "Number of tracked objects processed by garbage collection, by result (success or error)",
+	}, []string{"result"})
+
+	gcCycleDurationMetric = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_tracker",
+		Name:      "gc_cycle_duration_seconds",
+		Help:      "Time spent on a single cycle of tracker garbage collection",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	})
+)
+
+func observeOperation(op string, start time.Time, err error) {
+	trackerOperationDurationMetric.WithLabelValues(op, metrics.ResultLabel(err)).Observe(time.Since(start).Seconds())
+}
+
+var _ Tracker = &monitoredTracker{}
+
+type monitoredTracker struct {
+	Tracker
+}
+
+// MonitoredTracker wraps 'tracker', recording the latency of all calls made
+// through the returned Tracker.
+func MonitoredTracker(tracker Tracker) Tracker {
+	return &monitoredTracker{Tracker: tracker}
+}
+
+func (t *monitoredTracker) CreateTx(tx *sqlx.Tx, id string, pointsTo []string, ttl time.Duration) (retErr error) {
+	defer func(start time.Time) { observeOperation("create", start, retErr) }(time.Now())
+	return t.Tracker.CreateTx(tx, id, pointsTo, ttl)
+}
+
+func (t *monitoredTracker) SetTTLPrefix(ctx context.Context, prefix string, ttl time.Duration) (_ time.Time, _ int, retErr error) {
+	defer func(start time.Time) { observeOperation("set_ttl_prefix", start, retErr) }(time.Now())
+	return t.Tracker.SetTTLPrefix(ctx, prefix, ttl)
+}
+
+func (t *monitoredTracker) SetTTL(ctx context.Context, id string, ttl time.Duration) (_ time.Time, retErr error) {
+	defer func(start time.Time) { observeOperation("set_ttl", start, retErr) }(time.Now())
+	return t.Tracker.SetTTL(ctx, id, ttl)
+}
+
+func (t *monitoredTracker) GetExpiresAt(ctx context.Context, id string) (_ time.Time, retErr error) {
+	defer func(start time.Time) { observeOperation("get_expires_at", start, retErr) }(time.Now())
+	return t.Tracker.GetExpiresAt(ctx, id)
+}
+
+func (t *monitoredTracker) GetDownstream(ctx context.Context, id string) (_ []string, retErr error) {
+	defer func(start time.Time) { observeOperation("get_downstream", start, retErr) }(time.Now())
+	return t.Tracker.GetDownstream(ctx, id)
+}
+
+func (t *monitoredTracker) GetUpstream(ctx context.Context, id string) (_ []string, retErr error) {
+	defer func(start time.Time) { observeOperation("get_upstream", start, retErr) }(time.Now())
+	return t.Tracker.GetUpstream(ctx, id)
+}
+
+func (t *monitoredTracker) DeleteTx(tx *sqlx.Tx, id string) (retErr error) {
+	defer func(start time.Time) { observeOperation("delete", start, retErr) }(time.Now())
+	return t.Tracker.DeleteTx(tx, id)
+}
+
+func (t *monitoredTracker) IterateDeletable(ctx context.Context, cb func(id string) error) (retErr error) {
+	defer func(start time.Time) { observeOperation("iterate_deletable", start, retErr) }(time.Now())
+	return t.Tracker.IterateDeletable(ctx, cb)
+}
@@ -84,6 +84,7 @@ type getPermissionsForPrincipalFunc func(context.Context, *auth.GetPermissionsFo
 type whoAmIFunc func(context.Context, *auth.WhoAmIRequest) (*auth.WhoAmIResponse, error)
 type getRolesForPermissionFunc func(context.Context, *auth.GetRolesForPermissionRequest) (*auth.GetRolesForPermissionResponse, error)
 type getOIDCLoginFunc func(context.Context, *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error)
+type getOIDCDeviceLoginFunc func(context.Context, *auth.GetOIDCDeviceLoginRequest) (*auth.GetOIDCDeviceLoginResponse, error)
 type getRobotTokenFunc func(context.Context, *auth.GetRobotTokenRequest) (*auth.GetRobotTokenResponse, error)
 type revokeAuthTokenFunc func(context.Context, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error)
 type revokeAuthTokensForUserFunc func(context.Context, *auth.RevokeAuthTokensForUserRequest) (*auth.RevokeAuthTokensForUserResponse, error)
@@ -113,6 +114,7 @@ type mockGetPermissionsForPrincipal struct {
 type mockWhoAmI struct{ handler whoAmIFunc }
 type mockGetRolesForPermission struct{ handler getRolesForPermissionFunc }
 type mockGetOIDCLogin struct{ handler getOIDCLoginFunc }
+type mockGetOIDCDeviceLogin struct{ handler getOIDCDeviceLoginFunc }
 type mockGetRobotToken struct{ handler getRobotTokenFunc }
 type mockRevokeAuthToken struct{ handler revokeAuthTokenFunc }
 type mockRevokeAuthTokensForUser struct{ handler revokeAuthTokensForUserFunc }
@@ -137,6 +139,7 @@ func (mock *mockAuthorize) Use(cb authorizeFunc)
 func (mock *mockWhoAmI) Use(cb whoAmIFunc)                                         { mock.handler = cb }
 func (mock *mockGetRolesForPermission) Use(cb getRolesForPermissionFunc)           { mock.handler = cb }
 func (mock *mockGetOIDCLogin) Use(cb getOIDCLoginFunc)                             { mock.handler = cb }
+func (mock *mockGetOIDCDeviceLogin) Use(cb getOIDCDeviceLoginFunc)                 { mock.handler = cb }
 func (mock *mockGetRobotToken) Use(cb getRobotTokenFunc)                           { mock.handler = cb }
 func (mock *mockRevokeAuthToken) Use(cb revokeAuthTokenFunc)                       { mock.handler = cb }
 func (mock *mockRevokeAuthTokensForUser) Use(cb revokeAuthTokensForUserFunc)       { mock.handler = cb }
@@ -171,6 +174,7 @@ type mockAuthServer struct {
 	WhoAmI                     mockWhoAmI
 	GetRolesForPermission      mockGetRolesForPermission
 	GetOIDCLogin               mockGetOIDCLogin
+	GetOIDCDeviceLogin         mockGetOIDCDeviceLogin
 	GetRobotToken              mockGetRobotToken
 	RevokeAuthToken            mockRevokeAuthToken
 	RevokeAuthTokensForUser    mockRevokeAuthTokensForUser
@@ -263,6 +267,12 @@ func (api *authServerAPI) GetOIDCLogin(ctx context.Context, req *auth.GetOIDCLog
 	}
 	return nil, errors.Errorf("unhandled pachd mock auth.GetOIDCLogin")
 }
+func (api *authServerAPI) GetOIDCDeviceLogin(ctx context.Context, req *auth.GetOIDCDeviceLoginRequest) (*auth.GetOIDCDeviceLoginResponse, error) {
+	if api.mock.GetOIDCDeviceLogin.handler != nil {
+		return api.mock.GetOIDCDeviceLogin.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.GetOIDCDeviceLogin")
+}
 func (api *authServerAPI) GetRobotToken(ctx context.Context, req *auth.GetRobotTokenRequest) (*auth.GetRobotTokenResponse, error) {
 	if api.mock.GetRobotToken.handler != nil {
 		return api.mock.GetRobotToken.handler(ctx, req)
@@ -411,6 +421,8 @@ type createRepoFunc func(context.Context, *pfs.CreateRepoRequest) (*types.Empty,
 type inspectRepoFunc func(context.Context, *pfs.InspectRepoRequest) (*pfs.RepoInfo, error)
 type listRepoFunc func(*pfs.ListRepoRequest, pfs.API_ListRepoServer) error
 type deleteRepoFunc func(context.Context, *pfs.DeleteRepoRequest) (*types.Empty, error)
+type setRepoQuotaFunc func(context.Context, *pfs.SetRepoQuotaRequest) (*types.Empty, error)
+type listRepoQuotaFunc func(*pfs.ListRepoQuotaRequest, pfs.API_ListRepoQuotaServer) error
 type startCommitFunc func(context.Context, *pfs.StartCommitRequest) (*pfs.Commit, error)
 type finishCommitFunc func(context.Context, *pfs.FinishCommitRequest) (*types.Empty, error)
 type inspectCommitFunc func(context.Context, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error)
@@ -435,6 +447,7 @@ type globFileFunc func(*pfs.GlobFileRequest, pfs.API_GlobFileServer) error
 type diffFileFunc func(*pfs.DiffFileRequest, pfs.API_DiffFileServer) error
 type deleteAllPFSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type fsckFunc func(*pfs.FsckRequest, pfs.API_FsckServer) error
+type sweepOrphansFunc func(*pfs.SweepOrphansRequest, pfs.API_SweepOrphansServer) error
 type createFileSetFunc func(pfs.API_CreateFileSetServer) error
 type addFileSetFunc func(context.Context, *pfs.AddFileSetRequest) (*types.Empty, error)
 type getFileSetFunc func(context.Context, *pfs.GetFileSetRequest) (*pfs.CreateFileSetResponse, error)
@@ -448,6 +461,8 @@ type mockCreateRepo struct{ handler createRepoFunc }
 type mockInspectRepo struct{ handler inspectRepoFunc }
 type mockListRepo struct{ handler listRepoFunc }
 type mockDeleteRepo struct{ handler deleteRepoFunc }
+type mockSetRepoQuota struct{ handler setRepoQuotaFunc }
+type mockListRepoQuota struct{ handler listRepoQuotaFunc }
 type mockStartCommit struct{ handler startCommitFunc }
 type mockFinishCommit struct{ handler finishCommitFunc }
 type mockInspectCommit struct{ handler inspectCommitFunc }
@@ -472,6 +487,7 @@ type mockGlobFile struct{ handler globFileFunc }
 type mockDiffFile struct{ handler diffFileFunc }
 type mockDeleteAllPFS struct{ handler deleteAllPFSFunc }
 type mockFsck struct{ handler fsckFunc }
+type mockSweepOrphans struct{ handler sweepOrphansFunc }
 type mockCreateFileSet struct{ handler createFileSetFunc }
 type mockAddFileSet struct{ handler addFileSetFunc }
 type mockGetFileSet struct{ handler getFileSetFunc }
@@ -485,6 +501,8 @@ func (mock *mockCreateRepo) Use(cb createRepoFunc)                 { mock.handle
 func (mock *mockInspectRepo) Use(cb inspectRepoFunc)               { mock.handler = cb }
 func (mock *mockListRepo) Use(cb listRepoFunc)                     { mock.handler = cb }
 func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                 { mock.handler = cb }
+func (mock *mockSetRepoQuota) Use(cb setRepoQuotaFunc)             { mock.handler = cb }
+func (mock *mockListRepoQuota) Use(cb listRepoQuotaFunc)           { mock.handler = cb }
 func (mock *mockStartCommit) Use(cb startCommitFunc)               { mock.handler = cb }
 func (mock *mockFinishCommit) Use(cb finishCommitFunc)             { mock.handler = cb }
 func (mock *mockInspectCommit) Use(cb inspectCommitFunc)           { mock.handler = cb }
@@ -509,6 +527,7 @@ func (mock *mockGlobFile) Use(cb globFileFunc)                     { mock.handle
 func (mock *mockDiffFile) Use(cb diffFileFunc)                     { mock.handler = cb }
 func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)             { mock.handler = cb }
 func (mock *mockFsck) Use(cb fsckFunc)                             { mock.handler = cb }
+func (mock *mockSweepOrphans) Use(cb sweepOrphansFunc)             { mock.handler = cb }
 func (mock *mockCreateFileSet) Use(cb createFileSetFunc)           { mock.handler = cb }
 func (mock *mockAddFileSet) Use(cb addFileSetFunc)                 { mock.handler = cb }
 func (mock *mockGetFileSet) Use(cb getFileSetFunc)                 { mock.handler = cb }
@@ -528,6 +547,8 @@ type mockPFSServer struct {
 	InspectRepo        mockInspectRepo
 	ListRepo           mockListRepo
 	DeleteRepo         mockDeleteRepo
+	SetRepoQuota       mockSetRepoQuota
+	ListRepoQuota      mockListRepoQuota
 	StartCommit        mockStartCommit
 	FinishCommit       mockFinishCommit
 	InspectCommit      mockInspectCommit
@@ -552,6 +573,7 @@ type mockPFSServer struct {
 	DiffFile           mockDiffFile
 	DeleteAll          mockDeleteAllPFS
 	Fsck               mockFsck
+	SweepOrphans       mockSweepOrphans
 	CreateFileSet      mockCreateFileSet
 	AddFileSet         mockAddFileSet
 	GetFileSet         mockGetFileSet
@@ -591,6 +613,18 @@ func (api *pfsServerAPI) DeleteRepo(ctx context.Context, req *pfs.DeleteRepoRequ
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteRepo")
 }
+func (api *pfsServerAPI) SetRepoQuota(ctx context.Context, req *pfs.SetRepoQuotaRequest) (*types.Empty, error) {
+	if api.mock.SetRepoQuota.handler != nil {
+		return api.mock.SetRepoQuota.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetRepoQuota")
+}
+func (api *pfsServerAPI) ListRepoQuota(req *pfs.ListRepoQuotaRequest, serv pfs.API_ListRepoQuotaServer) error {
+	if api.mock.ListRepoQuota.handler != nil {
+		return api.mock.ListRepoQuota.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListRepoQuota")
+}
 func (api *pfsServerAPI) StartCommit(ctx context.Context, req *pfs.StartCommitRequest) (*pfs.Commit, error) {
 	if api.mock.StartCommit.handler != nil {
 		return api.mock.StartCommit.handler(ctx, req)
@@ -735,6 +769,12 @@ func (api *pfsServerAPI) Fsck(req *pfs.FsckRequest, serv pfs.API_FsckServer) err
 	}
 	return errors.Errorf("unhandled pachd mock pfs.Fsck")
 }
+func (api *pfsServerAPI) SweepOrphans(req *pfs.SweepOrphansRequest, serv pfs.API_SweepOrphansServer) error {
+	if api.mock.SweepOrphans.handler != nil {
+		return api.mock.SweepOrphans.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.SweepOrphans")
+}
 func (api *pfsServerAPI) CreateFileSet(srv pfs.API_CreateFileSetServer) error {
 	if api.mock.CreateFileSet.handler != nil {
 		return api.mock.CreateFileSet.handler(srv)
@@ -797,12 +837,18 @@ type listPipelineFunc func(*pps.ListPipelineRequest, pps.API_ListPipelineServer)
 type deletePipelineFunc func(context.Context, *pps.DeletePipelineRequest) (*types.Empty, error)
 type startPipelineFunc func(context.Context, *pps.StartPipelineRequest) (*types.Empty, error)
 type stopPipelineFunc func(context.Context, *pps.StopPipelineRequest) (*types.Empty, error)
+type promotePipelineFunc func(context.Context, *pps.PromotePipelineRequest) (*types.Empty, error)
+type rollbackPipelineFunc func(context.Context, *pps.RollbackPipelineRequest) (*types.Empty, error)
 type runPipelineFunc func(context.Context, *pps.RunPipelineRequest) (*types.Empty, error)
 type runCronFunc func(context.Context, *pps.RunCronRequest) (*types.Empty, error)
 type createSecretFunc func(context.Context, *pps.CreateSecretRequest) (*types.Empty, error)
 type deleteSecretFunc func(context.Context, *pps.DeleteSecretRequest) (*types.Empty, error)
 type inspectSecretFunc func(context.Context, *pps.InspectSecretRequest) (*pps.SecretInfo, error)
 type listSecretFunc func(context.Context, *types.Empty) (*pps.SecretInfos, error)
+type createNotificationFunc func(context.Context, *pps.CreateNotificationRequest) (*types.Empty, error)
+type deleteNotificationFunc func(context.Context, *pps.DeleteNotificationRequest) (*types.Empty, error)
+type listNotificationFunc func(context.Context, *pps.ListNotificationRequest) (*pps.NotificationInfos, error)
+type watchPPSFunc func(*pps.WatchRequest, pps.API_WatchServer) error
 type deleteAllPPSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type getLogsFunc func(*pps.GetLogsRequest, pps.API_GetLogsServer) error
 type activateAuthPPSFunc func(context.Context, *pps.ActivateAuthRequest) (*pps.ActivateAuthResponse, error)
@@ -826,12 +872,18 @@ type mockListPipeline struct{ handler listPipelineFunc }
 type mockDeletePipeline struct{ handler deletePipelineFunc }
 type mockStartPipeline struct{ handler startPipelineFunc }
 type mockStopPipeline struct{ handler stopPipelineFunc }
+type mockPromotePipeline struct{ handler promotePipelineFunc }
+type mockRollbackPipeline struct{ handler rollbackPipelineFunc }
 type mockRunPipeline struct{ handler runPipelineFunc }
 type mockRunCron struct{ handler runCronFunc }
 type mockCreateSecret struct{ handler createSecretFunc }
 type mockDeleteSecret struct{ handler deleteSecretFunc }
 type mockInspectSecret struct{ handler inspectSecretFunc }
 type mockListSecret struct{ handler listSecretFunc }
+type mockCreateNotification struct{ handler createNotificationFunc }
+type mockDeleteNotification struct{ handler deleteNotificationFunc }
+type mockListNotification struct{ handler listNotificationFunc }
+type mockWatchPPS struct{ handler watchPPSFunc }
 type mockDeleteAllPPS struct{ handler deleteAllPPSFunc }
 type mockGetLogs struct{ handler getLogsFunc }
 type mockActivateAuthPPS struct{ handler activateAuthPPSFunc }
@@ -855,12 +907,18 @@ func (mock *mockListPipeline) Use(cb listPipelineFunc)                   { mock.
 func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)               { mock.handler = cb }
 func (mock *mockStartPipeline) Use(cb startPipelineFunc)                 { mock.handler = cb }
 func (mock *mockStopPipeline) Use(cb stopPipelineFunc)                   { mock.handler = cb }
+func (mock *mockPromotePipeline) Use(cb promotePipelineFunc)             { mock.handler = cb }
+func (mock *mockRollbackPipeline) Use(cb rollbackPipelineFunc)           { mock.handler = cb }
 func (mock *mockRunPipeline) Use(cb runPipelineFunc)                     { mock.handler = cb }
 func (mock *mockRunCron) Use(cb runCronFunc)                             { mock.handler = cb }
 func (mock *mockCreateSecret) Use(cb createSecretFunc)                   { mock.handler = cb }
 func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)                   { mock.handler = cb }
 func (mock *mockInspectSecret) Use(cb inspectSecretFunc)                 { mock.handler = cb }
 func (mock *mockListSecret) Use(cb listSecretFunc)                       { mock.handler = cb }
+func (mock *mockCreateNotification) Use(cb createNotificationFunc)       { mock.handler = cb }
+func (mock *mockDeleteNotification) Use(cb deleteNotificationFunc)       { mock.handler = cb }
+func (mock *mockListNotification) Use(cb listNotificationFunc)           { mock.handler = cb }
+func (mock *mockWatchPPS) Use(cb watchPPSFunc)                           { mock.handler = cb }
 func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)                   { mock.handler = cb }
 func (mock *mockGetLogs) Use(cb getLogsFunc)                             { mock.handler = cb }
 func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc)             { mock.handler = cb }
@@ -890,12 +948,18 @@ type mockPPSServer struct {
 	DeletePipeline     mockDeletePipeline
 	StartPipeline      mockStartPipeline
 	StopPipeline       mockStopPipeline
+	PromotePipeline    mockPromotePipeline
+	RollbackPipeline   mockRollbackPipeline
 	RunPipeline        mockRunPipeline
 	RunCron            mockRunCron
 	CreateSecret       mockCreateSecret
 	DeleteSecret       mockDeleteSecret
 	InspectSecret      mockInspectSecret
 	ListSecret         mockListSecret
+	CreateNotification mockCreateNotification
+	DeleteNotification mockDeleteNotification
+	ListNotification   mockListNotification
+	Watch              mockWatchPPS
 	DeleteAll          mockDeleteAllPPS
 	GetLogs            mockGetLogs
 	ActivateAuth       mockActivateAuthPPS
@@ -1005,6 +1069,18 @@ func (api *ppsServerAPI) StopPipeline(ctx context.Context, req *pps.StopPipeline
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.StopPipeline")
 }
+func (api *ppsServerAPI) PromotePipeline(ctx context.Context, req *pps.PromotePipelineRequest) (*types.Empty, error) {
+	if api.mock.PromotePipeline.handler != nil {
+		return api.mock.PromotePipeline.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.PromotePipeline")
+}
+func (api *ppsServerAPI) RollbackPipeline(ctx context.Context, req *pps.RollbackPipelineRequest) (*types.Empty, error) {
+	if api.mock.RollbackPipeline.handler != nil {
+		return api.mock.RollbackPipeline.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.RollbackPipeline")
+}
 func (api *ppsServerAPI) RunPipeline(ctx context.Context, req *pps.RunPipelineRequest) (*types.Empty, error) {
 	if api.mock.RunPipeline.handler != nil {
 		return api.mock.RunPipeline.handler(ctx, req)
@@ -1041,6 +1117,30 @@ func (api *ppsServerAPI) ListSecret(ctx context.Context, in *types.Empty) (*pps.
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.ListSecret")
 }
+func (api *ppsServerAPI) CreateNotification(ctx context.Context, req *pps.CreateNotificationRequest) (*types.Empty, error) {
+	if api.mock.CreateNotification.handler != nil {
+		return api.mock.CreateNotification.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.CreateNotification")
+}
+func (api *ppsServerAPI) DeleteNotification(ctx context.Context, req *pps.DeleteNotificationRequest) (*types.Empty, error) {
+	if api.mock.DeleteNotification.handler != nil {
+		return api.mock.DeleteNotification.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.DeleteNotification")
+}
+func (api *ppsServerAPI) ListNotification(ctx context.Context, req *pps.ListNotificationRequest) (*pps.NotificationInfos, error) {
+	if api.mock.ListNotification.handler != nil {
+		return api.mock.ListNotification.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ListNotification")
+}
+func (api *ppsServerAPI) Watch(req *pps.WatchRequest, serv pps.API_WatchServer) error {
+	if api.mock.Watch.handler != nil {
+		return api.mock.Watch.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pps.Watch")
+}
 func (api *ppsServerAPI) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
 	if api.mock.DeleteAll.handler != nil {
 		return api.mock.DeleteAll.handler(ctx, req)
@@ -23,6 +23,7 @@ import (
 	authtesting "github.com/pachyderm/pachyderm/v2/src/server/auth/testing"
 	pfsapi "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs/server"
+	ppsapi "github.com/pachyderm/pachyderm/v2/src/server/pps"
 	proxyserver "github.com/pachyderm/pachyderm/v2/src/server/proxy/server"
 	txnserver "github.com/pachyderm/pachyderm/v2/src/server/transaction/server"
 )
@@ -40,13 +41,32 @@ type RealEnv struct {
 	TransactionServer        txnserver.APIServer
 	ProxyServer              proxy.APIServer
 	MockPPSTransactionServer *MockPPSTransactionServer
+	// PPSServer is only set by NewRealEnvWithPPS
+	PPSServer ppsapi.APIServer
 }
 
 // NewRealEnv constructs a MockEnv, then forwards all API calls to go to API
-// server instances for supported operations. PPS requires a kubernetes
-// environment in order to spin up pipelines, which is not yet supported by this
-// package, but the other API servers work.
+// server instances for supported operations. PPS is mocked (see
+// NewRealEnvWithPPS), but the other API servers work.
 func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
+	return newRealEnv(t, nil, customOpts...)
+}
+
+// PPSServerConstructor constructs the PPS API server of a RealEnv. This
+// package can't import the PPS server (which depends on packages whose tests
+// use this package), so NewRealEnvWithPPS takes one of these, which is
+// typically ppsserver.NewAPIServerFromServiceEnv.
+type PPSServerConstructor func(serviceenv.ServiceEnv, *txnenv.TransactionEnv) (ppsapi.APIServer, error)
+
+// NewRealEnvWithPPS is like NewRealEnv, but also runs a real PPS API server,
+// which runs pipeline workers as subprocesses using the local worker runtime.
+// The worker binary must be on the PATH, or set with a custom option (see
+// serviceenv.PachdSpecificConfiguration.PPSLocalWorkerBinary).
+func NewRealEnvWithPPS(t testing.TB, newPPSServer PPSServerConstructor, customOpts ...serviceenv.ConfigOption) *RealEnv {
+	return newRealEnv(t, newPPSServer, customOpts...)
+}
+
+func newRealEnv(t testing.TB, newPPSServer PPSServerConstructor, customOpts ...serviceenv.ConfigOption) *RealEnv {
 	mockEnv := NewMockEnv(t)
 
 	realEnv := &RealEnv{MockEnv: *mockEnv}
@@ -63,6 +83,12 @@ func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
 		serviceenv.WithEtcdHostPort(etcdClientURL.Hostname(), etcdClientURL.Port()),
 		serviceenv.WithPachdPeerPort(uint16(realEnv.MockPachd.Addr.(*net.TCPAddr).Port)),
 	}
+	if newPPSServer != nil {
+		opts = append(opts, func(config *serviceenv.Configuration) {
+			config.PPSWorkerRuntime = "local"
+			config.PPSLocalWorkerRoot = path.Join(realEnv.Directory, "workers")
+		})
+	}
 	opts = append(opts, customOpts...) // Overwrite with any custom options
 	realEnv.ServiceEnv = serviceenv.InitServiceEnv(serviceenv.ConfigFromOptions(opts...))
 
@@ -102,15 +128,21 @@ func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
 	realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPfsServer(realEnv.PFSServer)
 
 	// PPS
-	realEnv.MockPPSTransactionServer = NewMockPPSTransactionServer()
-	realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(&realEnv.MockPPSTransactionServer.api)
-	realEnv.MockPPSTransactionServer.InspectPipelineInTransaction.
-		Use(func(txnctx *txncontext.TransactionContext, name string) (*pps.PipelineInfo, error) {
-			return nil, col.ErrNotFound{
-				Type: "pipelines",
-				Key:  name,
-			}
-		})
+	if newPPSServer != nil {
+		realEnv.PPSServer, err = newPPSServer(realEnv.ServiceEnv, txnEnv)
+		require.NoError(t, err)
+		realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(realEnv.PPSServer)
+	} else {
+		realEnv.MockPPSTransactionServer = NewMockPPSTransactionServer()
+		realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(&realEnv.MockPPSTransactionServer.api)
+		realEnv.MockPPSTransactionServer.InspectPipelineInTransaction.
+			Use(func(txnctx *txncontext.TransactionContext, name string) (*pps.PipelineInfo, error) {
+				return nil, col.ErrNotFound{
+					Type: "pipelines",
+					Key:  name,
+				}
+			})
+	}
 
 	realEnv.TransactionServer, err = txnserver.NewAPIServer(realEnv.ServiceEnv, txnEnv)
 	require.NoError(t, err)
@@ -122,6 +154,9 @@ func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
 	linkServers(&realEnv.MockPachd.Auth, realEnv.AuthServer)
 	linkServers(&realEnv.MockPachd.Transaction, realEnv.TransactionServer)
 	linkServers(&realEnv.MockPachd.Proxy, realEnv.ProxyServer)
+	if newPPSServer != nil {
+		linkServers(&realEnv.MockPachd.PPS, realEnv.PPSServer)
+	}
 
 	return realEnv
 }
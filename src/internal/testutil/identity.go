@@ -80,7 +80,7 @@ func ConfigureOIDCProvider(t *testing.T) error {
 		Client: &identity.OIDCClient{
 			Id:           "pachyderm",
 			Name:         "pachyderm",
-			RedirectUris: []string{"http://pachd:1657/authorization-code/callback"},
+			RedirectUris: []string{"http://pachd:1657/authorization-code/callback", identity.DeviceCallbackRedirectURI},
 			Secret:       "notsecret",
 			TrustedPeers: []string{"testapp"},
 		},
@@ -128,10 +128,50 @@ func DoOAuthExchange(t testing.TB, pachClient, enterpriseClient *client.APIClien
 	require.NoError(t, err)
 
 	// Follow the resulting redirect back to pachd to complete the flow
-	_, err = c.Get(RewriteRedirect(t, resp, pachHost(pachClient)))
+	_, err = c.Get(RewriteRedirect(t, resp, PachHost(pachClient)))
 	require.NoError(t, err)
 }
 
+// DoDeviceLogin approves a device login with the mock provider, given the
+// user code returned by GetOIDCDeviceLogin
+func DoDeviceLogin(t testing.TB, enterpriseClient *client.APIClient, userCode string) {
+	// Create an HTTP client that doesn't follow redirects, as in DoOAuthExchange
+	c := &http.Client{}
+	c.CheckRedirect = func(_ *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	// Submit the user code, as the user would on the verification page, which
+	// redirects to the dex login page
+	vals := make(url.Values)
+	vals.Add("user_code", userCode)
+	resp, err := c.PostForm(RewriteURL(t, "http://pachd:1658/device/auth/verify_code", DexHost(enterpriseClient)), vals)
+	require.NoError(t, err)
+
+	// Dex login redirects to the provider page, which will generate it's own state
+	resp, err = c.Get(RewriteRedirect(t, resp, DexHost(enterpriseClient)))
+	require.NoError(t, err)
+	resp, err = c.Get(RewriteRedirect(t, resp, DexHost(enterpriseClient)))
+	require.NoError(t, err)
+
+	// POST our hard-coded credentials to the login page
+	vals = make(url.Values)
+	vals.Add("login", "admin")
+	vals.Add("password", "password")
+	resp, err = c.PostForm(RewriteRedirect(t, resp, DexHost(enterpriseClient)), vals)
+	require.NoError(t, err)
+
+	// The username/password flow redirects back to the dex /approval endpoint
+	resp, err = c.Get(RewriteRedirect(t, resp, DexHost(enterpriseClient)))
+	require.NoError(t, err)
+
+	// Follow the resulting redirect to the dex device callback, which completes
+	// the device login
+	resp, err = c.Get(RewriteRedirect(t, resp, DexHost(enterpriseClient)))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func GetOIDCTokenForTrustedApp(t testing.TB) string {
 	// Create an HTTP client that doesn't follow redirects.
 	// We rewrite the host names for each redirect to avoid issues because
@@ -215,7 +255,9 @@ func DexHost(c *client.APIClient) string {
 	return c.GetAddress().Host + ":30658"
 }
 
-func pachHost(c *client.APIClient) string {
+// PachHost returns the address to access pachd's OIDC and SCIM HTTP server
+// during tests
+func PachHost(c *client.APIClient) string {
 	if c.GetAddress().Port == 1650 {
 		return c.GetAddress().Host + ":1657"
 	}
@@ -82,11 +82,11 @@ func RunLocal() (retErr error) {
 		log.SetLevel(log.InfoLevel)
 	}
 
-	// must run InstallJaegerTracer before InitWithKube/pach client initialization
-	if endpoint := tracing.InstallJaegerTracerFromEnv(); endpoint != "" {
-		log.Printf("connecting to Jaeger at %q", endpoint)
+	// must run InstallTracerFromEnv before InitWithKube/pach client initialization
+	if endpoint := tracing.InstallTracerFromEnv(); endpoint != "" {
+		log.Printf("reporting traces to %q", endpoint)
 	} else {
-		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
+		log.Printf("no trace collector found (neither OTEL_EXPORTER_OTLP_ENDPOINT nor JAEGER_COLLECTOR_SERVICE_HOST is set)")
 	}
 	env := serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
 	debug.SetGCPercent(env.Config().GCPercent)
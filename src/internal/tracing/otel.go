@@ -0,0 +1,175 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otbridge "go.opentelemetry.io/otel/bridge/opentracing"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// otlpEndpointEnvVar is the standard OpenTelemetry environment variable
+	// for the base URL of an OTLP/HTTP collector (e.g.
+	// http://otel-collector:4318). Traces are posted to <endpoint>/v1/traces.
+	otlpEndpointEnvVar = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	// otlpTracesEndpointEnvVar overrides otlpEndpointEnvVar with the full URL
+	// that traces are posted to.
+	otlpTracesEndpointEnvVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	// otlpHeadersEnvVar is a comma-separated list of key=value headers to send
+	// with each export request (e.g. for authenticating to a hosted
+	// collector).
+	otlpHeadersEnvVar = "OTEL_EXPORTER_OTLP_HEADERS"
+	// otelServiceNameEnvVar overrides the service name reported with spans.
+	otelServiceNameEnvVar = "OTEL_SERVICE_NAME"
+	// otelSamplerArgEnvVar is the fraction of new traces (i.e. traces that
+	// aren't continuing a trace from an incoming RPC) that are recorded.
+	otelSamplerArgEnvVar = "OTEL_TRACES_SAMPLER_ARG"
+	// defaultSampleRatio is the fraction of new traces that are recorded if
+	// otelSamplerArgEnvVar is unset. Every job starts a new trace, so recording
+	// all of them would be expensive on busy clusters.
+	defaultSampleRatio = 0.01
+)
+
+// ExporterEnvVars are the environment variables which configure OTLP export.
+// pachd passes them through to the worker pods that it creates.
+var ExporterEnvVars = []string{
+	otlpEndpointEnvVar,
+	otlpTracesEndpointEnvVar,
+	otlpHeadersEnvVar,
+	otelSamplerArgEnvVar,
+}
+
+// otelProvider is the OpenTelemetry tracer provider installed by
+// InstallTracerFromEnv, or nil if traces aren't being exported over OTLP.
+var otelProvider *sdktrace.TracerProvider
+
+// otlpEndpointFromEnv returns the URL that traces should be posted to, or ""
+// if no OTLP collector is configured.
+func otlpEndpointFromEnv() string {
+	if endpoint := os.Getenv(otlpTracesEndpointEnvVar); endpoint != "" {
+		return endpoint
+	}
+	if endpoint := os.Getenv(otlpEndpointEnvVar); endpoint != "" {
+		return strings.TrimSuffix(endpoint, "/") + "/v1/traces"
+	}
+	return ""
+}
+
+// installOTLPTracer installs an OpenTelemetry tracer that exports spans to
+// 'endpoint'. The existing opentracing-based instrumentation is preserved by
+// installing the OpenTelemetry opentracing bridge as the global opentracing
+// tracer, and trace context is propagated in the W3C trace-context format.
+func installOTLPTracer(endpoint string) {
+	serviceName := os.Getenv(otelServiceNameEnvVar)
+	if serviceName == "" {
+		serviceName = JaegerServiceName
+	}
+	res, err := resource.Merge(resource.Environment(), resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		log.Errorf("could not merge OpenTelemetry resource attributes: %v", err)
+		res = resource.NewSchemaless(attribute.String("service.name", serviceName))
+	}
+	sampleRatio := defaultSampleRatio
+	if arg := os.Getenv(otelSamplerArgEnvVar); arg != "" {
+		if sampleRatio, err = strconv.ParseFloat(arg, 64); err != nil {
+			log.Errorf("could not parse %s: %v, sampling %v of traces", otelSamplerArgEnvVar, err, defaultSampleRatio)
+			sampleRatio = defaultSampleRatio
+		}
+	}
+	otelProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(newOTLPExporter(endpoint, parseOTLPHeaders(os.Getenv(otlpHeadersEnvVar)))),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	bridge, wrapperProvider := otbridge.NewTracerPair(otelProvider.Tracer("github.com/pachyderm/pachyderm"))
+	bridge.SetTextMapPropagator(propagator)
+	bridge.SetWarningHandler(func(msg string) {
+		log.Debugf("opentracing bridge: %s", msg)
+	})
+	otel.SetTracerProvider(wrapperProvider)
+	otel.SetTextMapPropagator(propagator)
+	opentracing.SetGlobalTracer(textMapBridgeTracer{bridge})
+}
+
+func parseOTLPHeaders(s string) map[string]string {
+	headers := make(map[string]string)
+	for _, kv := range strings.Split(s, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			continue
+		}
+		headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return headers
+}
+
+// textMapBridgeTracer wraps the opentracing bridge, which only supports
+// injecting into and extracting from opentracing.HTTPHeadersCarrier, so that
+// it works with any TextMap carrier (e.g. the gRPC metadata carrier used by
+// otgrpc, and the carrier used by extended traces).
+type textMapBridgeTracer struct {
+	*otbridge.BridgeTracer
+}
+
+func (t textMapBridgeTracer) Inject(sc opentracing.SpanContext, format interface{}, carrier interface{}) error {
+	w, ok := carrier.(opentracing.TextMapWriter)
+	if !ok {
+		return opentracing.ErrInvalidCarrier
+	}
+	header := http.Header{}
+	if err := t.BridgeTracer.Inject(sc, opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(header)); err != nil {
+		return err
+	}
+	for k := range header {
+		w.Set(strings.ToLower(k), header.Get(k))
+	}
+	return nil
+}
+
+func (t textMapBridgeTracer) Extract(format interface{}, carrier interface{}) (opentracing.SpanContext, error) {
+	r, ok := carrier.(opentracing.TextMapReader)
+	if !ok {
+		return nil, opentracing.ErrInvalidCarrier
+	}
+	header := http.Header{}
+	if err := r.ForeachKey(func(k, v string) error {
+		header.Add(k, v)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return t.BridgeTracer.Extract(opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(header))
+}
+
+// EnvVars returns environment variables carrying the trace context of the span
+// in 'ctx' (if any), so that a child process can continue the trace. Each
+// propagation header is converted to an environment variable by upper-casing
+// it and replacing '-' with '_', so W3C trace context is passed in TRACEPARENT
+// and TRACESTATE.
+func EnvVars(ctx context.Context) []string {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return nil
+	}
+	header := http.Header{}
+	if err := span.Tracer().Inject(span.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(header)); err != nil {
+		return nil
+	}
+	var env []string
+	for k := range header {
+		name := strings.ToUpper(strings.Replace(k, "-", "_", -1))
+		env = append(env, name+"="+header.Get(k))
+	}
+	return env
+}
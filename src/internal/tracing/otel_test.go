@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/otlp"
+	otbridge "go.opentelemetry.io/otel/bridge/opentracing"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func newTestTracer(t *testing.T, exporter sdktrace.SpanExporter) (*sdktrace.TracerProvider, opentracing.Tracer) {
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() {
+		require.NoError(t, tp.Shutdown(context.Background()))
+	})
+	bridge, _ := otbridge.NewTracerPair(tp.Tracer("test"))
+	bridge.SetTextMapPropagator(propagation.TraceContext{})
+	return tp, textMapBridgeTracer{bridge}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var reqs []*otlp.ExportTraceServiceRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+		require.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		require.Equal(t, "secret", r.Header.Get("Authorization"))
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := &otlp.ExportTraceServiceRequest{}
+		require.NoError(t, req.Unmarshal(body))
+		reqs = append(reqs, req)
+	}))
+	defer srv.Close()
+	_, tracer := newTestTracer(t, newOTLPExporter(srv.URL+"/v1/traces", parseOTLPHeaders("Authorization=secret")))
+
+	parent := tracer.StartSpan("parent")
+	child := tracer.StartSpan("child", opentracing.ChildOf(parent.Context()), opentracing.Tag{Key: "datum", Value: "abc"})
+	child.Finish()
+	parent.Finish()
+
+	require.Equal(t, 2, len(reqs))
+	childSpan := reqs[0].ResourceSpans[0].InstrumentationLibrarySpans[0].Spans[0]
+	parentSpan := reqs[1].ResourceSpans[0].InstrumentationLibrarySpans[0].Spans[0]
+	require.Equal(t, "child", childSpan.Name)
+	require.Equal(t, "parent", parentSpan.Name)
+	require.Equal(t, 16, len(parentSpan.TraceId))
+	require.Equal(t, 8, len(parentSpan.SpanId))
+	require.Equal(t, parentSpan.TraceId, childSpan.TraceId)
+	require.Equal(t, parentSpan.SpanId, childSpan.ParentSpanId)
+	require.Equal(t, 0, len(parentSpan.ParentSpanId))
+	require.True(t, parentSpan.StartTimeUnixNano > 0 && parentSpan.StartTimeUnixNano <= parentSpan.EndTimeUnixNano)
+	var found bool
+	for _, attr := range childSpan.Attributes {
+		if attr.Key == "datum" {
+			require.Equal(t, "abc", attr.Value.GetStringValue())
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestTraceContextPropagation(t *testing.T) {
+	_, tracer := newTestTracer(t, newOTLPExporter("http://localhost:0", nil))
+	span := tracer.StartSpan("span")
+	defer span.Finish()
+
+	// Inject into and extract from an arbitrary TextMap carrier, as otgrpc does
+	// with gRPC metadata.
+	carrier := opentracing.TextMapCarrier{}
+	require.NoError(t, tracer.Inject(span.Context(), opentracing.HTTPHeaders, carrier))
+	traceparent, ok := carrier["traceparent"]
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(traceparent, "00-"))
+	sc, err := tracer.Extract(opentracing.HTTPHeaders, carrier)
+	require.NoError(t, err)
+	remote := tracer.StartSpan("remote", opentracing.ChildOf(sc))
+	defer remote.Finish()
+
+	// The trace context is passed to child processes in TRACEPARENT.
+	env := EnvVars(opentracing.ContextWithSpan(context.Background(), span))
+	require.OneOfEquals(t, "TRACEPARENT="+traceparent, env)
+	require.Equal(t, 0, len(EnvVars(context.Background())))
+}
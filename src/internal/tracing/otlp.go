@@ -0,0 +1,205 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/otlp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// otlpExporter is an OpenTelemetry span exporter which sends spans to an OTLP
+// collector over HTTP, using the protobuf encoding of OTLP. The messages are
+// generated from the OTLP protos (see the otlp package), as the exporter
+// provided by OpenTelemetry requires a newer protobuf runtime than the one
+// we're pinned to (see go.mod).
+type otlpExporter struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+}
+
+// newOTLPExporter returns an exporter which posts spans to 'endpoint', which
+// must be the full URL of the collector's traces endpoint (e.g.
+// http://otel-collector:4318/v1/traces).
+func newOTLPExporter(endpoint string, headers map[string]string) *otlpExporter {
+	return &otlpExporter{
+		endpoint: endpoint,
+		headers:  headers,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// ExportSpans implements sdktrace.SpanExporter.
+func (e *otlpExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) (retErr error) {
+	if len(spans) == 0 {
+		return nil
+	}
+	body, err := encodeSpans(spans).Marshal()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("OTLP collector at %q returned %s: %s", e.endpoint, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// Shutdown implements sdktrace.SpanExporter.
+func (e *otlpExporter) Shutdown(ctx context.Context) error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+func encodeSpans(spans []sdktrace.ReadOnlySpan) *otlp.ExportTraceServiceRequest {
+	req := &otlp.ExportTraceServiceRequest{}
+	resourceSpans := make(map[string]*otlp.ResourceSpans)
+	librarySpans := make(map[string]*otlp.InstrumentationLibrarySpans)
+	for _, span := range spans {
+		var resourceKey string
+		var resourceAttrs []attribute.KeyValue
+		if res := span.Resource(); res != nil {
+			resourceKey = res.Encoded(attribute.DefaultEncoder())
+			resourceAttrs = res.Attributes()
+		}
+		rs, ok := resourceSpans[resourceKey]
+		if !ok {
+			rs = &otlp.ResourceSpans{Resource: &otlp.Resource{Attributes: encodeAttributes(resourceAttrs)}}
+			resourceSpans[resourceKey] = rs
+			req.ResourceSpans = append(req.ResourceSpans, rs)
+		}
+		lib := span.InstrumentationLibrary()
+		libraryKey := resourceKey + "\x00" + lib.Name + "\x00" + lib.Version
+		ls, ok := librarySpans[libraryKey]
+		if !ok {
+			ls = &otlp.InstrumentationLibrarySpans{
+				InstrumentationLibrary: &otlp.InstrumentationLibrary{Name: lib.Name, Version: lib.Version},
+			}
+			librarySpans[libraryKey] = ls
+			rs.InstrumentationLibrarySpans = append(rs.InstrumentationLibrarySpans, ls)
+		}
+		ls.Spans = append(ls.Spans, encodeSpan(span))
+	}
+	return req
+}
+
+func encodeSpan(span sdktrace.ReadOnlySpan) *otlp.Span {
+	sc := span.SpanContext()
+	traceID, spanID := sc.TraceID(), sc.SpanID()
+	s := &otlp.Span{
+		TraceId:           traceID[:],
+		SpanId:            spanID[:],
+		TraceState:        sc.TraceState().String(),
+		Name:              span.Name(),
+		Kind:              otlp.Span_SpanKind(span.SpanKind()),
+		StartTimeUnixNano: unixNano(span.StartTime()),
+		EndTimeUnixNano:   unixNano(span.EndTime()),
+		Attributes:        encodeAttributes(span.Attributes()),
+		Status:            &otlp.Status{},
+	}
+	if parent := span.Parent(); parent.HasSpanID() {
+		parentID := parent.SpanID()
+		s.ParentSpanId = parentID[:]
+	}
+	for _, event := range span.Events() {
+		s.Events = append(s.Events, &otlp.Span_Event{
+			TimeUnixNano: unixNano(event.Time),
+			Name:         event.Name,
+			Attributes:   encodeAttributes(event.Attributes),
+		})
+	}
+	switch status := span.Status(); status.Code {
+	case codes.Ok:
+		s.Status.Code = otlp.Status_STATUS_CODE_OK
+	case codes.Error:
+		s.Status.Code = otlp.Status_STATUS_CODE_ERROR
+		s.Status.Message = status.Description
+	}
+	return s
+}
+
+func unixNano(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano())
+}
+
+func encodeAttributes(attrs []attribute.KeyValue) []*otlp.KeyValue {
+	var result []*otlp.KeyValue
+	for _, attr := range attrs {
+		result = append(result, &otlp.KeyValue{Key: string(attr.Key), Value: encodeValue(attr.Value)})
+	}
+	return result
+}
+
+func encodeValue(v attribute.Value) *otlp.AnyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		return &otlp.AnyValue{Value: &otlp.AnyValue_BoolValue{BoolValue: v.AsBool()}}
+	case attribute.INT64:
+		return &otlp.AnyValue{Value: &otlp.AnyValue_IntValue{IntValue: v.AsInt64()}}
+	case attribute.FLOAT64:
+		return &otlp.AnyValue{Value: &otlp.AnyValue_DoubleValue{DoubleValue: v.AsFloat64()}}
+	case attribute.STRING:
+		return &otlp.AnyValue{Value: &otlp.AnyValue_StringValue{StringValue: v.AsString()}}
+	case attribute.BOOLSLICE:
+		var values []*otlp.AnyValue
+		for _, b := range v.AsBoolSlice() {
+			values = append(values, encodeValue(attribute.BoolValue(b)))
+		}
+		return arrayValue(values)
+	case attribute.INT64SLICE:
+		var values []*otlp.AnyValue
+		for _, i := range v.AsInt64Slice() {
+			values = append(values, encodeValue(attribute.Int64Value(i)))
+		}
+		return arrayValue(values)
+	case attribute.FLOAT64SLICE:
+		var values []*otlp.AnyValue
+		for _, f := range v.AsFloat64Slice() {
+			values = append(values, encodeValue(attribute.Float64Value(f)))
+		}
+		return arrayValue(values)
+	case attribute.STRINGSLICE:
+		var values []*otlp.AnyValue
+		for _, s := range v.AsStringSlice() {
+			values = append(values, encodeValue(attribute.StringValue(s)))
+		}
+		return arrayValue(values)
+	default:
+		return &otlp.AnyValue{Value: &otlp.AnyValue_StringValue{StringValue: fmt.Sprint(v.AsInterface())}}
+	}
+}
+
+func arrayValue(values []*otlp.AnyValue) *otlp.AnyValue {
+	return &otlp.AnyValue{Value: &otlp.AnyValue_ArrayValue{ArrayValue: &otlp.ArrayValue{Values: values}}}
+}
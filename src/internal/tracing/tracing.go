@@ -81,6 +81,18 @@ func TagAnySpan(spanBox interface{}, kvs ...interface{}) opentracing.Span {
 	return span
 }
 
+// StartSpan is like AddSpanToAnyExisting, except that if 'ctx' has no span and
+// spans are being exported over OTLP, it starts a new trace (which is recorded
+// according to OTEL_TRACES_SAMPLER_ARG).
+func StartSpan(ctx context.Context, operation string, kvs ...interface{}) (opentracing.Span, context.Context) {
+	if opentracing.SpanFromContext(ctx) != nil || otelProvider == nil {
+		return AddSpanToAnyExisting(ctx, operation, kvs...)
+	}
+	span := opentracing.StartSpan(operation)
+	span = TagAnySpan(span, kvs...)
+	return span, opentracing.ContextWithSpan(ctx, span)
+}
+
 // AddSpanToAnyExisting checks 'ctx' for Jaeger tracing information, and if
 // tracing metadata is present, it generates a new span for 'operation', marks
 // it as a child of the existing span, and returns it.
@@ -93,6 +105,39 @@ func AddSpanToAnyExisting(ctx context.Context, operation string, kvs ...interfac
 	return nil, ctx
 }
 
+// SerializeSpan returns the context of the span in 'ctx' (if any) as a set of
+// key/value pairs, so that it can be stored alongside work that is processed
+// elsewhere. It returns nil if 'ctx' has no span.
+func SerializeSpan(ctx context.Context) map[string]string {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return nil
+	}
+	carrier := opentracing.TextMapCarrier{}
+	if err := span.Tracer().Inject(span.Context(), opentracing.TextMap, carrier); err != nil {
+		log.Errorf("could not serialize span context: %v", err)
+		return nil
+	}
+	return carrier
+}
+
+// ContinueSpan is like AddSpanToAnyExisting, except that the new span is a
+// child of the span serialized in 'serialized' by SerializeSpan, if there is
+// one.
+func ContinueSpan(ctx context.Context, serialized map[string]string, operation string, kvs ...interface{}) (opentracing.Span, context.Context) {
+	if len(serialized) == 0 || !IsActive() {
+		return AddSpanToAnyExisting(ctx, operation, kvs...)
+	}
+	parent, err := opentracing.GlobalTracer().Extract(opentracing.TextMap, opentracing.TextMapCarrier(serialized))
+	if err != nil {
+		log.Errorf("could not extract span context: %v", err)
+		return AddSpanToAnyExisting(ctx, operation, kvs...)
+	}
+	span := opentracing.StartSpan(operation, opentracing.ChildOf(parent))
+	span = TagAnySpan(span, kvs...)
+	return span, opentracing.ContextWithSpan(ctx, span)
+}
+
 // FinishAnySpan calls span.Finish() if span is not nil. Pairs with
 // AddSpanToAnyExisting
 func FinishAnySpan(span opentracing.Span, kvs ...interface{}) {
@@ -102,8 +147,36 @@ func FinishAnySpan(span opentracing.Span, kvs ...interface{}) {
 	}
 }
 
+// tracerOnce is used to ensure that a tracer is only installed once
+var tracerOnce sync.Once
+
+// tracerEndpoint is set using tracerOnce on startup, and then returned by
+// future calls to InstallTracerFromEnv
+var tracerEndpoint string
+
+// InstallTracerFromEnv installs a global tracer, relying on environment
+// variables to configure it. If OTEL_EXPORTER_OTLP_ENDPOINT (or
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT) is set, spans are exported to that OTLP
+// collector and trace context is propagated in the W3C trace-context format.
+// Otherwise, a Jaeger client is installed if a Jaeger collector is configured
+// (see InstallJaegerTracerFromEnv). It returns the endpoint that traces are
+// sent to, or "" if tracing is disabled.
+func InstallTracerFromEnv() string {
+	tracerOnce.Do(func() {
+		if endpoint := otlpEndpointFromEnv(); endpoint != "" {
+			installOTLPTracer(endpoint)
+			tracerEndpoint = endpoint
+			return
+		}
+		tracerEndpoint = InstallJaegerTracerFromEnv()
+	})
+	return tracerEndpoint
+}
+
 // InstallJaegerTracerFromEnv installs a Jaeger client as the opentracing global
-// tracer, relying on environment variables to configure the client
+// tracer, relying on environment variables to configure the client. Most
+// callers should use InstallTracerFromEnv, which prefers OTLP when it's
+// configured.
 func InstallJaegerTracerFromEnv() string {
 	jaegerOnce.Do(func() {
 		var onUserMachine bool
@@ -169,7 +242,7 @@ func addTraceIfTracingEnabled(
 	// Always trace if PACH_TRACE is on
 	if _, shortTracingOn := os.LookupEnv(ShortTraceEnvVar); shortTracingOn {
 		if !IsActive() {
-			log.Error("PACH_TRACE is set, indicating tracing is requested, but no connection to Jaeger has been established")
+			log.Error("PACH_TRACE is set, indicating tracing is requested, but no tracer has been installed")
 		}
 		return true
 	}
@@ -181,14 +254,18 @@ func addTraceIfTracingEnabled(
 	if jaegerCtx, ok := parentSpanCtx.(jaeger.SpanContext); ok {
 		return jaegerCtx.IsValid()
 	}
+	// The OpenTelemetry bridge only extracts valid span contexts
+	if otelProvider != nil {
+		return true
+	}
 	// Non-Jaeger context. This shouldn't happen, unless some Pachyderm user is
 	// propagating e.g. Zipkin traces through the Pachyderm client. In that
 	// case, we wouldn't know where to report traces anyway
 	return false
 }
 
-// IsActive returns true if a connection to Jaeger has been established and a
-// global tracer has been installed
+// IsActive returns true if a global tracer (exporting to either an OTLP or a
+// Jaeger collector) has been installed
 func IsActive() bool {
 	return opentracing.IsGlobalTracerRegistered()
 }
@@ -217,9 +294,17 @@ func StreamServerInterceptor() grpc.StreamServerInterceptor {
 		otgrpc.IncludingSpans(otgrpc.SpanInclusionFunc(addTraceIfTracingEnabled)))
 }
 
-// CloseAndReportTraces tries to close the global tracer, which, in the case of
-// the Jaeger tracer, causes it to send any unreported traces to the collector
+// CloseAndReportTraces tries to close the global tracer, which causes it to send
+// any unreported traces to the collector
 func CloseAndReportTraces() {
+	if otelProvider != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := otelProvider.Shutdown(ctx); err != nil {
+			log.Errorf("could not report traces to the OTLP collector: %v", err)
+		}
+		return
+	}
 	if c, ok := opentracing.GlobalTracer().(io.Closer); ok {
 		c.Close()
 	}
@@ -0,0 +1,237 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+)
+
+const (
+	taskPrefix    = "/task"
+	subtaskPrefix = "/subtask"
+	claimPrefix   = "/claim"
+)
+
+// taskEtcd stores tasks, subtasks and claims in etcd collections.
+// Workers watch the task collection for tasks to be created / deleted and
+// appropriately run / delete tasks in a local task queue with a function that
+// watches the subtask and claim collections for subtasks that need to be
+// processed.
+type taskEtcd struct {
+	etcdClient                    *etcd.Client
+	taskCol, subtaskCol, claimCol col.EtcdCollection
+}
+
+func newTaskEtcd(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *taskEtcd {
+	return &taskEtcd{
+		etcdClient: etcdClient,
+		taskCol:    newCollection(etcdClient, path.Join(etcdPrefix, taskPrefix, taskNamespace), &Task{}),
+		subtaskCol: newCollection(etcdClient, path.Join(etcdPrefix, subtaskPrefix, taskNamespace), &TaskInfo{}),
+		claimCol:   newCollection(etcdClient, path.Join(etcdPrefix, claimPrefix, taskNamespace), &Claim{}),
+	}
+}
+
+func newCollection(etcdClient *etcd.Client, etcdPrefix string, template proto.Message) col.EtcdCollection {
+	return col.NewEtcdCollection(
+		etcdClient,
+		etcdPrefix,
+		nil,
+		template,
+		nil,
+		nil,
+	)
+}
+
+func (te *taskEtcd) createTask(ctx context.Context, task *Task) error {
+	_, err := col.NewSTM(ctx, te.etcdClient, func(stm col.STM) error {
+		return te.taskCol.ReadWrite(stm).Put(task.ID, task)
+	})
+	return err
+}
+
+func (te *taskEtcd) createSubtask(ctx context.Context, taskID string, subtaskInfo *TaskInfo) error {
+	subtaskKey := path.Join(taskID, subtaskInfo.Task.ID)
+	_, err := col.NewSTM(ctx, te.etcdClient, func(stm col.STM) error {
+		return te.subtaskCol.ReadWrite(stm).Put(subtaskKey, subtaskInfo)
+	})
+	return err
+}
+
+func (te *taskEtcd) watchSubtasks(ctx context.Context, taskID string, cb func(*TaskInfo) error) error {
+	return te.subtaskCol.ReadOnly(ctx).WatchOneF(taskID, func(e *watch.Event) error {
+		var key string
+		subtaskInfo := &TaskInfo{}
+		if e.Type == watch.EventDelete {
+			return errors.New("task was deleted while waiting for results")
+		}
+		if err := e.Unmarshal(&key, subtaskInfo); err != nil {
+			return err
+		}
+		// Check that the subtask state is terminal.
+		if subtaskInfo.State == State_RUNNING {
+			return nil
+		}
+		return cb(subtaskInfo)
+	})
+}
+
+func (te *taskEtcd) deleteSubtasks(taskID string) error {
+	_, err := col.NewSTM(context.Background(), te.etcdClient, func(stm col.STM) error {
+		te.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		te.claimCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		return nil
+	})
+	return err
+}
+
+func (te *taskEtcd) deleteTask(taskID string) error {
+	_, err := col.NewSTM(context.Background(), te.etcdClient, func(stm col.STM) error {
+		te.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		te.claimCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		return te.taskCol.ReadWrite(stm).Delete(taskID)
+	})
+	return err
+}
+
+func (te *taskEtcd) deleteAllTasks() error {
+	_, err := col.NewSTM(context.Background(), te.etcdClient, func(stm col.STM) error {
+		te.subtaskCol.ReadWrite(stm).DeleteAll()
+		te.claimCol.ReadWrite(stm).DeleteAll()
+		te.taskCol.ReadWrite(stm).DeleteAll()
+		return nil
+	})
+	return err
+}
+
+func (te *taskEtcd) runWorker(ctx context.Context, processFunc ProcessFunc) error {
+	taskQueue := newTaskQueue(ctx)
+	return te.taskCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
+		taskID := string(e.Key)
+		task := &Task{}
+		if e.Type == watch.EventDelete {
+			taskQueue.deleteTask(taskID)
+			return nil
+		}
+		if err := e.Unmarshal(&taskID, task); err != nil {
+			return err
+		}
+		return taskQueue.runTask(ctx, taskID, func(taskEntry *taskEntry) {
+			if err := te.taskFunc(task, taskEntry, processFunc); err != nil && !errors.Is(taskEntry.ctx.Err(), context.Canceled) {
+				fmt.Printf("errored in task callback: %v\n", err)
+			}
+		})
+	})
+}
+
+func (te *taskEtcd) taskFunc(task *Task, taskEntry *taskEntry, processFunc ProcessFunc) error {
+	claimWatch, err := te.claimCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnorePut)
+	if err != nil {
+		return err
+	}
+	defer claimWatch.Close()
+	subtaskWatch, err := te.subtaskCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnoreDelete)
+	if err != nil {
+		return err
+	}
+	defer subtaskWatch.Close()
+	for {
+		select {
+		case e := <-claimWatch.Watch():
+			if e.Type == watch.EventError {
+				return e.Err
+			}
+			subtaskKey := string(e.Key)
+			taskEntry.runSubtask(te.subtaskFunc(subtaskKey, processFunc))
+		case e := <-subtaskWatch.Watch():
+			if e.Type == watch.EventError {
+				return e.Err
+			}
+			var subtaskKey string
+			if err := e.Unmarshal(&subtaskKey, &TaskInfo{}); err != nil {
+				return err
+			}
+			taskEntry.runSubtask(te.subtaskFunc(subtaskKey, processFunc))
+		case <-taskEntry.ctx.Done():
+			return taskEntry.ctx.Err()
+		}
+	}
+}
+
+func (te *taskEtcd) subtaskFunc(subtaskKey string, processFunc ProcessFunc) subtaskFunc {
+	return func(ctx context.Context) {
+		if err := func() error {
+			// (bryce) this should be refactored to have the check and claim in the same stm.
+			// there is a rare race condition that does not affect correctness, but it is less
+			// than ideal because a subtask could get run once more than necessary.
+			subtaskInfo := &TaskInfo{}
+			if _, err := col.NewSTM(ctx, te.etcdClient, func(stm col.STM) error {
+				return te.subtaskCol.ReadWrite(stm).Get(subtaskKey, subtaskInfo)
+			}); err != nil {
+				return err
+			}
+			if subtaskInfo.State != State_RUNNING {
+				return nil
+			}
+			return te.claimCol.Claim(ctx, subtaskKey, &Claim{}, func(claimCtx context.Context) (retErr error) {
+				subtask := subtaskInfo.Task
+				var result *types.Any
+				defer func() {
+					// If the task context was canceled or the claim was lost, just return with no error.
+					if errors.Is(claimCtx.Err(), context.Canceled) {
+						retErr = nil
+						return
+					}
+					subtaskInfo := &TaskInfo{}
+					if _, err := col.NewSTM(claimCtx, te.etcdClient, func(stm col.STM) error {
+						return te.subtaskCol.ReadWrite(stm).Update(subtaskKey, subtaskInfo, func() error {
+							// (bryce) remove when check and claim are in the same stm.
+							if subtaskInfo.State != State_RUNNING {
+								return nil
+							}
+							subtaskInfo.Task = subtask
+							subtaskInfo.State = State_SUCCESS
+							subtaskInfo.Result = result
+							if retErr != nil {
+								subtaskInfo.State = State_FAILURE
+								subtaskInfo.Reason = retErr.Error()
+								retErr = nil
+							}
+							return nil
+						})
+					}); retErr == nil {
+						retErr = err
+					}
+				}()
+				var err error
+				result, err = processFunc(claimCtx, subtask)
+				return err
+			})
+		}(); err != nil {
+			// If the task context was canceled or the subtask was deleted / not claimed, then no error should be logged.
+			if errors.Is(ctx.Err(), context.Canceled) ||
+				col.IsErrNotFound(err) || errors.Is(err, col.ErrNotClaimed) {
+				return
+			}
+			fmt.Printf("errored in subtask callback: %v\n", err)
+		}
+	}
+}
+
+func (te *taskEtcd) taskCount(ctx context.Context) (int64, int64, error) {
+	nSubTasks, rev, err := te.subtaskCol.ReadOnly(ctx).CountRev(0)
+	if err != nil {
+		return 0, 0, err
+	}
+	nClaims, _, err := te.claimCol.ReadOnly(ctx).CountRev(rev)
+	if err != nil {
+		return 0, 0, err
+	}
+	return nSubTasks, nClaims, nil
+}
@@ -0,0 +1,405 @@
+package work
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// claimTTL is how long a worker's claim on a subtask lasts without being
+	// renewed. Claims are renewed every claimTTL / 3.
+	claimTTL = 30 * time.Second
+	// pollInterval bounds how long workers and masters wait for a
+	// notification before checking the tables again, which covers expired
+	// claims (which are not notified) and dropped notifications.
+	pollInterval = 5 * time.Second
+)
+
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+//
+// SetupPostgresV0 creates the tables used by the postgres task queue.
+func SetupPostgresV0(ctx context.Context, tx *sqlx.Tx) error {
+	const schema = `
+	CREATE SCHEMA work;
+
+	CREATE TABLE work.tasks (
+		namespace VARCHAR(4096) NOT NULL,
+		task_id VARCHAR(64) NOT NULL,
+		seq BIGSERIAL NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY(namespace, task_id)
+	);
+
+	CREATE TABLE work.subtasks (
+		namespace VARCHAR(4096) NOT NULL,
+		task_id VARCHAR(64) NOT NULL,
+		subtask_id VARCHAR(4096) NOT NULL,
+		seq BIGSERIAL NOT NULL,
+		state VARCHAR(16) NOT NULL,
+		info BYTEA NOT NULL,
+		claim_id VARCHAR(64),
+		claim_expires_at TIMESTAMPTZ,
+		PRIMARY KEY(namespace, task_id, subtask_id),
+		FOREIGN KEY(namespace, task_id) REFERENCES work.tasks(namespace, task_id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX subtasks_running ON work.subtasks (namespace, seq) WHERE state = 'RUNNING';
+	`
+	_, err := tx.ExecContext(ctx, schema)
+	return errors.EnsureStack(err)
+}
+
+// taskPostgres stores tasks and subtasks in postgres. Workers claim subtasks
+// with SELECT ... FOR UPDATE SKIP LOCKED, and hold them with a lease that
+// they renew while processing. Workers are notified of new subtasks, and
+// masters of finished subtasks, with LISTEN / NOTIFY.
+type taskPostgres struct {
+	db        *sqlx.DB
+	listener  col.PostgresListener
+	namespace string
+}
+
+func newTaskPostgres(db *sqlx.DB, listener col.PostgresListener, taskNamespace string) *taskPostgres {
+	return &taskPostgres{
+		db:        db,
+		listener:  listener,
+		namespace: taskNamespace,
+	}
+}
+
+// subtasksChannel is the channel that is notified when subtasks are created
+// in the namespace. Namespaces are hashed because they may be longer than
+// postgres allows channel names to be.
+func (tp *taskPostgres) subtasksChannel() string {
+	sum := sha256.Sum256([]byte(tp.namespace))
+	return "work_subtasks_" + hex.EncodeToString(sum[:16])
+}
+
+// doneChannel is the channel that is notified when a subtask of a task
+// finishes.
+func doneChannel(taskID string) string {
+	return "work_done_" + taskID
+}
+
+func (tp *taskPostgres) createTask(ctx context.Context, task *Task) error {
+	_, err := tp.db.ExecContext(ctx, `
+	INSERT INTO work.tasks (namespace, task_id) VALUES ($1, $2)
+	`, tp.namespace, task.ID)
+	return errors.EnsureStack(err)
+}
+
+func (tp *taskPostgres) createSubtask(ctx context.Context, taskID string, subtaskInfo *TaskInfo) error {
+	data, err := proto.Marshal(subtaskInfo)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = tp.db.ExecContext(ctx, `
+	WITH inserted AS (
+		INSERT INTO work.subtasks (namespace, task_id, subtask_id, state, info)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING 1
+	)
+	SELECT pg_notify($6, '') FROM inserted
+	`, tp.namespace, taskID, subtaskInfo.Task.ID, State_RUNNING.String(), data, tp.subtasksChannel())
+	return errors.EnsureStack(err)
+}
+
+// watchSubtasks collects finished subtasks by deleting them, so each finished
+// subtask is passed to cb exactly once.
+func (tp *taskPostgres) watchSubtasks(ctx context.Context, taskID string, cb func(*TaskInfo) error) error {
+	return tp.wait(ctx, doneChannel(taskID), func() error {
+		var infos [][]byte
+		if err := tp.db.SelectContext(ctx, &infos, `
+		DELETE FROM work.subtasks
+		WHERE namespace = $1 AND task_id = $2 AND state <> $3
+		RETURNING info
+		`, tp.namespace, taskID, State_RUNNING.String()); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, data := range infos {
+			subtaskInfo := &TaskInfo{}
+			if err := proto.Unmarshal(data, subtaskInfo); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := cb(subtaskInfo); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (tp *taskPostgres) deleteSubtasks(taskID string) error {
+	_, err := tp.db.Exec(`
+	DELETE FROM work.subtasks WHERE namespace = $1 AND task_id = $2
+	`, tp.namespace, taskID)
+	return errors.EnsureStack(err)
+}
+
+func (tp *taskPostgres) deleteTask(taskID string) error {
+	// Subtasks are deleted by the foreign key cascade.
+	_, err := tp.db.Exec(`
+	DELETE FROM work.tasks WHERE namespace = $1 AND task_id = $2
+	`, tp.namespace, taskID)
+	return errors.EnsureStack(err)
+}
+
+func (tp *taskPostgres) deleteAllTasks() error {
+	_, err := tp.db.Exec(`
+	DELETE FROM work.tasks WHERE namespace = $1
+	`, tp.namespace)
+	return errors.EnsureStack(err)
+}
+
+func (tp *taskPostgres) runWorker(ctx context.Context, processFunc ProcessFunc) error {
+	return tp.wait(ctx, tp.subtasksChannel(), func() error {
+		// Process subtasks until there are none left to claim.
+		for {
+			claimed, err := tp.processNext(ctx, processFunc)
+			if err != nil {
+				if errors.Is(ctx.Err(), context.Canceled) {
+					return errors.EnsureStack(ctx.Err())
+				}
+				return err
+			}
+			if !claimed {
+				return nil
+			}
+		}
+	})
+}
+
+// processNext claims and processes the next unclaimed subtask in the
+// namespace, prioritizing subtasks from tasks that were created earlier. It
+// returns false if there were no subtasks to claim.
+func (tp *taskPostgres) processNext(ctx context.Context, processFunc ProcessFunc) (bool, error) {
+	claimID := uuid.NewWithoutDashes()
+	var subtask struct {
+		TaskID    string `db:"task_id"`
+		SubtaskID string `db:"subtask_id"`
+		Info      []byte `db:"info"`
+	}
+	if err := tp.db.GetContext(ctx, &subtask, `
+	UPDATE work.subtasks
+	SET claim_id = $3, claim_expires_at = CURRENT_TIMESTAMP + make_interval(secs => $4)
+	WHERE (namespace, task_id, subtask_id) = (
+		SELECT s.namespace, s.task_id, s.subtask_id
+		FROM work.subtasks s JOIN work.tasks t ON s.namespace = t.namespace AND s.task_id = t.task_id
+		WHERE s.namespace = $1 AND s.state = $2
+		AND (s.claim_expires_at IS NULL OR s.claim_expires_at < CURRENT_TIMESTAMP)
+		ORDER BY t.seq, s.seq
+		LIMIT 1
+		FOR UPDATE OF s SKIP LOCKED
+	)
+	RETURNING task_id, subtask_id, info
+	`, tp.namespace, State_RUNNING.String(), claimID, claimTTL.Seconds()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.EnsureStack(err)
+	}
+	subtaskInfo := &TaskInfo{}
+	if err := proto.Unmarshal(subtask.Info, subtaskInfo); err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	claimCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	renewDone := make(chan struct{})
+	go func() {
+		defer close(renewDone)
+		tp.renewClaim(claimCtx, cancel, subtask.TaskID, subtask.SubtaskID, claimID)
+	}()
+	result, err := processFunc(claimCtx, subtaskInfo.Task)
+	// If the worker context was canceled, the task was deleted, or the claim
+	// was lost, just return with no error.
+	if claimCtx.Err() != nil {
+		<-renewDone
+		tp.releaseClaim(subtask.TaskID, subtask.SubtaskID, claimID)
+		return true, nil
+	}
+	cancel()
+	<-renewDone
+	subtaskInfo.State = State_SUCCESS
+	subtaskInfo.Result = result
+	if err != nil {
+		subtaskInfo.State = State_FAILURE
+		subtaskInfo.Reason = err.Error()
+	}
+	data, err := proto.Marshal(subtaskInfo)
+	if err != nil {
+		return true, errors.EnsureStack(err)
+	}
+	if _, err := tp.db.ExecContext(ctx, `
+	WITH updated AS (
+		UPDATE work.subtasks
+		SET state = $5, info = $6, claim_id = NULL, claim_expires_at = NULL
+		WHERE namespace = $1 AND task_id = $2 AND subtask_id = $3 AND claim_id = $4 AND state = $7
+		RETURNING 1
+	)
+	SELECT pg_notify($8, '') FROM updated
+	`, tp.namespace, subtask.TaskID, subtask.SubtaskID, claimID, subtaskInfo.State.String(), data,
+		State_RUNNING.String(), doneChannel(subtask.TaskID)); err != nil {
+		return true, errors.EnsureStack(err)
+	}
+	return true, nil
+}
+
+// releaseClaim gives up a claim on a subtask that was not finished, so that
+// another worker can claim it without waiting for the claim to expire.
+func (tp *taskPostgres) releaseClaim(taskID, subtaskID, claimID string) {
+	if _, err := tp.db.Exec(`
+	WITH released AS (
+		UPDATE work.subtasks
+		SET claim_id = NULL, claim_expires_at = NULL
+		WHERE namespace = $1 AND task_id = $2 AND subtask_id = $3 AND claim_id = $4
+		RETURNING 1
+	)
+	SELECT pg_notify($5, '') FROM released
+	`, tp.namespace, taskID, subtaskID, claimID, tp.subtasksChannel()); err != nil {
+		log.Errorf("errored releasing claim on subtask %v: %v", subtaskID, err)
+	}
+}
+
+// renewClaim extends the claim on a subtask until ctx is done. If the claim
+// can't be renewed because the subtask (or its task) was deleted, or the claim
+// expired and the subtask was claimed by another worker, cancel is called.
+func (tp *taskPostgres) renewClaim(ctx context.Context, cancel context.CancelFunc, taskID, subtaskID, claimID string) {
+	ticker := time.NewTicker(claimTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		res, err := tp.db.ExecContext(ctx, `
+		UPDATE work.subtasks
+		SET claim_expires_at = CURRENT_TIMESTAMP + make_interval(secs => $5)
+		WHERE namespace = $1 AND task_id = $2 AND subtask_id = $3 AND claim_id = $4
+		`, tp.namespace, taskID, subtaskID, claimID, claimTTL.Seconds())
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Errorf("errored renewing claim on subtask %v: %v", subtaskID, err)
+			}
+			continue
+		}
+		if n, err := res.RowsAffected(); err == nil && n == 0 {
+			cancel()
+			return
+		}
+	}
+}
+
+func (tp *taskPostgres) taskCount(ctx context.Context) (int64, int64, error) {
+	var counts struct {
+		Subtasks int64 `db:"subtasks"`
+		Claims   int64 `db:"claims"`
+	}
+	if err := tp.db.GetContext(ctx, &counts, `
+	SELECT COUNT(*) AS subtasks,
+		COUNT(*) FILTER (WHERE claim_expires_at >= CURRENT_TIMESTAMP) AS claims
+	FROM work.subtasks
+	WHERE namespace = $1 AND state = $2
+	`, tp.namespace, State_RUNNING.String()); err != nil {
+		return 0, 0, errors.EnsureStack(err)
+	}
+	return counts.Subtasks, counts.Claims, nil
+}
+
+// wait calls f, and then calls it again each time 'channel' is notified (or
+// pollInterval passes), until f returns an error or ctx is canceled. If f
+// returns errutil.ErrBreak, wait returns nil.
+func (tp *taskPostgres) wait(ctx context.Context, channel string, f func() error) error {
+	n := newNotifier(channel)
+	if err := tp.listener.Register(n); err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := tp.listener.Unregister(n); err != nil {
+			log.Errorf("errored unregistering from channel %v: %v", channel, err)
+		}
+	}()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := f(); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		case <-n.notify:
+		case err := <-n.err:
+			// The listener drops its notifiers when it loses its connection, so
+			// register again (polling covers anything missed in between).
+			log.Errorf("lost notifications for channel %v: %v", channel, err)
+			if err := tp.listener.Register(n); err != nil {
+				return errors.EnsureStack(err)
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// notifier is a col.Notifier which coalesces notifications, as the work to do
+// in response to any number of notifications is the same.
+type notifier struct {
+	id      string
+	channel string
+	notify  chan struct{}
+	err     chan error
+}
+
+func newNotifier(channel string) *notifier {
+	return &notifier{
+		id:      uuid.NewWithoutDashes(),
+		channel: channel,
+		notify:  make(chan struct{}, 1),
+		err:     make(chan error, 1),
+	}
+}
+
+func (n *notifier) ID() string {
+	return n.id
+}
+
+func (n *notifier) Channel() string {
+	return n.channel
+}
+
+func (n *notifier) Notify(*pq.Notification) {
+	select {
+	case n.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (n *notifier) Error(err error) {
+	select {
+	case n.err <- err:
+	default:
+	}
+}
+
+func (n *notifier) String() string {
+	return fmt.Sprintf("work notifier for %v", n.channel)
+}
+
+var _ col.Notifier = &notifier{}
@@ -3,98 +3,96 @@ package work
 import (
 	"context"
 	"fmt"
-	"path"
 	"sync/atomic"
 
 	etcd "github.com/coreos/etcd/clientv3"
-	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
-	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
 	"golang.org/x/sync/errgroup"
 )
 
 const (
-	taskPrefix    = "/task"
-	subtaskPrefix = "/subtask"
-	claimPrefix   = "/claim"
+	// EtcdBackend stores tasks in etcd.
+	EtcdBackend = "etcd"
+	// PostgresBackend stores tasks in postgres.
+	PostgresBackend = "postgres"
 )
 
+// taskStore is the storage backend for a task namespace. It is responsible
+// for persisting tasks and subtasks, and for distributing subtasks to
+// workers.
+type taskStore interface {
+	createTask(ctx context.Context, task *Task) error
+	deleteTask(taskID string) error
+	deleteAllTasks() error
+	createSubtask(ctx context.Context, taskID string, subtaskInfo *TaskInfo) error
+	// watchSubtasks calls cb with each subtask of the task that reaches a
+	// terminal state, until cb returns errutil.ErrBreak or ctx is canceled.
+	watchSubtasks(ctx context.Context, taskID string, cb func(*TaskInfo) error) error
+	deleteSubtasks(taskID string) error
+	// runWorker claims and processes subtasks until ctx is canceled.
+	runWorker(ctx context.Context, processFunc ProcessFunc) error
+	taskCount(ctx context.Context) (subtasks int64, claims int64, _ error)
+}
+
 // TaskQueue manages a set of parallel tasks, and provides an interface for running tasks.
 // Priority of tasks (and therefore subtasks) is based on task creation time, so tasks created
 // earlier will be prioritized over tasks that were created later.
 type TaskQueue struct {
-	*taskEtcd
+	store     taskStore
 	taskQueue *taskQueue
 }
 
-type taskEtcd struct {
-	etcdClient                    *etcd.Client
-	taskCol, subtaskCol, claimCol col.EtcdCollection
+// NewTaskQueue sets up a new task queue backed by etcd.
+func NewTaskQueue(ctx context.Context, etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) (*TaskQueue, error) {
+	return newTaskQueueFromStore(ctx, newTaskEtcd(etcdClient, etcdPrefix, taskNamespace))
 }
 
-// NewTaskQueue sets up a new task queue.
-func NewTaskQueue(ctx context.Context, etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) (*TaskQueue, error) {
+// NewPostgresTaskQueue sets up a new task queue backed by postgres.
+func NewPostgresTaskQueue(ctx context.Context, db *sqlx.DB, listener col.PostgresListener, taskNamespace string) (*TaskQueue, error) {
+	return newTaskQueueFromStore(ctx, newTaskPostgres(db, listener, taskNamespace))
+}
+
+func newTaskQueueFromStore(ctx context.Context, store taskStore) (*TaskQueue, error) {
 	tq := &TaskQueue{
-		taskEtcd:  newTaskEtcd(etcdClient, etcdPrefix, taskNamespace),
+		store:     store,
 		taskQueue: newTaskQueue(ctx),
 	}
-	// Clear etcd key space.
-	if err := tq.deleteAllTasks(); err != nil {
+	// Clear the task namespace.
+	if err := store.deleteAllTasks(); err != nil {
 		return nil, err
 	}
 	return tq, nil
 }
 
-func newTaskEtcd(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *taskEtcd {
-	return &taskEtcd{
-		etcdClient: etcdClient,
-		taskCol:    newCollection(etcdClient, path.Join(etcdPrefix, taskPrefix, taskNamespace), &Task{}),
-		subtaskCol: newCollection(etcdClient, path.Join(etcdPrefix, subtaskPrefix, taskNamespace), &TaskInfo{}),
-		claimCol:   newCollection(etcdClient, path.Join(etcdPrefix, claimPrefix, taskNamespace), &Claim{}),
-	}
-}
-
-func newCollection(etcdClient *etcd.Client, etcdPrefix string, template proto.Message) col.EtcdCollection {
-	return col.NewEtcdCollection(
-		etcdClient,
-		etcdPrefix,
-		nil,
-		template,
-		nil,
-		nil,
-	)
-}
-
 // RunTask runs a task in the task queue.
 // The task code should be contained within the passed in callback.
 // The callback will receive a Master, which should be used for running subtasks in the task queue.
 // The task state will be cleaned up upon return of the callback.
 func (tq *TaskQueue) RunTask(ctx context.Context, f func(*Master)) (retErr error) {
 	task := &Task{ID: uuid.NewWithoutDashes()}
-	if _, err := col.NewSTM(ctx, tq.etcdClient, func(stm col.STM) error {
-		return tq.taskCol.ReadWrite(stm).Put(task.ID, task)
-	}); err != nil {
+	if err := tq.store.createTask(ctx, task); err != nil {
 		return err
 	}
 	defer func() {
 		if retErr != nil {
-			if err := tq.deleteTask(task.ID); err != nil {
+			if err := tq.store.deleteTask(task.ID); err != nil {
 				fmt.Printf("errored deleting task %v: %v\n", task.ID, err)
 			}
 		}
 	}()
 	return tq.taskQueue.runTask(ctx, task.ID, func(te *taskEntry) {
 		defer func() {
-			if err := tq.deleteTask(task.ID); err != nil {
+			if err := tq.store.deleteTask(task.ID); err != nil {
 				fmt.Printf("errored deleting task %v: %v\n", task.ID, err)
 			}
 		}()
 		f(&Master{
-			taskEtcd:  tq.taskEtcd,
+			store:     tq.store,
 			taskID:    task.ID,
 			taskEntry: te,
 		})
@@ -114,7 +112,7 @@ func (tq *TaskQueue) RunTaskBlock(ctx context.Context, f func(*Master) error) er
 
 // Master manages subtasks in the task queue, and provides an interface for running subtasks.
 type Master struct {
-	*taskEtcd
+	store     taskStore
 	taskID    string
 	taskEntry *taskEntry
 }
@@ -158,19 +156,7 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 	done := make(chan struct{})
 	ctx, cancel := context.WithCancel(m.taskEntry.ctx)
 	eg.Go(func() error {
-		return m.subtaskCol.ReadOnly(ctx).WatchOneF(m.taskID, func(e *watch.Event) error {
-			var key string
-			subtaskInfo := &TaskInfo{}
-			if e.Type == watch.EventDelete {
-				return errors.New("task was deleted while waiting for results")
-			}
-			if err := e.Unmarshal(&key, subtaskInfo); err != nil {
-				return err
-			}
-			// Check that the subtask state is terminal.
-			if subtaskInfo.State == State_RUNNING {
-				return nil
-			}
+		return m.store.watchSubtasks(ctx, m.taskID, func(subtaskInfo *TaskInfo) error {
 			if collectFunc != nil {
 				if err := m.taskEntry.runSubtaskBlock(func(ctx context.Context) error {
 					return collectFunc(ctx, subtaskInfo)
@@ -181,7 +167,7 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 			atomic.AddInt64(&count, -1)
 			select {
 			case <-done:
-				if count == 0 {
+				if atomic.LoadInt64(&count) == 0 {
 					return errutil.ErrBreak
 				}
 			default:
@@ -199,13 +185,16 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 		if err := eg.Wait(); retErr == nil && !errors.Is(ctx.Err(), context.Canceled) {
 			retErr = err
 		}
-		if err := m.deleteSubtasks(); err != nil {
+		if err := m.store.deleteSubtasks(m.taskID); err != nil {
 			fmt.Printf("errored deleting subtasks for task %v: %v\n", m.taskID, err)
 		}
 	}()
 
 	for subtask := range subtaskChan {
-		if err := m.createSubtask(subtask); err != nil {
+		if subtask.ID == "" {
+			subtask.ID = uuid.NewWithoutDashes()
+		}
+		if err := m.store.createSubtask(m.taskEntry.ctx, m.taskID, &TaskInfo{Task: subtask, State: State_RUNNING}); err != nil {
 			return err
 		}
 		atomic.AddInt64(&count, 1)
@@ -213,191 +202,34 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 	return nil
 }
 
-func (m *Master) createSubtask(subtask *Task) error {
-	if subtask.ID == "" {
-		subtask.ID = uuid.NewWithoutDashes()
-	}
-	subtaskKey := path.Join(m.taskID, subtask.ID)
-	subtaskInfo := &TaskInfo{Task: subtask, State: State_RUNNING}
-	if _, err := col.NewSTM(m.taskEntry.ctx, m.etcdClient, func(stm col.STM) error {
-		return m.subtaskCol.ReadWrite(stm).Put(subtaskKey, subtaskInfo)
-	}); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (m *Master) deleteSubtasks() error {
-	_, err := col.NewSTM(context.Background(), m.etcdClient, func(stm col.STM) error {
-		m.subtaskCol.ReadWrite(stm).DeleteAllPrefix(m.taskID)
-		m.claimCol.ReadWrite(stm).DeleteAllPrefix(m.taskID)
-		return nil
-	})
-	return err
-}
-
-func (tq *TaskQueue) deleteTask(taskID string) error {
-	_, err := col.NewSTM(context.Background(), tq.etcdClient, func(stm col.STM) error {
-		tq.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
-		tq.claimCol.ReadWrite(stm).DeleteAllPrefix(taskID)
-		return tq.taskCol.ReadWrite(stm).Delete(taskID)
-	})
-	return err
-}
-
-func (tq *TaskQueue) deleteAllTasks() error {
-	_, err := col.NewSTM(context.Background(), tq.etcdClient, func(stm col.STM) error {
-		tq.subtaskCol.ReadWrite(stm).DeleteAll()
-		tq.claimCol.ReadWrite(stm).DeleteAll()
-		tq.taskCol.ReadWrite(stm).DeleteAll()
-		return nil
-	})
-	return err
-}
-
 // Worker is a worker that will process subtasks in a task.
-// A worker watches the task collection for tasks to be created / deleted and appropriately
-// runs / deletes tasks in the internal task queue with a function that watches the
-// subtask and claim collections for subtasks that need to be processed.
-// The processFunc callback will be called for each subtask that needs to be processed
-// in the task.
+// A worker waits for subtasks to be created in the task namespace, claims
+// them, and processes them with the processFunc callback, prioritizing
+// subtasks from tasks that were created earlier.
 type Worker struct {
-	*taskEtcd
+	store taskStore
 }
 
-// NewWorker creates a new worker.
+// NewWorker creates a new worker backed by etcd.
 func NewWorker(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *Worker {
-	return &Worker{taskEtcd: newTaskEtcd(etcdClient, etcdPrefix, taskNamespace)}
+	return &Worker{store: newTaskEtcd(etcdClient, etcdPrefix, taskNamespace)}
+}
+
+// NewPostgresWorker creates a new worker backed by postgres.
+func NewPostgresWorker(db *sqlx.DB, listener col.PostgresListener, taskNamespace string) *Worker {
+	return &Worker{store: newTaskPostgres(db, listener, taskNamespace)}
 }
 
 // ProcessFunc is a callback that is used for processing a subtask in a task.
 type ProcessFunc func(context.Context, *Task) (*types.Any, error)
 
 // Run runs the worker with the given context.
-// The worker will continue to watch the task collection until the context is canceled.
+// The worker will continue to process subtasks until the context is canceled.
 func (w *Worker) Run(ctx context.Context, processFunc ProcessFunc) error {
-	taskQueue := newTaskQueue(ctx)
-	return w.taskCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
-		taskID := string(e.Key)
-		task := &Task{}
-		if e.Type == watch.EventDelete {
-			taskQueue.deleteTask(taskID)
-			return nil
-		}
-		if err := e.Unmarshal(&taskID, task); err != nil {
-			return err
-		}
-		return taskQueue.runTask(ctx, taskID, func(taskEntry *taskEntry) {
-			if err := w.taskFunc(task, taskEntry, processFunc); err != nil && !errors.Is(taskEntry.ctx.Err(), context.Canceled) {
-				fmt.Printf("errored in task callback: %v\n", err)
-			}
-		})
-	})
-}
-
-func (w *Worker) taskFunc(task *Task, taskEntry *taskEntry, processFunc ProcessFunc) error {
-	claimWatch, err := w.claimCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnorePut)
-	if err != nil {
-		return err
-	}
-	defer claimWatch.Close()
-	subtaskWatch, err := w.subtaskCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnoreDelete)
-	if err != nil {
-		return err
-	}
-	defer subtaskWatch.Close()
-	for {
-		select {
-		case e := <-claimWatch.Watch():
-			if e.Type == watch.EventError {
-				return e.Err
-			}
-			subtaskKey := string(e.Key)
-			taskEntry.runSubtask(w.subtaskFunc(subtaskKey, processFunc))
-		case e := <-subtaskWatch.Watch():
-			if e.Type == watch.EventError {
-				return e.Err
-			}
-			var subtaskKey string
-			if err := e.Unmarshal(&subtaskKey, &TaskInfo{}); err != nil {
-				return err
-			}
-			taskEntry.runSubtask(w.subtaskFunc(subtaskKey, processFunc))
-		case <-taskEntry.ctx.Done():
-			return taskEntry.ctx.Err()
-		}
-	}
-}
-
-func (w *Worker) subtaskFunc(subtaskKey string, processFunc ProcessFunc) subtaskFunc {
-	return func(ctx context.Context) {
-		if err := func() error {
-			// (bryce) this should be refactored to have the check and claim in the same stm.
-			// there is a rare race condition that does not affect correctness, but it is less
-			// than ideal because a subtask could get run once more than necessary.
-			subtaskInfo := &TaskInfo{}
-			if _, err := col.NewSTM(ctx, w.etcdClient, func(stm col.STM) error {
-				return w.subtaskCol.ReadWrite(stm).Get(subtaskKey, subtaskInfo)
-			}); err != nil {
-				return err
-			}
-			if subtaskInfo.State != State_RUNNING {
-				return nil
-			}
-			return w.claimCol.Claim(ctx, subtaskKey, &Claim{}, func(claimCtx context.Context) (retErr error) {
-				subtask := subtaskInfo.Task
-				var result *types.Any
-				defer func() {
-					// If the task context was canceled or the claim was lost, just return with no error.
-					if errors.Is(claimCtx.Err(), context.Canceled) {
-						retErr = nil
-						return
-					}
-					subtaskInfo := &TaskInfo{}
-					if _, err := col.NewSTM(claimCtx, w.etcdClient, func(stm col.STM) error {
-						return w.subtaskCol.ReadWrite(stm).Update(subtaskKey, subtaskInfo, func() error {
-							// (bryce) remove when check and claim are in the same stm.
-							if subtaskInfo.State != State_RUNNING {
-								return nil
-							}
-							subtaskInfo.Task = subtask
-							subtaskInfo.State = State_SUCCESS
-							subtaskInfo.Result = result
-							if retErr != nil {
-								subtaskInfo.State = State_FAILURE
-								subtaskInfo.Reason = retErr.Error()
-								retErr = nil
-							}
-							return nil
-						})
-					}); retErr == nil {
-						retErr = err
-					}
-				}()
-				var err error
-				result, err = processFunc(claimCtx, subtask)
-				return err
-			})
-		}(); err != nil {
-			// If the task context was canceled or the subtask was deleted / not claimed, then no error should be logged.
-			if errors.Is(ctx.Err(), context.Canceled) ||
-				col.IsErrNotFound(err) || errors.Is(err, col.ErrNotClaimed) {
-				return
-			}
-			fmt.Printf("errored in subtask callback: %v\n", err)
-		}
-	}
+	return w.store.runWorker(ctx, processFunc)
 }
 
 // TaskCount returns how many subtasks are in the queue and how many are claimed.
 func (w *Worker) TaskCount(ctx context.Context) (subTasks int64, claims int64, _ error) {
-	nSubTasks, rev, err := w.subtaskCol.ReadOnly(ctx).CountRev(0)
-	if err != nil {
-		return 0, 0, err
-	}
-	nClaims, _, err := w.claimCol.ReadOnly(ctx).CountRev(rev)
-	if err != nil {
-		return 0, 0, err
-	}
-	return nSubTasks, nClaims, nil
+	return w.store.taskCount(ctx)
 }
@@ -10,6 +10,10 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testetcd"
@@ -20,6 +24,55 @@ var (
 	errSubtaskFailure = errors.Errorf("subtask failure")
 )
 
+// testBackend sets up a task store and returns constructors for workers and
+// task queues that use it.
+type testBackend func(t *testing.T) (func() *Worker, func(context.Context) (*TaskQueue, error))
+
+func etcdBackend(t *testing.T) (func() *Worker, func(context.Context) (*TaskQueue, error)) {
+	env := testetcd.NewEnv(t)
+	return func() *Worker {
+			return NewWorker(env.EtcdClient, "", "")
+		}, func(ctx context.Context) (*TaskQueue, error) {
+			return NewTaskQueue(ctx, env.EtcdClient, "", "")
+		}
+}
+
+func postgresBackend(t *testing.T) (func() *Worker, func(context.Context) (*TaskQueue, error)) {
+	// LISTEN / NOTIFY requires a direct connection rather than pgbouncer.
+	options := dockertestenv.NewTestDirectDBOptions(t)
+	db, err := dbutil.NewDB(options...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	require.NoError(t, dbutil.WithTx(context.Background(), db, func(tx *sqlx.Tx) error {
+		return SetupPostgresV0(context.Background(), tx)
+	}))
+	listener := col.NewPostgresListener(dbutil.GetDSN(options...))
+	t.Cleanup(func() {
+		require.NoError(t, listener.Close())
+	})
+	return func() *Worker {
+			return NewPostgresWorker(db, listener, "")
+		}, func(ctx context.Context) (*TaskQueue, error) {
+			return NewPostgresTaskQueue(ctx, db, listener, "")
+		}
+}
+
+// runBackends runs f against each task store.
+func runBackends(t *testing.T, f func(*testing.T, testBackend)) {
+	for name, backend := range map[string]testBackend{
+		EtcdBackend:     etcdBackend,
+		PostgresBackend: postgresBackend,
+	} {
+		backend := backend
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			f(t, backend)
+		})
+	}
+}
+
 func seedStr(seed int64) string {
 	return fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
 }
@@ -75,11 +128,11 @@ func collectSubtask(subtaskInfo *TaskInfo, collected map[string]bool) error {
 	return nil
 }
 
-func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64) {
+func test(t *testing.T, backend testBackend, workerFailProb, taskCancelProb, subtaskFailProb float64) {
 	seed := time.Now().UTC().UnixNano()
 	rand.Seed(seed)
 	msg := seedStr(seed)
-	env := testetcd.NewEnv(t)
+	newWorker, newTaskQueue := backend(t)
 
 	numTasks := 10
 	numSubtasks := 10
@@ -89,7 +142,7 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 	workerEg, errCtx := errgroup.WithContext(workerCtx)
 	for i := 0; i < numWorkers; i++ {
 		workerEg.Go(func() error {
-			w := NewWorker(env.EtcdClient, "", "")
+			w := newWorker()
 			for {
 				ctx, cancel := context.WithCancel(errCtx)
 				if err := w.Run(ctx, func(_ context.Context, subtask *Task) (*types.Any, error) {
@@ -116,7 +169,7 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 			}
 		})
 	}
-	tq, err := NewTaskQueue(errCtx, env.EtcdClient, "", "")
+	tq, err := newTaskQueue(errCtx)
 	require.NoError(t, err)
 	taskMapsFunc := func() []map[string]bool {
 		var taskMaps []map[string]bool
@@ -168,40 +221,51 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 
 func TestBasic(t *testing.T) {
 	t.Parallel()
-	test(t, 0, 0, 0)
+	runBackends(t, func(t *testing.T, backend testBackend) {
+		test(t, backend, 0, 0, 0)
+	})
 }
 
 func TestWorkerCrashes(t *testing.T) {
 	t.Parallel()
-	test(t, 0.1, 0, 0)
+	runBackends(t, func(t *testing.T, backend testBackend) {
+		test(t, backend, 0.1, 0, 0)
+	})
 }
 
 func TestCancelTasks(t *testing.T) {
 	t.Parallel()
-	test(t, 0, 0.2, 0)
+	runBackends(t, func(t *testing.T, backend testBackend) {
+		test(t, backend, 0, 0.2, 0)
+	})
 }
 
 func TestSubtaskFailures(t *testing.T) {
 	t.Parallel()
-	test(t, 0, 0, 0.1)
+	runBackends(t, func(t *testing.T, backend testBackend) {
+		test(t, backend, 0, 0, 0.1)
+	})
 }
 
 func TestEverything(t *testing.T) {
 	t.Parallel()
-	test(t, 0.1, 0.2, 0.1)
+	runBackends(t, func(t *testing.T, backend testBackend) {
+		test(t, backend, 0.1, 0.2, 0.1)
+	})
 }
 
 func TestRunZeroSubtasks(t *testing.T) {
 	t.Parallel()
-	env := testetcd.NewEnv(t)
-
-	tq, err := NewTaskQueue(context.Background(), env.EtcdClient, "", "")
-	require.NoError(t, err)
+	runBackends(t, func(t *testing.T, backend testBackend) {
+		_, newTaskQueue := backend(t)
+		tq, err := newTaskQueue(context.Background())
+		require.NoError(t, err)
 
-	err = tq.RunTaskBlock(context.Background(), func(m *Master) error {
-		return m.RunSubtasks(nil, func(_ context.Context, _ *TaskInfo) error {
-			return nil
+		err = tq.RunTaskBlock(context.Background(), func(m *Master) error {
+			return m.RunSubtasks(nil, func(_ context.Context, _ *TaskInfo) error {
+				return nil
+			})
 		})
+		require.NoError(t, err)
 	})
-	require.NoError(t, err)
 }
@@ -434,10 +434,12 @@ func (m *RepoInfo) GetDetails() *RepoInfo_Details {
 
 // Details are only provided when explicitly requested
 type RepoInfo_Details struct {
-	SizeBytes            int64    `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SizeBytes int64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Only provided when explicitly requested with ListRepoRequest.usage
+	Usage                *RepoUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *RepoInfo_Details) Reset()         { *m = RepoInfo_Details{} }
@@ -480,6 +482,158 @@ func (m *RepoInfo_Details) GetSizeBytes() int64 {
 	return 0
 }
 
+func (m *RepoInfo_Details) GetUsage() *RepoUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+// RepoUsage describes the storage consumed by the heads of a repo's branches.
+type RepoUsage struct {
+	// logical_bytes is the total size of the files in the branch heads.
+	LogicalBytes int64 `protobuf:"varint,1,opt,name=logical_bytes,json=logicalBytes,proto3" json:"logical_bytes,omitempty"`
+	// chunk_bytes is the size of the chunks referenced by the branch heads,
+	// after deduplication and compression.
+	ChunkBytes           int64    `protobuf:"varint,2,opt,name=chunk_bytes,json=chunkBytes,proto3" json:"chunk_bytes,omitempty"`
+	FileCount            int64    `protobuf:"varint,3,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoUsage) Reset()         { *m = RepoUsage{} }
+func (m *RepoUsage) String() string { return proto.CompactTextString(m) }
+func (*RepoUsage) ProtoMessage()    {}
+func (*RepoUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{4}
+}
+func (m *RepoUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoUsage.Merge(m, src)
+}
+func (m *RepoUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoUsage proto.InternalMessageInfo
+
+func (m *RepoUsage) GetLogicalBytes() int64 {
+	if m != nil {
+		return m.LogicalBytes
+	}
+	return 0
+}
+
+func (m *RepoUsage) GetChunkBytes() int64 {
+	if m != nil {
+		return m.ChunkBytes
+	}
+	return 0
+}
+
+func (m *RepoUsage) GetFileCount() int64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
+// RepoQuota limits the storage consumed by a single repo, or in aggregate by
+// every repo whose name begins with a prefix.  Exactly one of 'repo' and
+// 'prefix' must be set.  Once a repo is at a quota, PutFile and FinishCommit
+// on it fail with a quota exceeded error; a commit that takes a repo over a
+// quota fails with that error once it's finished.
+type RepoQuota struct {
+	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// max_bytes limits RepoUsage.logical_bytes, zero means unlimited.
+	MaxBytes int64 `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// max_files limits RepoUsage.file_count, zero means unlimited.
+	MaxFiles             int64    `protobuf:"varint,4,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoQuota) Reset()         { *m = RepoQuota{} }
+func (m *RepoQuota) String() string { return proto.CompactTextString(m) }
+func (*RepoQuota) ProtoMessage()    {}
+func (*RepoQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{5}
+}
+func (m *RepoQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoQuota.Merge(m, src)
+}
+func (m *RepoQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoQuota proto.InternalMessageInfo
+
+func (m *RepoQuota) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RepoQuota) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *RepoQuota) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *RepoQuota) GetMaxFiles() int64 {
+	if m != nil {
+		return m.MaxFiles
+	}
+	return 0
+}
+
 // RepoAuthInfo includes the caller's access scope for a repo, and is returned
 // by ListRepo and InspectRepo but not persisted in etcd. It's used by the
 // Pachyderm dashboard to render repo access appropriately. To set a user's auth
@@ -501,7 +655,7 @@ func (m *RepoAuthInfo) Reset()         { *m = RepoAuthInfo{} }
 func (m *RepoAuthInfo) String() string { return proto.CompactTextString(m) }
 func (*RepoAuthInfo) ProtoMessage()    {}
 func (*RepoAuthInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{4}
+	return fileDescriptor_21a7b2476cbc6216, []int{6}
 }
 func (m *RepoAuthInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,22 +699,23 @@ func (m *RepoAuthInfo) GetRoles() []string {
 }
 
 type BranchInfo struct {
-	Branch               *Branch   `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
-	Head                 *Commit   `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
-	Provenance           []*Branch `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty"`
-	Subvenance           []*Branch `protobuf:"bytes,4,rep,name=subvenance,proto3" json:"subvenance,omitempty"`
-	DirectProvenance     []*Branch `protobuf:"bytes,5,rep,name=direct_provenance,json=directProvenance,proto3" json:"direct_provenance,omitempty"`
-	Trigger              *Trigger  `protobuf:"bytes,6,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Branch               *Branch           `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	Head                 *Commit           `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	Provenance           []*Branch         `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty"`
+	Subvenance           []*Branch         `protobuf:"bytes,4,rep,name=subvenance,proto3" json:"subvenance,omitempty"`
+	DirectProvenance     []*Branch         `protobuf:"bytes,5,rep,name=direct_provenance,json=directProvenance,proto3" json:"direct_provenance,omitempty"`
+	Trigger              *Trigger          `protobuf:"bytes,6,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Protection           *BranchProtection `protobuf:"bytes,7,opt,name=protection,proto3" json:"protection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *BranchInfo) Reset()         { *m = BranchInfo{} }
 func (m *BranchInfo) String() string { return proto.CompactTextString(m) }
 func (*BranchInfo) ProtoMessage()    {}
 func (*BranchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{5}
+	return fileDescriptor_21a7b2476cbc6216, []int{7}
 }
 func (m *BranchInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,6 +786,100 @@ func (m *BranchInfo) GetTrigger() *Trigger {
 	return nil
 }
 
+func (m *BranchInfo) GetProtection() *BranchProtection {
+	if m != nil {
+		return m.Protection
+	}
+	return nil
+}
+
+// BranchProtection defines the restrictions placed on how a branch's head may
+// be moved.  Callers holding 'bypass_role' on the branch's repo are exempt.
+type BranchProtection struct {
+	// Forbids StartCommit on the branch, data must arrive via CreateBranch.
+	NoDirectCommits bool `protobuf:"varint,1,opt,name=no_direct_commits,json=noDirectCommits,proto3" json:"no_direct_commits,omitempty"`
+	// Requires that the head only be moved by CreateBranch from a commit on a
+	// different branch (i.e. a promote workflow).
+	RequirePromotion bool `protobuf:"varint,2,opt,name=require_promotion,json=requirePromotion,proto3" json:"require_promotion,omitempty"`
+	// Forbids deleting the branch.
+	NoDelete bool `protobuf:"varint,3,opt,name=no_delete,json=noDelete,proto3" json:"no_delete,omitempty"`
+	// Forbids moving the head back to a commit already in the branch's history.
+	NoForceMove bool `protobuf:"varint,4,opt,name=no_force_move,json=noForceMove,proto3" json:"no_force_move,omitempty"`
+	// A role (e.g. "repoOwner") that allows the caller to ignore the rules above.
+	BypassRole           string   `protobuf:"bytes,5,opt,name=bypass_role,json=bypassRole,proto3" json:"bypass_role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BranchProtection) Reset()         { *m = BranchProtection{} }
+func (m *BranchProtection) String() string { return proto.CompactTextString(m) }
+func (*BranchProtection) ProtoMessage()    {}
+func (*BranchProtection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{8}
+}
+func (m *BranchProtection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BranchProtection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BranchProtection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BranchProtection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchProtection.Merge(m, src)
+}
+func (m *BranchProtection) XXX_Size() int {
+	return m.Size()
+}
+func (m *BranchProtection) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchProtection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BranchProtection proto.InternalMessageInfo
+
+func (m *BranchProtection) GetNoDirectCommits() bool {
+	if m != nil {
+		return m.NoDirectCommits
+	}
+	return false
+}
+
+func (m *BranchProtection) GetRequirePromotion() bool {
+	if m != nil {
+		return m.RequirePromotion
+	}
+	return false
+}
+
+func (m *BranchProtection) GetNoDelete() bool {
+	if m != nil {
+		return m.NoDelete
+	}
+	return false
+}
+
+func (m *BranchProtection) GetNoForceMove() bool {
+	if m != nil {
+		return m.NoForceMove
+	}
+	return false
+}
+
+func (m *BranchProtection) GetBypassRole() string {
+	if m != nil {
+		return m.BypassRole
+	}
+	return ""
+}
+
 // Trigger defines the conditions under which a head is moved, and to which
 // branch it is moved.
 type Trigger struct {
@@ -655,7 +904,7 @@ func (m *Trigger) Reset()         { *m = Trigger{} }
 func (m *Trigger) String() string { return proto.CompactTextString(m) }
 func (*Trigger) ProtoMessage()    {}
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{6}
+	return fileDescriptor_21a7b2476cbc6216, []int{9}
 }
 func (m *Trigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +979,7 @@ func (m *CommitOrigin) Reset()         { *m = CommitOrigin{} }
 func (m *CommitOrigin) String() string { return proto.CompactTextString(m) }
 func (*CommitOrigin) ProtoMessage()    {}
 func (*CommitOrigin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{7}
+	return fileDescriptor_21a7b2476cbc6216, []int{10}
 }
 func (m *CommitOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +1029,7 @@ type Commit struct {
 func (m *Commit) Reset()      { *m = Commit{} }
 func (*Commit) ProtoMessage() {}
 func (*Commit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{8}
+	return fileDescriptor_21a7b2476cbc6216, []int{11}
 }
 func (m *Commit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -847,7 +1096,7 @@ func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
 func (m *CommitInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()    {}
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{9}
+	return fileDescriptor_21a7b2476cbc6216, []int{12}
 }
 func (m *CommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -965,6 +1214,8 @@ type CommitInfo_Details struct {
 	SizeBytes            int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 	CompactingTime       *types.Duration `protobuf:"bytes,2,opt,name=compacting_time,json=compactingTime,proto3" json:"compacting_time,omitempty"`
 	ValidatingTime       *types.Duration `protobuf:"bytes,3,opt,name=validating_time,json=validatingTime,proto3" json:"validating_time,omitempty"`
+	FileCount            int64           `protobuf:"varint,4,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	ChunkBytes           int64           `protobuf:"varint,5,opt,name=chunk_bytes,json=chunkBytes,proto3" json:"chunk_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -974,7 +1225,7 @@ func (m *CommitInfo_Details) Reset()         { *m = CommitInfo_Details{} }
 func (m *CommitInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo_Details) ProtoMessage()    {}
 func (*CommitInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{9, 0}
+	return fileDescriptor_21a7b2476cbc6216, []int{12, 0}
 }
 func (m *CommitInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,6 +1275,20 @@ func (m *CommitInfo_Details) GetValidatingTime() *types.Duration {
 	return nil
 }
 
+func (m *CommitInfo_Details) GetFileCount() int64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
+func (m *CommitInfo_Details) GetChunkBytes() int64 {
+	if m != nil {
+		return m.ChunkBytes
+	}
+	return 0
+}
+
 type CommitSet struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1035,7 +1300,7 @@ func (m *CommitSet) Reset()         { *m = CommitSet{} }
 func (m *CommitSet) String() string { return proto.CompactTextString(m) }
 func (*CommitSet) ProtoMessage()    {}
 func (*CommitSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{10}
+	return fileDescriptor_21a7b2476cbc6216, []int{13}
 }
 func (m *CommitSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1348,7 @@ func (m *CommitSetInfo) Reset()         { *m = CommitSetInfo{} }
 func (m *CommitSetInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitSetInfo) ProtoMessage()    {}
 func (*CommitSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{11}
+	return fileDescriptor_21a7b2476cbc6216, []int{14}
 }
 func (m *CommitSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1141,7 +1406,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{12}
+	return fileDescriptor_21a7b2476cbc6216, []int{15}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1218,7 +1483,7 @@ func (m *CreateRepoRequest) Reset()         { *m = CreateRepoRequest{} }
 func (m *CreateRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRepoRequest) ProtoMessage()    {}
 func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{13}
+	return fileDescriptor_21a7b2476cbc6216, []int{16}
 }
 func (m *CreateRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,7 +1544,7 @@ func (m *InspectRepoRequest) Reset()         { *m = InspectRepoRequest{} }
 func (m *InspectRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectRepoRequest) ProtoMessage()    {}
 func (*InspectRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{14}
+	return fileDescriptor_21a7b2476cbc6216, []int{17}
 }
 func (m *InspectRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1318,7 +1583,9 @@ func (m *InspectRepoRequest) GetRepo() *Repo {
 type ListRepoRequest struct {
 	// type is the type of (system) repos that should be returned
 	// an empty string requests all repos
-	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// usage requests that RepoInfo.Details.Usage be computed for each repo
+	Usage                bool     `protobuf:"varint,2,opt,name=usage,proto3" json:"usage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1328,7 +1595,7 @@ func (m *ListRepoRequest) Reset()         { *m = ListRepoRequest{} }
 func (m *ListRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRepoRequest) ProtoMessage()    {}
 func (*ListRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{15}
+	return fileDescriptor_21a7b2476cbc6216, []int{18}
 }
 func (m *ListRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1364,6 +1631,13 @@ func (m *ListRepoRequest) GetType() string {
 	return ""
 }
 
+func (m *ListRepoRequest) GetUsage() bool {
+	if m != nil {
+		return m.Usage
+	}
+	return false
+}
+
 type DeleteRepoRequest struct {
 	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
@@ -1376,7 +1650,7 @@ func (m *DeleteRepoRequest) Reset()         { *m = DeleteRepoRequest{} }
 func (m *DeleteRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRepoRequest) ProtoMessage()    {}
 func (*DeleteRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{16}
+	return fileDescriptor_21a7b2476cbc6216, []int{19}
 }
 func (m *DeleteRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1435,7 +1709,7 @@ func (m *StartCommitRequest) Reset()         { *m = StartCommitRequest{} }
 func (m *StartCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*StartCommitRequest) ProtoMessage()    {}
 func (*StartCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{17}
+	return fileDescriptor_21a7b2476cbc6216, []int{20}
 }
 func (m *StartCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1501,7 +1775,7 @@ func (m *FinishCommitRequest) Reset()         { *m = FinishCommitRequest{} }
 func (m *FinishCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*FinishCommitRequest) ProtoMessage()    {}
 func (*FinishCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{18}
+	return fileDescriptor_21a7b2476cbc6216, []int{21}
 }
 func (m *FinishCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1571,7 +1845,7 @@ func (m *InspectCommitRequest) Reset()         { *m = InspectCommitRequest{} }
 func (m *InspectCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectCommitRequest) ProtoMessage()    {}
 func (*InspectCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{19}
+	return fileDescriptor_21a7b2476cbc6216, []int{22}
 }
 func (m *InspectCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1631,7 +1905,7 @@ func (m *ListCommitRequest) Reset()         { *m = ListCommitRequest{} }
 func (m *ListCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCommitRequest) ProtoMessage()    {}
 func (*ListCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{20}
+	return fileDescriptor_21a7b2476cbc6216, []int{23}
 }
 func (m *ListCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1721,7 +1995,7 @@ func (m *InspectCommitSetRequest) Reset()         { *m = InspectCommitSetRequest
 func (m *InspectCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectCommitSetRequest) ProtoMessage()    {}
 func (*InspectCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{21}
+	return fileDescriptor_21a7b2476cbc6216, []int{24}
 }
 func (m *InspectCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1774,7 +2048,7 @@ func (m *ListCommitSetRequest) Reset()         { *m = ListCommitSetRequest{} }
 func (m *ListCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCommitSetRequest) ProtoMessage()    {}
 func (*ListCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{22}
+	return fileDescriptor_21a7b2476cbc6216, []int{25}
 }
 func (m *ListCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1814,7 +2088,7 @@ func (m *SquashCommitSetRequest) Reset()         { *m = SquashCommitSetRequest{}
 func (m *SquashCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*SquashCommitSetRequest) ProtoMessage()    {}
 func (*SquashCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{23}
+	return fileDescriptor_21a7b2476cbc6216, []int{26}
 }
 func (m *SquashCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1861,7 +2135,7 @@ func (m *DropCommitSetRequest) Reset()         { *m = DropCommitSetRequest{} }
 func (m *DropCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*DropCommitSetRequest) ProtoMessage()    {}
 func (*DropCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{24}
+	return fileDescriptor_21a7b2476cbc6216, []int{27}
 }
 func (m *DropCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2189,7 @@ func (m *SubscribeCommitRequest) Reset()         { *m = SubscribeCommitRequest{}
 func (m *SubscribeCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeCommitRequest) ProtoMessage()    {}
 func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{25}
+	return fileDescriptor_21a7b2476cbc6216, []int{28}
 }
 func (m *SubscribeCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1997,7 +2271,7 @@ func (m *ClearCommitRequest) Reset()         { *m = ClearCommitRequest{} }
 func (m *ClearCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ClearCommitRequest) ProtoMessage()    {}
 func (*ClearCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{26}
+	return fileDescriptor_21a7b2476cbc6216, []int{29}
 }
 func (m *ClearCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2034,21 +2308,23 @@ func (m *ClearCommitRequest) GetCommit() *Commit {
 }
 
 type CreateBranchRequest struct {
-	Head                 *Commit   `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	Branch               *Branch   `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	Provenance           []*Branch `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty"`
-	Trigger              *Trigger  `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	NewCommitSet         bool      `protobuf:"varint,5,opt,name=new_commit_set,json=newCommitSet,proto3" json:"new_commit_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Head         *Commit   `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Branch       *Branch   `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Provenance   []*Branch `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty"`
+	Trigger      *Trigger  `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	NewCommitSet bool      `protobuf:"varint,5,opt,name=new_commit_set,json=newCommitSet,proto3" json:"new_commit_set,omitempty"`
+	// If set, replaces the branch's protection rules. An empty message clears them.
+	Protection           *BranchProtection `protobuf:"bytes,6,opt,name=protection,proto3" json:"protection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *CreateBranchRequest) Reset()         { *m = CreateBranchRequest{} }
 func (m *CreateBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateBranchRequest) ProtoMessage()    {}
 func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{27}
+	return fileDescriptor_21a7b2476cbc6216, []int{30}
 }
 func (m *CreateBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2112,6 +2388,13 @@ func (m *CreateBranchRequest) GetNewCommitSet() bool {
 	return false
 }
 
+func (m *CreateBranchRequest) GetProtection() *BranchProtection {
+	if m != nil {
+		return m.Protection
+	}
+	return nil
+}
+
 type InspectBranchRequest struct {
 	Branch               *Branch  `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2123,7 +2406,7 @@ func (m *InspectBranchRequest) Reset()         { *m = InspectBranchRequest{} }
 func (m *InspectBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectBranchRequest) ProtoMessage()    {}
 func (*InspectBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{28}
+	return fileDescriptor_21a7b2476cbc6216, []int{31}
 }
 func (m *InspectBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2171,7 +2454,7 @@ func (m *ListBranchRequest) Reset()         { *m = ListBranchRequest{} }
 func (m *ListBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*ListBranchRequest) ProtoMessage()    {}
 func (*ListBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{29}
+	return fileDescriptor_21a7b2476cbc6216, []int{32}
 }
 func (m *ListBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2226,7 +2509,7 @@ func (m *DeleteBranchRequest) Reset()         { *m = DeleteBranchRequest{} }
 func (m *DeleteBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteBranchRequest) ProtoMessage()    {}
 func (*DeleteBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{30}
+	return fileDescriptor_21a7b2476cbc6216, []int{33}
 }
 func (m *DeleteBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2285,7 +2568,7 @@ func (m *AddFile) Reset()         { *m = AddFile{} }
 func (m *AddFile) String() string { return proto.CompactTextString(m) }
 func (*AddFile) ProtoMessage()    {}
 func (*AddFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{31}
+	return fileDescriptor_21a7b2476cbc6216, []int{34}
 }
 func (m *AddFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2385,7 +2668,7 @@ func (m *AddFile_URLSource) Reset()         { *m = AddFile_URLSource{} }
 func (m *AddFile_URLSource) String() string { return proto.CompactTextString(m) }
 func (*AddFile_URLSource) ProtoMessage()    {}
 func (*AddFile_URLSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{31, 0}
+	return fileDescriptor_21a7b2476cbc6216, []int{34, 0}
 }
 func (m *AddFile_URLSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2440,7 +2723,7 @@ func (m *DeleteFile) Reset()         { *m = DeleteFile{} }
 func (m *DeleteFile) String() string { return proto.CompactTextString(m) }
 func (*DeleteFile) ProtoMessage()    {}
 func (*DeleteFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{32}
+	return fileDescriptor_21a7b2476cbc6216, []int{35}
 }
 func (m *DeleteFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2497,7 +2780,7 @@ func (m *CopyFile) Reset()         { *m = CopyFile{} }
 func (m *CopyFile) String() string { return proto.CompactTextString(m) }
 func (*CopyFile) ProtoMessage()    {}
 func (*CopyFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{33}
+	return fileDescriptor_21a7b2476cbc6216, []int{36}
 }
 func (m *CopyFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2570,7 +2853,7 @@ func (m *ModifyFileRequest) Reset()         { *m = ModifyFileRequest{} }
 func (m *ModifyFileRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyFileRequest) ProtoMessage()    {}
 func (*ModifyFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{34}
+	return fileDescriptor_21a7b2476cbc6216, []int{37}
 }
 func (m *ModifyFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2681,7 +2964,7 @@ func (m *GetFileRequest) Reset()         { *m = GetFileRequest{} }
 func (m *GetFileRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFileRequest) ProtoMessage()    {}
 func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{35}
+	return fileDescriptor_21a7b2476cbc6216, []int{38}
 }
 func (m *GetFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2742,7 +3025,7 @@ func (m *InspectFileRequest) Reset()         { *m = InspectFileRequest{} }
 func (m *InspectFileRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectFileRequest) ProtoMessage()    {}
 func (*InspectFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{36}
+	return fileDescriptor_21a7b2476cbc6216, []int{39}
 }
 func (m *InspectFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2793,7 +3076,7 @@ func (m *ListFileRequest) Reset()         { *m = ListFileRequest{} }
 func (m *ListFileRequest) String() string { return proto.CompactTextString(m) }
 func (*ListFileRequest) ProtoMessage()    {}
 func (*ListFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{37}
+	return fileDescriptor_21a7b2476cbc6216, []int{40}
 }
 func (m *ListFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2840,7 +3123,7 @@ func (m *WalkFileRequest) Reset()         { *m = WalkFileRequest{} }
 func (m *WalkFileRequest) String() string { return proto.CompactTextString(m) }
 func (*WalkFileRequest) ProtoMessage()    {}
 func (*WalkFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{38}
+	return fileDescriptor_21a7b2476cbc6216, []int{41}
 }
 func (m *WalkFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2888,7 +3171,7 @@ func (m *GlobFileRequest) Reset()         { *m = GlobFileRequest{} }
 func (m *GlobFileRequest) String() string { return proto.CompactTextString(m) }
 func (*GlobFileRequest) ProtoMessage()    {}
 func (*GlobFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{39}
+	return fileDescriptor_21a7b2476cbc6216, []int{42}
 }
 func (m *GlobFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2946,7 +3229,7 @@ func (m *DiffFileRequest) Reset()         { *m = DiffFileRequest{} }
 func (m *DiffFileRequest) String() string { return proto.CompactTextString(m) }
 func (*DiffFileRequest) ProtoMessage()    {}
 func (*DiffFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{40}
+	return fileDescriptor_21a7b2476cbc6216, []int{43}
 }
 func (m *DiffFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3008,7 +3291,7 @@ func (m *DiffFileResponse) Reset()         { *m = DiffFileResponse{} }
 func (m *DiffFileResponse) String() string { return proto.CompactTextString(m) }
 func (*DiffFileResponse) ProtoMessage()    {}
 func (*DiffFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{41}
+	return fileDescriptor_21a7b2476cbc6216, []int{44}
 }
 func (m *DiffFileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3062,7 +3345,7 @@ func (m *FsckRequest) Reset()         { *m = FsckRequest{} }
 func (m *FsckRequest) String() string { return proto.CompactTextString(m) }
 func (*FsckRequest) ProtoMessage()    {}
 func (*FsckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{42}
+	return fileDescriptor_21a7b2476cbc6216, []int{45}
 }
 func (m *FsckRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3110,7 +3393,7 @@ func (m *FsckResponse) Reset()         { *m = FsckResponse{} }
 func (m *FsckResponse) String() string { return proto.CompactTextString(m) }
 func (*FsckResponse) ProtoMessage()    {}
 func (*FsckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{43}
+	return fileDescriptor_21a7b2476cbc6216, []int{46}
 }
 func (m *FsckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3153,6 +3436,140 @@ func (m *FsckResponse) GetError() string {
 	return ""
 }
 
+// SweepOrphansRequest sweeps chunk storage for objects that are not tracked
+// in the database.
+type SweepOrphansRequest struct {
+	// dry_run reports orphaned objects without recording or deleting them.
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// grace_period is how long an object must have been orphaned before it is
+	// deleted. If unset, a default of 24 hours is used. It must be at least one
+	// hour, so that uploads which are still in progress are never deleted.
+	GracePeriod          *types.Duration `protobuf:"bytes,2,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SweepOrphansRequest) Reset()         { *m = SweepOrphansRequest{} }
+func (m *SweepOrphansRequest) String() string { return proto.CompactTextString(m) }
+func (*SweepOrphansRequest) ProtoMessage()    {}
+func (*SweepOrphansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{47}
+}
+func (m *SweepOrphansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SweepOrphansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SweepOrphansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SweepOrphansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepOrphansRequest.Merge(m, src)
+}
+func (m *SweepOrphansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SweepOrphansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepOrphansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepOrphansRequest proto.InternalMessageInfo
+
+func (m *SweepOrphansRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *SweepOrphansRequest) GetGracePeriod() *types.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return nil
+}
+
+type SweepOrphansResponse struct {
+	Object string `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// orphaned_since is when the object was first seen to be orphaned.
+	OrphanedSince *types.Timestamp `protobuf:"bytes,2,opt,name=orphaned_since,json=orphanedSince,proto3" json:"orphaned_since,omitempty"`
+	Deleted       bool             `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// tier is the chunk storage tier ("hot" or "cold") that the object is in.
+	Tier                 string   `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SweepOrphansResponse) Reset()         { *m = SweepOrphansResponse{} }
+func (m *SweepOrphansResponse) String() string { return proto.CompactTextString(m) }
+func (*SweepOrphansResponse) ProtoMessage()    {}
+func (*SweepOrphansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{48}
+}
+func (m *SweepOrphansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SweepOrphansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SweepOrphansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SweepOrphansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepOrphansResponse.Merge(m, src)
+}
+func (m *SweepOrphansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SweepOrphansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepOrphansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepOrphansResponse proto.InternalMessageInfo
+
+func (m *SweepOrphansResponse) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+func (m *SweepOrphansResponse) GetOrphanedSince() *types.Timestamp {
+	if m != nil {
+		return m.OrphanedSince
+	}
+	return nil
+}
+
+func (m *SweepOrphansResponse) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *SweepOrphansResponse) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
 type CreateFileSetResponse struct {
 	FileSetId            string   `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3164,7 +3581,7 @@ func (m *CreateFileSetResponse) Reset()         { *m = CreateFileSetResponse{} }
 func (m *CreateFileSetResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateFileSetResponse) ProtoMessage()    {}
 func (*CreateFileSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{44}
+	return fileDescriptor_21a7b2476cbc6216, []int{49}
 }
 func (m *CreateFileSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3211,7 +3628,7 @@ func (m *GetFileSetRequest) Reset()         { *m = GetFileSetRequest{} }
 func (m *GetFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFileSetRequest) ProtoMessage()    {}
 func (*GetFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{45}
+	return fileDescriptor_21a7b2476cbc6216, []int{50}
 }
 func (m *GetFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3676,7 @@ func (m *AddFileSetRequest) Reset()         { *m = AddFileSetRequest{} }
 func (m *AddFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*AddFileSetRequest) ProtoMessage()    {}
 func (*AddFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{46}
+	return fileDescriptor_21a7b2476cbc6216, []int{51}
 }
 func (m *AddFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3314,7 +3731,7 @@ func (m *RenewFileSetRequest) Reset()         { *m = RenewFileSetRequest{} }
 func (m *RenewFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*RenewFileSetRequest) ProtoMessage()    {}
 func (*RenewFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{47}
+	return fileDescriptor_21a7b2476cbc6216, []int{52}
 }
 func (m *RenewFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3369,7 +3786,7 @@ func (m *ComposeFileSetRequest) Reset()         { *m = ComposeFileSetRequest{} }
 func (m *ComposeFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ComposeFileSetRequest) ProtoMessage()    {}
 func (*ComposeFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{48}
+	return fileDescriptor_21a7b2476cbc6216, []int{53}
 }
 func (m *ComposeFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3422,7 +3839,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{49}
+	return fileDescriptor_21a7b2476cbc6216, []int{54}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3461,7 +3878,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{50}
+	return fileDescriptor_21a7b2476cbc6216, []int{55}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3503,7 +3920,7 @@ func (m *RunLoadTestRequest) Reset()         { *m = RunLoadTestRequest{} }
 func (m *RunLoadTestRequest) String() string { return proto.CompactTextString(m) }
 func (*RunLoadTestRequest) ProtoMessage()    {}
 func (*RunLoadTestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{51}
+	return fileDescriptor_21a7b2476cbc6216, []int{56}
 }
 func (m *RunLoadTestRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3553,6 +3970,94 @@ func (m *RunLoadTestRequest) GetSeed() int64 {
 	return 0
 }
 
+// SetRepoQuotaRequest creates or replaces a quota, a quota with no limits set
+// is deleted.
+type SetRepoQuotaRequest struct {
+	Quota                *RepoQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetRepoQuotaRequest) Reset()         { *m = SetRepoQuotaRequest{} }
+func (m *SetRepoQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRepoQuotaRequest) ProtoMessage()    {}
+func (*SetRepoQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{57}
+}
+func (m *SetRepoQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRepoQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRepoQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetRepoQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRepoQuotaRequest.Merge(m, src)
+}
+func (m *SetRepoQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetRepoQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRepoQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRepoQuotaRequest proto.InternalMessageInfo
+
+func (m *SetRepoQuotaRequest) GetQuota() *RepoQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type ListRepoQuotaRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRepoQuotaRequest) Reset()         { *m = ListRepoQuotaRequest{} }
+func (m *ListRepoQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRepoQuotaRequest) ProtoMessage()    {}
+func (*ListRepoQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{58}
+}
+func (m *ListRepoQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListRepoQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListRepoQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListRepoQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRepoQuotaRequest.Merge(m, src)
+}
+func (m *ListRepoQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListRepoQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRepoQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRepoQuotaRequest proto.InternalMessageInfo
+
 type RunLoadTestResponse struct {
 	Spec                 string          `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
 	Branch               *Branch         `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
@@ -3568,7 +4073,7 @@ func (m *RunLoadTestResponse) Reset()         { *m = RunLoadTestResponse{} }
 func (m *RunLoadTestResponse) String() string { return proto.CompactTextString(m) }
 func (*RunLoadTestResponse) ProtoMessage()    {}
 func (*RunLoadTestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{52}
+	return fileDescriptor_21a7b2476cbc6216, []int{59}
 }
 func (m *RunLoadTestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3642,8 +4147,11 @@ func init() {
 	proto.RegisterType((*File)(nil), "pfs_v2.File")
 	proto.RegisterType((*RepoInfo)(nil), "pfs_v2.RepoInfo")
 	proto.RegisterType((*RepoInfo_Details)(nil), "pfs_v2.RepoInfo.Details")
+	proto.RegisterType((*RepoUsage)(nil), "pfs_v2.RepoUsage")
+	proto.RegisterType((*RepoQuota)(nil), "pfs_v2.RepoQuota")
 	proto.RegisterType((*RepoAuthInfo)(nil), "pfs_v2.RepoAuthInfo")
 	proto.RegisterType((*BranchInfo)(nil), "pfs_v2.BranchInfo")
+	proto.RegisterType((*BranchProtection)(nil), "pfs_v2.BranchProtection")
 	proto.RegisterType((*Trigger)(nil), "pfs_v2.Trigger")
 	proto.RegisterType((*CommitOrigin)(nil), "pfs_v2.CommitOrigin")
 	proto.RegisterType((*Commit)(nil), "pfs_v2.Commit")
@@ -3684,6 +4192,8 @@ func init() {
 	proto.RegisterType((*DiffFileResponse)(nil), "pfs_v2.DiffFileResponse")
 	proto.RegisterType((*FsckRequest)(nil), "pfs_v2.FsckRequest")
 	proto.RegisterType((*FsckResponse)(nil), "pfs_v2.FsckResponse")
+	proto.RegisterType((*SweepOrphansRequest)(nil), "pfs_v2.SweepOrphansRequest")
+	proto.RegisterType((*SweepOrphansResponse)(nil), "pfs_v2.SweepOrphansResponse")
 	proto.RegisterType((*CreateFileSetResponse)(nil), "pfs_v2.CreateFileSetResponse")
 	proto.RegisterType((*GetFileSetRequest)(nil), "pfs_v2.GetFileSetRequest")
 	proto.RegisterType((*AddFileSetRequest)(nil), "pfs_v2.AddFileSetRequest")
@@ -3692,189 +4202,217 @@ func init() {
 	proto.RegisterType((*ActivateAuthRequest)(nil), "pfs_v2.ActivateAuthRequest")
 	proto.RegisterType((*ActivateAuthResponse)(nil), "pfs_v2.ActivateAuthResponse")
 	proto.RegisterType((*RunLoadTestRequest)(nil), "pfs_v2.RunLoadTestRequest")
+	proto.RegisterType((*SetRepoQuotaRequest)(nil), "pfs_v2.SetRepoQuotaRequest")
+	proto.RegisterType((*ListRepoQuotaRequest)(nil), "pfs_v2.ListRepoQuotaRequest")
 	proto.RegisterType((*RunLoadTestResponse)(nil), "pfs_v2.RunLoadTestResponse")
 }
 
 func init() { proto.RegisterFile("pfs/pfs.proto", fileDescriptor_21a7b2476cbc6216) }
 
 var fileDescriptor_21a7b2476cbc6216 = []byte{
-	// 2808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcf, 0x73, 0xdb, 0xc6,
-	0xf5, 0x17, 0x08, 0x8a, 0x3f, 0x1e, 0x29, 0x89, 0x5a, 0xc9, 0x0a, 0x43, 0x27, 0xb2, 0x07, 0xdf,
-	0x6f, 0x1d, 0xc7, 0x49, 0x24, 0x57, 0x8e, 0x9d, 0xa6, 0x6e, 0xda, 0xa1, 0x44, 0xda, 0x62, 0x24,
-	0x53, 0x2e, 0x28, 0x3b, 0x6d, 0x92, 0x19, 0x0e, 0x04, 0x2c, 0x45, 0xd4, 0x20, 0x80, 0x00, 0xa0,
-	0x54, 0x75, 0xa6, 0x3d, 0xb6, 0x87, 0xfe, 0x03, 0x3d, 0xe6, 0x4f, 0xe8, 0xe4, 0xaf, 0xc8, 0xb1,
-	0xe7, 0x1e, 0x3a, 0x1d, 0x9f, 0x7a, 0xee, 0xa1, 0xe7, 0xce, 0xfe, 0x00, 0x16, 0x00, 0xc1, 0x1f,
-	0x72, 0x73, 0xe1, 0x2c, 0x76, 0xdf, 0x7b, 0xfb, 0xf6, 0xbd, 0xb7, 0x6f, 0x3f, 0xef, 0x11, 0x56,
-	0xdc, 0x81, 0xbf, 0xeb, 0x0e, 0xfc, 0x1d, 0xd7, 0x73, 0x02, 0x07, 0x15, 0xdc, 0x81, 0xdf, 0xbf,
-	0xd8, 0x6b, 0xdc, 0x3c, 0x77, 0x9c, 0x73, 0x0b, 0xef, 0xd2, 0xd9, 0xb3, 0xf1, 0x60, 0x17, 0x8f,
-	0xdc, 0xe0, 0x8a, 0x11, 0x35, 0x6e, 0xa5, 0x17, 0x03, 0x73, 0x84, 0xfd, 0x40, 0x1b, 0xb9, 0x9c,
-	0x60, 0x3b, 0x4d, 0x70, 0xe9, 0x69, 0xae, 0x8b, 0x3d, 0x7f, 0xda, 0xba, 0x31, 0xf6, 0xb4, 0xc0,
-	0x74, 0x6c, 0xbe, 0xbe, 0x79, 0xee, 0x9c, 0x3b, 0x74, 0xb8, 0x4b, 0x46, 0x7c, 0x76, 0x4d, 0x1b,
-	0x07, 0xc3, 0x5d, 0xf2, 0xc3, 0x26, 0x94, 0x8f, 0x21, 0xaf, 0x62, 0xd7, 0x41, 0x08, 0xf2, 0xb6,
-	0x36, 0xc2, 0x75, 0xe9, 0xb6, 0x74, 0xb7, 0xac, 0xd2, 0x31, 0x99, 0x0b, 0xae, 0x5c, 0x5c, 0xcf,
-	0xb1, 0x39, 0x32, 0xfe, 0x69, 0xfe, 0x2f, 0xdf, 0xde, 0x5a, 0x52, 0x5a, 0x50, 0xd8, 0xf7, 0x34,
-	0x5b, 0x1f, 0xa2, 0xdb, 0x90, 0xf7, 0xb0, 0xeb, 0x50, 0xbe, 0xca, 0x5e, 0x75, 0x87, 0x9d, 0x7d,
-	0x87, 0xc8, 0x54, 0xe9, 0x4a, 0x24, 0x39, 0x27, 0x24, 0x73, 0x29, 0xbf, 0x82, 0xfc, 0x13, 0xd3,
-	0xc2, 0xe8, 0x0e, 0x14, 0x74, 0x67, 0x34, 0x32, 0x03, 0x2e, 0x65, 0x35, 0x94, 0x72, 0x40, 0x67,
-	0x55, 0xbe, 0x4a, 0x24, 0xb9, 0x5a, 0x30, 0x0c, 0x25, 0x91, 0x31, 0xda, 0x84, 0x65, 0x43, 0x0b,
-	0xc6, 0xa3, 0xba, 0x4c, 0x27, 0xd9, 0x87, 0xf2, 0x9f, 0x1c, 0x94, 0x88, 0x0a, 0x1d, 0x7b, 0xe0,
-	0x2c, 0xa0, 0xe2, 0xc7, 0x50, 0xd4, 0x3d, 0xac, 0x05, 0xd8, 0xa0, 0xb2, 0x2b, 0x7b, 0x8d, 0x1d,
-	0x66, 0xdd, 0x9d, 0xd0, 0xba, 0x3b, 0xa7, 0xa1, 0x7b, 0xd4, 0x90, 0x14, 0x3d, 0x80, 0x2d, 0xdf,
-	0xfc, 0x1d, 0xee, 0x9f, 0x5d, 0x05, 0xd8, 0xef, 0x8f, 0x89, 0x73, 0xfa, 0x67, 0xce, 0xd8, 0x36,
-	0xa8, 0x2e, 0xb2, 0xba, 0x41, 0x56, 0xf7, 0xc9, 0xe2, 0x0b, 0xb2, 0xb6, 0x4f, 0x96, 0xd0, 0x6d,
-	0xa8, 0x18, 0xd8, 0xd7, 0x3d, 0xd3, 0x25, 0xbe, 0xaa, 0xe7, 0xa9, 0xd6, 0xf1, 0x29, 0x74, 0x0f,
-	0x4a, 0x67, 0xd4, 0xb6, 0xd8, 0xaf, 0x2f, 0xdf, 0x96, 0xe3, 0xf6, 0x60, 0x36, 0x57, 0xa3, 0x75,
-	0xf4, 0x63, 0x28, 0x13, 0x5f, 0xf6, 0x4d, 0x7b, 0xe0, 0xd4, 0x0b, 0x54, 0xf5, 0xcd, 0xf8, 0xf9,
-	0x9a, 0xe3, 0x60, 0x48, 0x6c, 0xa0, 0x96, 0x34, 0x3e, 0x42, 0x7b, 0x50, 0x34, 0x70, 0xa0, 0x99,
-	0x96, 0x5f, 0x2f, 0x52, 0x86, 0x7a, 0x9c, 0x81, 0x90, 0xec, 0xb4, 0xd8, 0xba, 0x1a, 0x12, 0x36,
-	0xee, 0x42, 0x91, 0xcf, 0xa1, 0x77, 0x01, 0xc4, 0xa1, 0xa9, 0x49, 0x65, 0xb5, 0x1c, 0x1d, 0x54,
-	0xf9, 0x0a, 0xaa, 0xf1, 0x7d, 0xd1, 0x43, 0xa8, 0xb8, 0xd8, 0x1b, 0x99, 0xbe, 0x6f, 0x3a, 0x36,
-	0xa1, 0x97, 0xef, 0xae, 0xee, 0x6d, 0xec, 0x50, 0xa5, 0x2f, 0xf6, 0x76, 0x9e, 0x47, 0x6b, 0x6a,
-	0x9c, 0x8e, 0x78, 0xd5, 0x73, 0x2c, 0xec, 0xd7, 0x73, 0xb7, 0x65, 0xe2, 0x55, 0xfa, 0xa1, 0x7c,
-	0x9b, 0x03, 0x60, 0x26, 0xa0, 0xb2, 0xef, 0x40, 0x81, 0x19, 0x22, 0x1d, 0x36, 0xdc, 0x4c, 0x7c,
-	0x15, 0x29, 0x90, 0x1f, 0x62, 0x2d, 0x74, 0x6d, 0x3a, 0xb8, 0xe8, 0x1a, 0xda, 0x01, 0x70, 0x3d,
-	0xe7, 0x02, 0xdb, 0x9a, 0xad, 0xe3, 0xba, 0x9c, 0x69, 0xf6, 0x18, 0x05, 0xa1, 0xf7, 0xc7, 0x67,
-	0x21, 0x7d, 0x3e, 0x9b, 0x5e, 0x50, 0xa0, 0xc7, 0xb0, 0x6e, 0x98, 0x1e, 0xd6, 0x83, 0x7e, 0x6c,
-	0x9b, 0x6c, 0xef, 0xd6, 0x18, 0xe1, 0x73, 0xb1, 0xd9, 0xfb, 0x50, 0x0c, 0x3c, 0xf3, 0xfc, 0x1c,
-	0x7b, 0xdc, 0xc7, 0x6b, 0x21, 0xcb, 0x29, 0x9b, 0x56, 0xc3, 0x75, 0xe5, 0x0f, 0x50, 0xe4, 0x73,
-	0x68, 0x2b, 0x61, 0x9e, 0x72, 0x64, 0x8e, 0x1a, 0xc8, 0x9a, 0x65, 0x51, 0x6b, 0x94, 0x54, 0x32,
-	0x44, 0x37, 0xa1, 0xac, 0x7b, 0x8e, 0xdd, 0xf7, 0x5d, 0xac, 0xf3, 0x7b, 0x54, 0x22, 0x13, 0x3d,
-	0x17, 0xeb, 0xe4, 0xd2, 0x11, 0xf7, 0xf2, 0x48, 0xa5, 0x63, 0x54, 0x87, 0x22, 0xbb, 0x92, 0x24,
-	0x42, 0x49, 0x04, 0x84, 0x9f, 0xca, 0x23, 0xa8, 0x32, 0xbb, 0x9e, 0x78, 0xe6, 0xb9, 0x69, 0xa3,
-	0x3b, 0x90, 0x7f, 0x65, 0xda, 0x06, 0x55, 0x61, 0x75, 0x0f, 0x85, 0x7a, 0xb3, 0xd5, 0x23, 0xd3,
-	0x36, 0x54, 0xba, 0xae, 0x74, 0xa1, 0xc0, 0xf8, 0x16, 0xf6, 0xea, 0x16, 0xe4, 0x4c, 0xe6, 0xd3,
-	0xf2, 0x7e, 0xe1, 0xf5, 0x3f, 0x6e, 0xe5, 0x3a, 0x2d, 0x35, 0x67, 0x1a, 0x3c, 0xb5, 0xfc, 0xa9,
-	0x00, 0xc0, 0x04, 0x86, 0xa1, 0xb2, 0x50, 0x86, 0xf9, 0x10, 0x0a, 0x0e, 0x55, 0x8d, 0x07, 0xcb,
-	0x66, 0x92, 0x8e, 0xa9, 0xad, 0x72, 0x9a, 0xf4, 0x5d, 0x96, 0x27, 0xef, 0xf2, 0x03, 0x58, 0x71,
-	0x35, 0x0f, 0xdb, 0x41, 0x9f, 0x6f, 0x9f, 0xcf, 0xdc, 0xbe, 0xca, 0x88, 0xb8, 0x05, 0x1e, 0xc0,
-	0x8a, 0x3e, 0x34, 0x2d, 0xa3, 0x2f, 0x6c, 0x2c, 0x67, 0x31, 0x51, 0x22, 0xf6, 0xe1, 0x93, 0x14,
-	0xe6, 0x07, 0x9a, 0x47, 0x52, 0x58, 0x61, 0x7e, 0x0a, 0xe3, 0xa4, 0xe8, 0x27, 0x50, 0x1e, 0x98,
-	0xb6, 0xe9, 0x0f, 0x4d, 0xfb, 0x9c, 0xa7, 0x83, 0x59, 0x7c, 0x82, 0x18, 0x3d, 0x82, 0x12, 0xfb,
-	0xc0, 0x46, 0xbd, 0x34, 0x97, 0x31, 0xa2, 0xcd, 0xbe, 0x08, 0xe5, 0x05, 0x2f, 0xc2, 0x26, 0x2c,
-	0x63, 0xcf, 0x73, 0xbc, 0x3a, 0xb0, 0x64, 0x4f, 0x3f, 0x66, 0xe4, 0xe1, 0xca, 0xf4, 0x3c, 0xfc,
-	0xb1, 0x48, 0x83, 0x55, 0xae, 0x7e, 0xc2, 0xbc, 0xd9, 0x89, 0xf0, 0xaf, 0xd2, 0xa2, 0x99, 0x10,
-	0xed, 0xc3, 0x9a, 0xee, 0x8c, 0x5c, 0x4d, 0x0f, 0x4c, 0xfb, 0xbc, 0x4f, 0x5e, 0x77, 0x1e, 0x53,
-	0x6f, 0x4f, 0xd8, 0xa9, 0xc5, 0x5f, 0x6e, 0x75, 0x55, 0x70, 0x10, 0xdb, 0x11, 0x19, 0x17, 0x9a,
-	0x65, 0x1a, 0x9a, 0x90, 0x21, 0xcf, 0x95, 0x21, 0x38, 0x88, 0x0c, 0xe5, 0xff, 0xa0, 0xcc, 0x4e,
-	0xd4, 0xc3, 0x01, 0xbf, 0x34, 0x52, 0xfa, 0xd2, 0x28, 0x0e, 0xac, 0x44, 0x44, 0xf4, 0xc2, 0xdc,
-	0x07, 0x60, 0xd1, 0xd7, 0xf7, 0x71, 0x78, 0x69, 0xd6, 0x93, 0x16, 0xea, 0xe1, 0x40, 0x2d, 0xeb,
-	0x91, 0xe8, 0x0f, 0x45, 0x4e, 0xc8, 0x51, 0x77, 0xa2, 0x49, 0x83, 0x8a, 0x3c, 0xf1, 0xbd, 0x04,
-	0x25, 0xf2, 0xf6, 0x87, 0x0f, 0xf4, 0xc0, 0xb4, 0x70, 0xfa, 0x81, 0x26, 0xeb, 0x2a, 0x5d, 0x41,
-	0x1f, 0x91, 0x38, 0xb5, 0x70, 0x3f, 0x82, 0x23, 0xab, 0x7b, 0xb5, 0x38, 0xd9, 0xe9, 0x95, 0x8b,
-	0x49, 0x90, 0xb1, 0x11, 0x09, 0x6b, 0xb6, 0x11, 0xb9, 0x0e, 0xf2, 0xfc, 0xb0, 0x8e, 0x88, 0x53,
-	0x4e, 0xcd, 0xa7, 0x9d, 0x8a, 0x20, 0x3f, 0xd4, 0xfc, 0x21, 0xcd, 0x7a, 0x55, 0x95, 0x8e, 0x15,
-	0x07, 0xd6, 0x0f, 0x28, 0x22, 0xa0, 0x80, 0x02, 0x7f, 0x33, 0xc6, 0x7e, 0xb0, 0x00, 0xe6, 0x48,
-	0x25, 0x8f, 0xdc, 0x64, 0xf2, 0xd8, 0x82, 0xc2, 0xd8, 0x35, 0xb4, 0x80, 0x39, 0xbd, 0xa4, 0xf2,
-	0x2f, 0xe5, 0x11, 0xa0, 0x8e, 0x4d, 0x72, 0x75, 0x70, 0xad, 0x1d, 0x95, 0x1f, 0xc1, 0xda, 0xb1,
-	0xe9, 0x27, 0x98, 0x42, 0x84, 0x27, 0x09, 0x84, 0xa7, 0x1c, 0xc1, 0x7a, 0x0b, 0x5b, 0xf8, 0xba,
-	0xe7, 0xd9, 0x84, 0xe5, 0x81, 0xe3, 0xe9, 0x98, 0x3f, 0x2c, 0xec, 0x43, 0xf9, 0xa3, 0x04, 0xa8,
-	0x47, 0x92, 0x0d, 0x4f, 0x5a, 0x5c, 0xdc, 0x1d, 0x28, 0xb0, 0x94, 0x37, 0x2d, 0x1f, 0xb3, 0xd5,
-	0x05, 0x8c, 0x24, 0x9e, 0x0b, 0x79, 0xd6, 0x73, 0xa1, 0xfc, 0x59, 0x82, 0x8d, 0x27, 0x34, 0x09,
-	0x4d, 0x68, 0xb2, 0xd0, 0xcb, 0x30, 0x5f, 0x93, 0x28, 0x39, 0xc9, 0xf1, 0xe4, 0x14, 0x99, 0x25,
-	0x1f, 0x37, 0xcb, 0x39, 0x6c, 0x72, 0x17, 0xbe, 0x99, 0x36, 0xef, 0x41, 0xfe, 0x52, 0x33, 0x03,
-	0x7e, 0x15, 0x36, 0x52, 0x17, 0x33, 0x20, 0xc1, 0x48, 0x09, 0x94, 0x7f, 0x4b, 0xb0, 0x4e, 0x9c,
-	0x9e, 0xdc, 0x66, 0xbe, 0x37, 0x15, 0xc8, 0x0f, 0x3c, 0x67, 0x34, 0x0d, 0x33, 0x91, 0x35, 0xb4,
-	0x0d, 0xb9, 0xc0, 0x49, 0x9b, 0x9d, 0x53, 0xe4, 0x02, 0x87, 0xc4, 0xaf, 0x3d, 0x1e, 0x9d, 0x61,
-	0x8f, 0xdf, 0x23, 0xfe, 0x45, 0xd0, 0x83, 0x87, 0x2f, 0xb0, 0xe7, 0x63, 0x7a, 0x8f, 0x4a, 0x6a,
-	0xf8, 0x19, 0x42, 0x93, 0x82, 0x80, 0x26, 0x0f, 0xa0, 0xc2, 0x1e, 0xdb, 0x3e, 0x85, 0x11, 0xc5,
-	0xa9, 0x30, 0x02, 0x9c, 0x68, 0xac, 0xf4, 0xe1, 0xad, 0x84, 0x75, 0x49, 0xa6, 0xe2, 0x27, 0xbf,
-	0x7e, 0x5e, 0x43, 0x31, 0x53, 0x97, 0xb8, 0x55, 0xb7, 0x60, 0x53, 0x18, 0x55, 0x48, 0x57, 0x3e,
-	0x87, 0xad, 0xde, 0x37, 0x63, 0x2d, 0x8c, 0xb1, 0xff, 0x65, 0x5f, 0xe5, 0x10, 0x36, 0x5b, 0x9e,
-	0xe3, 0xfe, 0x00, 0x92, 0xfe, 0x25, 0xc1, 0x56, 0x6f, 0x7c, 0x46, 0x22, 0xf5, 0x0c, 0x5f, 0x37,
-	0x10, 0x04, 0x8a, 0xcc, 0x25, 0x50, 0x64, 0x18, 0x20, 0xf2, 0x8c, 0x00, 0x79, 0x1f, 0x96, 0x7d,
-	0x12, 0x8b, 0xd4, 0xff, 0x53, 0xc2, 0x94, 0x51, 0x84, 0x9e, 0x5f, 0x9e, 0xea, 0xf9, 0xc2, 0x42,
-	0x9e, 0xff, 0x19, 0xa0, 0x03, 0x0b, 0x6b, 0xde, 0x1b, 0xdd, 0x2a, 0xe5, 0xb5, 0x04, 0x1b, 0x2c,
-	0x95, 0xf3, 0xe4, 0xc1, 0xf9, 0xc3, 0x02, 0x42, 0x9a, 0x51, 0x40, 0xdc, 0x49, 0xd8, 0x69, 0x3a,
-	0x6c, 0xbd, 0x6e, 0xa1, 0x11, 0xc3, 0xfe, 0xf9, 0xd9, 0xd8, 0x1f, 0xfd, 0x3f, 0xac, 0xda, 0xf8,
-	0xb2, 0x1f, 0x8b, 0x0e, 0x66, 0xce, 0xaa, 0x8d, 0x2f, 0xa3, 0xc0, 0x50, 0x7e, 0x1e, 0xa5, 0x9e,
-	0xe4, 0x21, 0x17, 0xc4, 0xdd, 0xca, 0x09, 0x4b, 0x28, 0x49, 0xe6, 0xf9, 0x71, 0x14, 0xbb, 0xf4,
-	0xb9, 0xc4, 0xa5, 0x57, 0x7a, 0xb0, 0xc1, 0xde, 0x9b, 0x37, 0xd2, 0x67, 0xca, 0xbb, 0xf3, 0x77,
-	0x09, 0x8a, 0x4d, 0xc3, 0xa0, 0xed, 0x85, 0xb0, 0x6d, 0x20, 0x65, 0xb5, 0x0d, 0x72, 0xb1, 0xb6,
-	0x01, 0xda, 0x05, 0xd9, 0xd3, 0x2e, 0x79, 0x4c, 0xdf, 0x9c, 0x40, 0x0c, 0x14, 0x03, 0xbc, 0xd4,
-	0xac, 0x31, 0x3e, 0x5c, 0x52, 0x09, 0x25, 0xfa, 0x08, 0xe4, 0xb1, 0x67, 0x71, 0xcf, 0xbc, 0x1d,
-	0x6a, 0xc8, 0x37, 0xde, 0x79, 0xa1, 0x1e, 0xf7, 0x9c, 0xb1, 0xa7, 0x53, 0xf2, 0xb1, 0x67, 0x35,
-	0x1e, 0x43, 0x39, 0x9a, 0x23, 0x21, 0xff, 0x42, 0x3d, 0xe6, 0x5a, 0x91, 0x21, 0x7a, 0x07, 0xca,
-	0x1e, 0xd6, 0xc7, 0x9e, 0x6f, 0x5e, 0x84, 0xc7, 0x11, 0x13, 0xfb, 0x25, 0x28, 0xf8, 0x94, 0x53,
-	0x79, 0x04, 0xc0, 0x2c, 0x76, 0xbd, 0xe3, 0x29, 0xbf, 0x81, 0xd2, 0x81, 0xe3, 0x5e, 0x51, 0xae,
-	0x1a, 0xc8, 0x86, 0x1f, 0x84, 0xbb, 0x1b, 0x7e, 0x30, 0xc5, 0x24, 0xdb, 0x20, 0xfb, 0x9e, 0xce,
-	0x4d, 0x92, 0x84, 0x66, 0x64, 0x81, 0xe4, 0x07, 0xcd, 0x75, 0xb1, 0x6d, 0xf0, 0x07, 0x8e, 0x7f,
-	0x91, 0xbb, 0xb4, 0xfe, 0xcc, 0x31, 0xcc, 0x01, 0xdd, 0x2e, 0x74, 0xea, 0x2e, 0x80, 0x8f, 0xa3,
-	0x62, 0x28, 0xf3, 0x3e, 0x1d, 0x2e, 0xa9, 0x65, 0x1f, 0x87, 0xb5, 0xd0, 0x87, 0x50, 0xd2, 0x0c,
-	0xa3, 0x4f, 0xe1, 0x61, 0x2e, 0x19, 0xff, 0xdc, 0xca, 0x87, 0x4b, 0x6a, 0x51, 0xe3, 0x9e, 0x7e,
-	0x48, 0x1e, 0x69, 0x62, 0x18, 0xc6, 0xc0, 0x94, 0x8e, 0x72, 0x86, 0xb0, 0xd9, 0xe1, 0x92, 0x0a,
-	0x86, 0xb0, 0xe0, 0x2e, 0x81, 0x8b, 0xee, 0x15, 0x63, 0x62, 0xbe, 0xac, 0x09, 0xa5, 0x98, 0xc1,
-	0x0e, 0x97, 0xd4, 0x92, 0xce, 0xc7, 0xfb, 0x05, 0xc8, 0x9f, 0x39, 0xc6, 0x95, 0xf2, 0x35, 0xac,
-	0x3e, 0xc5, 0x41, 0xfc, 0x80, 0xf3, 0xa1, 0x2c, 0x77, 0x7b, 0x4e, 0xb8, 0x7d, 0x0b, 0x0a, 0xce,
-	0x60, 0x40, 0xee, 0x2b, 0xeb, 0x1b, 0xf1, 0xaf, 0x18, 0xce, 0xbb, 0xd6, 0x0e, 0xca, 0xa7, 0x0c,
-	0xe7, 0x5d, 0x8b, 0xe9, 0xf3, 0x7c, 0x29, 0x57, 0x93, 0x95, 0x07, 0xb0, 0xf6, 0x85, 0x66, 0xbd,
-	0xba, 0xde, 0x7e, 0x3d, 0x58, 0x7b, 0x6a, 0x39, 0x67, 0x71, 0xa6, 0x45, 0x71, 0x4c, 0x1d, 0x8a,
-	0xae, 0x16, 0x04, 0xd8, 0x0b, 0x11, 0x55, 0xf8, 0xa9, 0xfc, 0x1e, 0xd6, 0x5a, 0xe6, 0x60, 0x10,
-	0x17, 0xfa, 0x1e, 0x94, 0x48, 0x7e, 0x9b, 0xaa, 0x4d, 0xd1, 0xc6, 0x97, 0xd4, 0x9f, 0xef, 0x41,
-	0xc9, 0xb1, 0x12, 0x41, 0x93, 0x22, 0x74, 0x2c, 0x16, 0x2f, 0x75, 0x28, 0xfa, 0x43, 0xcd, 0xb2,
-	0x9c, 0x4b, 0x0e, 0xb1, 0xc3, 0x4f, 0xc5, 0x82, 0x9a, 0xd8, 0xde, 0x77, 0x1d, 0xdb, 0xc7, 0xe8,
-	0x83, 0x89, 0xfd, 0x13, 0x35, 0x08, 0x2b, 0x70, 0x42, 0x1d, 0x3e, 0x98, 0xd0, 0x21, 0x83, 0x98,
-	0xeb, 0xa1, 0xdc, 0x82, 0xca, 0x13, 0x5f, 0x7f, 0x15, 0x1e, 0xb4, 0x06, 0xf2, 0xc0, 0xfc, 0x2d,
-	0xdd, 0xa3, 0xa4, 0x92, 0xa1, 0xf2, 0x08, 0xaa, 0x8c, 0x80, 0xab, 0x12, 0xa3, 0x28, 0x53, 0x0a,
-	0x81, 0x3e, 0x73, 0x31, 0xf4, 0xa9, 0x7c, 0x02, 0x37, 0xd8, 0x83, 0x46, 0xb6, 0xa1, 0x20, 0x82,
-	0x0b, 0xd8, 0x86, 0x0a, 0x2d, 0xa8, 0xc8, 0x6d, 0x0c, 0x2b, 0x42, 0x95, 0xd6, 0x58, 0xa4, 0x02,
-	0x34, 0x94, 0xc7, 0xb0, 0xce, 0x23, 0x3b, 0x06, 0x3d, 0x16, 0x7d, 0x47, 0xbf, 0x82, 0x75, 0x7e,
-	0x39, 0xaf, 0xcf, 0x9c, 0xd6, 0x2c, 0x97, 0xd6, 0xec, 0x25, 0x6c, 0xa8, 0x98, 0x5b, 0x39, 0x26,
-	0x7e, 0xce, 0x81, 0xd0, 0x2d, 0xa8, 0x04, 0x81, 0xd5, 0xf7, 0xb1, 0xee, 0xd8, 0x86, 0x4f, 0xc5,
-	0xca, 0x2a, 0x04, 0x81, 0xd5, 0x63, 0x33, 0xca, 0x97, 0x70, 0xe3, 0xc0, 0x19, 0xb9, 0x8e, 0x8f,
-	0x53, 0x92, 0x6f, 0x43, 0x35, 0x26, 0x99, 0xf5, 0x30, 0xcb, 0x2a, 0x44, 0xa2, 0xfd, 0xf9, 0xb2,
-	0x6f, 0xc0, 0x46, 0x53, 0x0f, 0xcc, 0x0b, 0x2d, 0xc0, 0xcd, 0x71, 0x10, 0x3e, 0x71, 0x04, 0x46,
-	0x26, 0xa7, 0x99, 0x73, 0x14, 0x03, 0x90, 0x3a, 0xb6, 0x8f, 0x1d, 0xcd, 0x38, 0xc5, 0x7e, 0x10,
-	0xab, 0xd5, 0x68, 0x83, 0x8e, 0xe7, 0x79, 0x32, 0x5e, 0x18, 0x75, 0x10, 0x5e, 0x8c, 0xc3, 0xc6,
-	0x34, 0x1d, 0x2b, 0xdf, 0x49, 0xb0, 0x91, 0xd8, 0x86, 0x87, 0xc6, 0x0f, 0xbc, 0x8f, 0x88, 0xcc,
-	0x7c, 0xbc, 0x2e, 0x7a, 0x08, 0xa5, 0xf0, 0x0f, 0x0b, 0x0a, 0x53, 0x66, 0xf6, 0x34, 0x22, 0xd2,
-	0x7b, 0x5d, 0x00, 0x01, 0xfd, 0xd0, 0x5b, 0xb0, 0x71, 0xa2, 0x76, 0x9e, 0x76, 0xba, 0xfd, 0xa3,
-	0x4e, 0xb7, 0xd5, 0x7f, 0xd1, 0x3d, 0xea, 0x9e, 0x7c, 0xd1, 0xad, 0x2d, 0xa1, 0x12, 0xe4, 0x5f,
-	0xf4, 0xda, 0x6a, 0x4d, 0x22, 0xa3, 0xe6, 0x8b, 0xd3, 0x93, 0x5a, 0x8e, 0x8c, 0x9e, 0xf4, 0x0e,
-	0x8e, 0x6a, 0x32, 0x2a, 0xc3, 0x72, 0xf3, 0xb8, 0xd3, 0xec, 0xd5, 0xf2, 0xf7, 0x3e, 0x60, 0x6d,
-	0x08, 0xda, 0x35, 0xa8, 0x42, 0x49, 0x6d, 0xf7, 0xda, 0xea, 0xcb, 0x76, 0x8b, 0x89, 0x78, 0xd2,
-	0x39, 0x6e, 0xd7, 0x24, 0x54, 0x04, 0xb9, 0xd5, 0x51, 0x6b, 0xb9, 0x7b, 0x5f, 0x43, 0x25, 0x06,
-	0x5d, 0x51, 0x1d, 0x36, 0x0f, 0x4e, 0x9e, 0x3d, 0xeb, 0x9c, 0xf6, 0x7b, 0xa7, 0xcd, 0xd3, 0x76,
-	0x6c, 0xfb, 0x0a, 0x14, 0x7b, 0xa7, 0x4d, 0xf5, 0xb4, 0xdd, 0xaa, 0x49, 0x64, 0x37, 0xb5, 0xdd,
-	0x6c, 0xfd, 0xba, 0x96, 0x43, 0x2b, 0x50, 0x7e, 0xd2, 0xe9, 0x76, 0x7a, 0x87, 0x9d, 0xee, 0xd3,
-	0x9a, 0x4c, 0x36, 0x64, 0x9f, 0xed, 0x56, 0x2d, 0x7f, 0xef, 0x31, 0x94, 0x5b, 0xd8, 0x32, 0x47,
-	0x66, 0x80, 0x3d, 0xb2, 0x7b, 0xf7, 0xa4, 0xdb, 0x66, 0x7a, 0x7c, 0xde, 0x3b, 0xe9, 0xb2, 0xa3,
-	0x1c, 0x77, 0xba, 0xed, 0x5a, 0x8e, 0x68, 0xd4, 0xfb, 0xe5, 0x71, 0x4d, 0x26, 0x83, 0x83, 0xde,
-	0xcb, 0x5a, 0x7e, 0xef, 0xbb, 0x0d, 0x90, 0x9b, 0xcf, 0x3b, 0xa8, 0x09, 0x20, 0x9a, 0x11, 0x28,
-	0x42, 0x24, 0x13, 0x0d, 0x8a, 0xc6, 0xd6, 0x84, 0xb5, 0xdb, 0x23, 0x37, 0xb8, 0x52, 0x96, 0xd0,
-	0x67, 0x50, 0x89, 0xb5, 0x17, 0x50, 0xd4, 0x17, 0x9b, 0xec, 0x39, 0x34, 0x6a, 0xe9, 0xbf, 0x0e,
-	0x94, 0x25, 0xf4, 0x29, 0x94, 0xc2, 0x2e, 0x03, 0x7a, 0x2b, 0x5c, 0x4f, 0xf5, 0x1d, 0xb2, 0x18,
-	0xef, 0x4b, 0x44, 0x79, 0xd1, 0x79, 0x10, 0xca, 0x4f, 0x74, 0x23, 0x66, 0x28, 0xff, 0x18, 0x2a,
-	0xb1, 0x76, 0x83, 0x50, 0x7e, 0xb2, 0x07, 0xd1, 0x48, 0x25, 0x20, 0x65, 0x09, 0xb5, 0xa1, 0x1a,
-	0x6f, 0x11, 0xa0, 0x9b, 0x22, 0x63, 0x4f, 0x34, 0x0e, 0x66, 0xe8, 0x70, 0x00, 0x95, 0x58, 0x11,
-	0x22, 0x74, 0x98, 0xac, 0x4c, 0x66, 0x0a, 0x59, 0x49, 0xd4, 0xb0, 0xe8, 0x9d, 0x94, 0x1f, 0x92,
-	0x82, 0x32, 0x9a, 0x6d, 0xca, 0x12, 0xfa, 0x05, 0x80, 0xa8, 0x53, 0x85, 0x41, 0x27, 0x1a, 0x02,
-	0xd9, 0xec, 0xf7, 0x25, 0xd4, 0x81, 0xb5, 0x54, 0xe5, 0x88, 0xb6, 0x23, 0x93, 0x66, 0x96, 0x94,
-	0x53, 0x45, 0x1d, 0x41, 0x2d, 0x5d, 0x94, 0xa3, 0x5b, 0x99, 0x67, 0x12, 0xb9, 0x77, 0xaa, 0xb0,
-	0x43, 0x58, 0x49, 0x14, 0xe0, 0xc2, 0x3a, 0x59, 0x75, 0x79, 0xe3, 0xc6, 0x44, 0x7d, 0x1c, 0x53,
-	0x6b, 0x2d, 0x55, 0xb2, 0xc7, 0x4e, 0x98, 0x59, 0xcb, 0xcf, 0x70, 0xda, 0x53, 0x58, 0x49, 0xd4,
-	0xec, 0x42, 0xad, 0xac, 0x52, 0x7e, 0x86, 0xa0, 0x36, 0x54, 0xe3, 0x85, 0xa8, 0x88, 0xc4, 0x8c,
-	0xf2, 0x74, 0xa1, 0x20, 0xe2, 0x72, 0xd2, 0x41, 0x94, 0x14, 0x84, 0x92, 0x59, 0x3d, 0x19, 0x44,
-	0x5c, 0x42, 0x22, 0x88, 0x16, 0x60, 0xbf, 0x2f, 0x91, 0xc3, 0xc4, 0x0b, 0x3c, 0x71, 0x98, 0x8c,
-	0xb2, 0x6f, 0xe6, 0x61, 0x40, 0x14, 0x14, 0x42, 0x8f, 0x89, 0x22, 0x63, 0xba, 0x88, 0xbb, 0x12,
-	0xda, 0x87, 0x22, 0xc7, 0x35, 0x68, 0x2b, 0x94, 0x90, 0x84, 0xf0, 0x8d, 0x59, 0x75, 0x1f, 0x3f,
-	0x0f, 0x70, 0x96, 0xd3, 0xa6, 0xfa, 0xe6, 0x62, 0x44, 0x9e, 0xa5, 0xea, 0xa4, 0xf3, 0x6c, 0x5c,
-	0xd6, 0x04, 0x74, 0x14, 0x79, 0x96, 0xf2, 0x26, 0xf2, 0xec, 0x1c, 0xc6, 0xfb, 0x12, 0x61, 0x0d,
-	0x51, 0xbe, 0x60, 0x4d, 0xe1, 0xfe, 0xe9, 0xac, 0x21, 0xd6, 0x17, 0xac, 0x29, 0xf4, 0x3f, 0x85,
-	0xb5, 0x09, 0xa5, 0x10, 0x52, 0x0b, 0xd6, 0x14, 0xc6, 0x6f, 0xd4, 0x27, 0x17, 0x38, 0x28, 0x62,
-	0x97, 0xb5, 0x1a, 0x07, 0x4c, 0x22, 0x92, 0x32, 0xd0, 0x55, 0xe3, 0x9d, 0xec, 0xc5, 0x50, 0x1c,
-	0xfa, 0x8c, 0xbe, 0xb7, 0x38, 0xc0, 0x4d, 0xcb, 0x42, 0x53, 0x62, 0x66, 0x46, 0x38, 0x3e, 0x84,
-	0x3c, 0x81, 0xe4, 0x28, 0xea, 0x6a, 0xc5, 0x10, 0x7c, 0x63, 0x33, 0x39, 0x19, 0x3b, 0xc2, 0x33,
-	0x58, 0x49, 0x20, 0xf2, 0x59, 0x81, 0xfc, 0x6e, 0xf2, 0xd6, 0xa7, 0x30, 0x3c, 0x8d, 0xe7, 0xc3,
-	0x28, 0x16, 0x13, 0xb2, 0x26, 0xb0, 0xfb, 0x5c, 0x59, 0xe4, 0xf1, 0x15, 0xa0, 0x1d, 0xa5, 0x7b,
-	0x19, 0x8b, 0x66, 0xad, 0x38, 0x34, 0x17, 0xee, 0xc9, 0x00, 0xec, 0x33, 0xc4, 0x3c, 0x87, 0xd5,
-	0x24, 0x12, 0x47, 0xef, 0xc6, 0xf2, 0xf7, 0x24, 0x42, 0x9f, 0x7f, 0xb6, 0x43, 0xa8, 0xc4, 0x90,
-	0xae, 0xb8, 0x6a, 0x93, 0x28, 0xbb, 0x71, 0x33, 0x73, 0x2d, 0x92, 0x74, 0x94, 0x80, 0xe6, 0x2d,
-	0x3c, 0xd0, 0xc6, 0x56, 0x30, 0x35, 0x7a, 0x66, 0x0b, 0xdb, 0xff, 0xe4, 0xfb, 0xd7, 0xdb, 0xd2,
-	0xdf, 0x5e, 0x6f, 0x4b, 0xff, 0x7c, 0xbd, 0x2d, 0x7d, 0xf9, 0xfe, 0xb9, 0x19, 0x0c, 0xc7, 0x67,
-	0x3b, 0xba, 0x33, 0xda, 0x75, 0x35, 0x7d, 0x78, 0x65, 0x60, 0x2f, 0x3e, 0xba, 0xd8, 0xdb, 0xf5,
-	0x3d, 0x7d, 0xd7, 0x1d, 0xf8, 0x67, 0x05, 0xba, 0xcf, 0x83, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0xb3, 0x03, 0x6c, 0x45, 0x79, 0x24, 0x00, 0x00,
+	// 3230 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0x5b, 0x6f, 0x1b, 0xd7,
+	0xd1, 0x22, 0x97, 0xe2, 0x65, 0xa8, 0x0b, 0x75, 0x24, 0xcb, 0x0c, 0x6d, 0xcb, 0xc6, 0xe6, 0x83,
+	0x93, 0xd8, 0x89, 0xe4, 0x4f, 0x76, 0x9c, 0xe4, 0x73, 0x92, 0x0f, 0x94, 0x28, 0x5b, 0x8c, 0x65,
+	0xc9, 0x59, 0x4a, 0x4e, 0x9b, 0x04, 0x20, 0x56, 0xbb, 0x87, 0xe4, 0xc6, 0xcb, 0x3d, 0xeb, 0xbd,
+	0x48, 0x51, 0x81, 0xb6, 0x6f, 0x7d, 0x29, 0xd0, 0xe7, 0x3e, 0x14, 0x48, 0x7f, 0x43, 0xff, 0x44,
+	0xf3, 0x52, 0xa0, 0xcf, 0x79, 0x28, 0x0a, 0x3f, 0xf5, 0xb1, 0xe8, 0x2f, 0x28, 0xce, 0x65, 0xaf,
+	0x5c, 0x5e, 0x64, 0xe4, 0x45, 0x38, 0x7b, 0xe6, 0x72, 0x66, 0xe6, 0xcc, 0xcc, 0x99, 0x19, 0x0a,
+	0x16, 0xed, 0x9e, 0xbb, 0x65, 0xf7, 0xdc, 0x4d, 0xdb, 0x21, 0x1e, 0x41, 0x45, 0xbb, 0xe7, 0x76,
+	0xcf, 0xb6, 0x1b, 0xd7, 0xfa, 0x84, 0xf4, 0x4d, 0xbc, 0xc5, 0x76, 0x4f, 0xfd, 0xde, 0x16, 0x1e,
+	0xda, 0xde, 0x05, 0x47, 0x6a, 0xdc, 0x4c, 0x03, 0x3d, 0x63, 0x88, 0x5d, 0x4f, 0x1d, 0xda, 0x02,
+	0x61, 0x23, 0x8d, 0x70, 0xee, 0xa8, 0xb6, 0x8d, 0x1d, 0x77, 0x1c, 0x5c, 0xf7, 0x1d, 0xd5, 0x33,
+	0x88, 0x25, 0xe0, 0x6b, 0x7d, 0xd2, 0x27, 0x6c, 0xb9, 0x45, 0x57, 0x62, 0x77, 0x59, 0xf5, 0xbd,
+	0xc1, 0x16, 0xfd, 0xc3, 0x37, 0xe4, 0x07, 0x50, 0x50, 0xb0, 0x4d, 0x10, 0x82, 0x82, 0xa5, 0x0e,
+	0x71, 0x3d, 0x77, 0x2b, 0xf7, 0x6e, 0x45, 0x61, 0x6b, 0xba, 0xe7, 0x5d, 0xd8, 0xb8, 0x9e, 0xe7,
+	0x7b, 0x74, 0xfd, 0x7f, 0x85, 0x3f, 0xfe, 0xf9, 0xe6, 0x9c, 0xdc, 0x82, 0xe2, 0x8e, 0xa3, 0x5a,
+	0xda, 0x00, 0xdd, 0x82, 0x82, 0x83, 0x6d, 0xc2, 0xe8, 0xaa, 0xdb, 0x0b, 0x9b, 0x5c, 0xf7, 0x4d,
+	0xca, 0x53, 0x61, 0x90, 0x90, 0x73, 0x3e, 0xe2, 0x2c, 0xb8, 0xfc, 0x02, 0x0a, 0x8f, 0x0d, 0x13,
+	0xa3, 0xdb, 0x50, 0xd4, 0xc8, 0x70, 0x68, 0x78, 0x82, 0xcb, 0x52, 0xc0, 0x65, 0x97, 0xed, 0x2a,
+	0x02, 0x4a, 0x39, 0xd9, 0xaa, 0x37, 0x08, 0x38, 0xd1, 0x35, 0x5a, 0x83, 0x79, 0x5d, 0xf5, 0xfc,
+	0x61, 0x5d, 0x62, 0x9b, 0xfc, 0x43, 0xfe, 0x41, 0x82, 0x32, 0x15, 0xa1, 0x6d, 0xf5, 0xc8, 0x0c,
+	0x22, 0x3e, 0x80, 0x92, 0xe6, 0x60, 0xd5, 0xc3, 0x3a, 0xe3, 0x5d, 0xdd, 0x6e, 0x6c, 0x72, 0xeb,
+	0x6e, 0x06, 0xd6, 0xdd, 0x3c, 0x0e, 0xae, 0x47, 0x09, 0x50, 0xd1, 0x7d, 0x58, 0x77, 0x8d, 0x5f,
+	0xe1, 0xee, 0xe9, 0x85, 0x87, 0xdd, 0xae, 0x4f, 0x2f, 0xa7, 0x7b, 0x4a, 0x7c, 0x4b, 0x67, 0xb2,
+	0x48, 0xca, 0x2a, 0x85, 0xee, 0x50, 0xe0, 0x09, 0x85, 0xed, 0x50, 0x10, 0xba, 0x05, 0x55, 0x1d,
+	0xbb, 0x9a, 0x63, 0xd8, 0xf4, 0xae, 0xea, 0x05, 0x26, 0x75, 0x7c, 0x0b, 0xdd, 0x81, 0xf2, 0x29,
+	0xb3, 0x2d, 0x76, 0xeb, 0xf3, 0xb7, 0xa4, 0xb8, 0x3d, 0xb8, 0xcd, 0x95, 0x10, 0x8e, 0xfe, 0x17,
+	0x2a, 0xf4, 0x2e, 0xbb, 0x86, 0xd5, 0x23, 0xf5, 0x22, 0x13, 0x7d, 0x2d, 0xae, 0x5f, 0xd3, 0xf7,
+	0x06, 0xd4, 0x06, 0x4a, 0x59, 0x15, 0x2b, 0xb4, 0x0d, 0x25, 0x1d, 0x7b, 0xaa, 0x61, 0xba, 0xf5,
+	0x12, 0x23, 0xa8, 0xc7, 0x09, 0x28, 0xca, 0x66, 0x8b, 0xc3, 0x95, 0x00, 0xb1, 0xf1, 0x25, 0x94,
+	0xc4, 0x1e, 0xba, 0x01, 0x10, 0x29, 0xcd, 0x4c, 0x2a, 0x29, 0x95, 0x50, 0x51, 0xf4, 0x0e, 0xcc,
+	0xfb, 0xae, 0xda, 0xc7, 0xc2, 0x8e, 0x2b, 0x71, 0xde, 0x27, 0x14, 0xa0, 0x70, 0xb8, 0x6c, 0x43,
+	0x25, 0xdc, 0x43, 0x6f, 0xc3, 0xa2, 0x49, 0xfa, 0x86, 0xa6, 0x9a, 0x09, 0xbe, 0x0b, 0x62, 0x93,
+	0xb3, 0xbe, 0x09, 0x55, 0x6d, 0xe0, 0x5b, 0x2f, 0x05, 0x4a, 0x9e, 0xa1, 0x00, 0xdb, 0xe2, 0x08,
+	0x37, 0x00, 0x7a, 0x86, 0x89, 0xbb, 0x1a, 0xf1, 0x2d, 0x4f, 0xdc, 0x41, 0x85, 0xee, 0xec, 0xd2,
+	0x0d, 0xf9, 0xb7, 0xfc, 0xc4, 0x2f, 0x7d, 0xe2, 0xa9, 0x33, 0xf8, 0xc4, 0x3a, 0x14, 0x6d, 0x07,
+	0xf7, 0x8c, 0xef, 0x85, 0xbb, 0x89, 0x2f, 0x74, 0x0d, 0x2a, 0x43, 0xf5, 0x7b, 0x21, 0x04, 0x3f,
+	0xa4, 0x3c, 0x54, 0xbf, 0xe7, 0x22, 0x08, 0x20, 0x3d, 0xd4, 0xad, 0x17, 0x42, 0x20, 0xf5, 0x72,
+	0x57, 0xfe, 0x06, 0x16, 0xe2, 0x77, 0x82, 0x3e, 0x84, 0xaa, 0x8d, 0x9d, 0xa1, 0xe1, 0xba, 0x06,
+	0xb1, 0xa8, 0xce, 0xd2, 0xbb, 0x4b, 0xdb, 0xab, 0x9b, 0xec, 0x42, 0xcf, 0xb6, 0x37, 0x9f, 0x87,
+	0x30, 0x25, 0x8e, 0x47, 0x3d, 0xde, 0x21, 0x26, 0xb3, 0x80, 0x44, 0x3d, 0x9e, 0x7d, 0xc8, 0x3f,
+	0xe5, 0x01, 0xb8, 0x7b, 0x30, 0xde, 0xb7, 0xa1, 0xc8, 0x9d, 0x24, 0x1d, 0x52, 0xc2, 0x85, 0x04,
+	0x14, 0xc9, 0x50, 0x18, 0x60, 0x35, 0x70, 0xfb, 0x74, 0xe0, 0x31, 0x18, 0xda, 0x04, 0xb0, 0x1d,
+	0x72, 0x86, 0x2d, 0xd5, 0xd2, 0x70, 0x5d, 0xca, 0x74, 0xc9, 0x18, 0x06, 0xc5, 0x77, 0xfd, 0xd3,
+	0x00, 0xbf, 0x90, 0x8d, 0x1f, 0x61, 0xa0, 0x47, 0xb0, 0xa2, 0x1b, 0x0e, 0xd6, 0xbc, 0x6e, 0xec,
+	0x98, 0x6c, 0xcf, 0xaf, 0x71, 0xc4, 0xe7, 0xd1, 0x61, 0xef, 0x41, 0xc9, 0x73, 0x8c, 0x7e, 0x1f,
+	0x3b, 0xc2, 0xff, 0x97, 0x03, 0x92, 0x63, 0xbe, 0xad, 0x04, 0x70, 0xf4, 0x31, 0xd3, 0xc3, 0xc3,
+	0x1a, 0x8b, 0xbc, 0x94, 0xf3, 0xf3, 0x03, 0x9e, 0x87, 0x70, 0x25, 0x86, 0x2b, 0xff, 0x2d, 0x07,
+	0xb5, 0x34, 0x02, 0xba, 0x03, 0x2b, 0x16, 0xe9, 0x0a, 0xc9, 0x79, 0x86, 0xe2, 0x8e, 0x5b, 0x56,
+	0x96, 0x2d, 0xd2, 0x62, 0xfb, 0xdc, 0x8e, 0x2e, 0xba, 0x0b, 0x2b, 0x0e, 0x7e, 0xe5, 0x1b, 0x0e,
+	0xa6, 0x3a, 0x0e, 0x09, 0x93, 0x20, 0xcf, 0x70, 0x6b, 0x02, 0xf0, 0x3c, 0xd8, 0xa7, 0x4e, 0x44,
+	0x19, 0x63, 0x13, 0x7b, 0x98, 0x79, 0x58, 0x59, 0x29, 0x5b, 0xa4, 0xc5, 0xbe, 0x91, 0x0c, 0x8b,
+	0x16, 0xe9, 0xf6, 0x88, 0xa3, 0xe1, 0xee, 0x90, 0x9c, 0x61, 0xe6, 0x65, 0x65, 0xa5, 0x6a, 0x91,
+	0xc7, 0x74, 0xef, 0x19, 0x39, 0xc3, 0x34, 0x52, 0x4e, 0x2f, 0x6c, 0xd5, 0x75, 0xbb, 0xd4, 0x37,
+	0xea, 0xf3, 0xcc, 0x7f, 0x81, 0x6f, 0x29, 0xc4, 0xc4, 0xf2, 0x6f, 0xa0, 0x24, 0xac, 0x43, 0xdd,
+	0x3c, 0xe6, 0x28, 0x95, 0xd0, 0x31, 0x6a, 0x20, 0xa9, 0xa6, 0x29, 0x64, 0xa4, 0x4b, 0x2a, 0x96,
+	0xe6, 0x10, 0xab, 0xeb, 0xda, 0x58, 0x13, 0xd9, 0xb6, 0x4c, 0x37, 0x3a, 0x36, 0xd6, 0x68, 0x6a,
+	0xa6, 0x49, 0x40, 0xe4, 0x33, 0xb6, 0x46, 0x75, 0x28, 0x05, 0x66, 0x99, 0x67, 0xa1, 0x10, 0x7c,
+	0xca, 0x0f, 0x61, 0x81, 0x5b, 0xe6, 0xc8, 0x31, 0xfa, 0x86, 0x85, 0x6e, 0x43, 0xe1, 0xa5, 0x61,
+	0xe9, 0x4c, 0x84, 0xa5, 0x6d, 0x14, 0xdc, 0x09, 0x87, 0x3e, 0x35, 0x2c, 0x5d, 0x61, 0x70, 0xf9,
+	0x10, 0x8a, 0x9c, 0x6e, 0x66, 0xff, 0x5e, 0x87, 0xbc, 0xc1, 0xbd, 0xbb, 0xb2, 0x53, 0x7c, 0xfd,
+	0x8f, 0x9b, 0xf9, 0x76, 0x4b, 0xc9, 0x1b, 0xba, 0x78, 0x80, 0xfe, 0x5a, 0x04, 0xe0, 0x0c, 0x83,
+	0xa0, 0x99, 0xe9, 0x1d, 0x7a, 0x1f, 0x8a, 0x84, 0x89, 0x56, 0xcf, 0x27, 0x53, 0x6e, 0x5c, 0x29,
+	0x45, 0xe0, 0xa4, 0x33, 0xbe, 0x34, 0x9a, 0xf1, 0xef, 0xc3, 0xa2, 0xad, 0x3a, 0xd8, 0x0a, 0xdc,
+	0xa8, 0x5e, 0xc8, 0x3c, 0x7e, 0x81, 0x23, 0xf1, 0x2f, 0x4a, 0xa4, 0x0d, 0x0c, 0x53, 0xef, 0x46,
+	0x36, 0x96, 0xb2, 0x88, 0x18, 0x52, 0xe0, 0x87, 0x0f, 0xa0, 0xe4, 0x7a, 0xaa, 0x43, 0x1f, 0xba,
+	0xe2, 0xf4, 0x87, 0x4e, 0xa0, 0xa2, 0x8f, 0xa1, 0xd2, 0x33, 0x2c, 0xc3, 0x1d, 0x18, 0x56, 0xbf,
+	0x5e, 0x9a, 0x4a, 0x17, 0x21, 0xa3, 0x87, 0x50, 0xe6, 0x1f, 0x58, 0xaf, 0x97, 0xa7, 0x12, 0x86,
+	0xb8, 0xd9, 0x29, 0xa1, 0x32, 0x63, 0x4a, 0x58, 0x83, 0x79, 0xec, 0x38, 0xc4, 0xa9, 0x03, 0x2f,
+	0x09, 0xd8, 0xc7, 0x84, 0xd7, 0xba, 0x3a, 0xfe, 0xb5, 0x7e, 0x10, 0x3d, 0x96, 0x0b, 0x42, 0xfc,
+	0x84, 0x79, 0xb3, 0x9f, 0xcb, 0x7f, 0xe7, 0x66, 0x7e, 0x2f, 0x77, 0x60, 0x59, 0x23, 0x43, 0x5b,
+	0xd5, 0x3c, 0xc3, 0xea, 0x77, 0x69, 0x0d, 0x28, 0x7c, 0xea, 0xad, 0x11, 0x3b, 0xb5, 0x44, 0x7d,
+	0xa7, 0x2c, 0x45, 0x14, 0xd4, 0x76, 0x94, 0xc7, 0x99, 0x6a, 0x1a, 0xba, 0x1a, 0xf1, 0x90, 0xa6,
+	0xf2, 0x88, 0x28, 0x18, 0x8f, 0xe4, 0xdb, 0x59, 0x48, 0xbd, 0x9d, 0xe9, 0xb7, 0x77, 0x3e, 0xfd,
+	0xf6, 0xca, 0x6f, 0x43, 0x85, 0x5b, 0xa4, 0x83, 0x3d, 0x11, 0x74, 0xb9, 0x74, 0xd0, 0xc9, 0x04,
+	0x16, 0x43, 0x24, 0x16, 0x70, 0xf7, 0x00, 0xb8, 0xf7, 0x76, 0x5d, 0x1c, 0x04, 0xdd, 0x4a, 0xd2,
+	0xc2, 0x1d, 0xec, 0x29, 0x15, 0x2d, 0x64, 0xfd, 0x7e, 0x94, 0x53, 0xf2, 0xcc, 0x1d, 0xd0, 0xe8,
+	0x85, 0x44, 0x79, 0xe6, 0xc7, 0x1c, 0x94, 0xe9, 0xdb, 0x1b, 0x94, 0x81, 0x54, 0xa1, 0xf4, 0x93,
+	0x4f, 0xe1, 0x0a, 0x83, 0xa0, 0x0f, 0x80, 0xa9, 0xdc, 0x0d, 0x8b, 0xde, 0xa5, 0xed, 0x5a, 0x1c,
+	0xed, 0xf8, 0xc2, 0xc6, 0xd4, 0x49, 0xf9, 0x8a, 0x86, 0x05, 0x3f, 0x88, 0x86, 0x93, 0x34, 0x3d,
+	0x2c, 0x42, 0xe4, 0x94, 0x53, 0x14, 0xd2, 0x4e, 0x81, 0xa0, 0x30, 0x50, 0xdd, 0x01, 0x33, 0xf3,
+	0x82, 0xc2, 0xd6, 0x32, 0x81, 0x95, 0x5d, 0x56, 0x77, 0xb2, 0x12, 0x05, 0xbf, 0xf2, 0xb1, 0xeb,
+	0xcd, 0x50, 0xc5, 0xa4, 0x92, 0x4f, 0x7e, 0x34, 0xf9, 0xac, 0x43, 0xd1, 0xb7, 0x75, 0x35, 0x7c,
+	0x6a, 0xc4, 0x97, 0xfc, 0x10, 0x50, 0xdb, 0xa2, 0xb9, 0xde, 0xbb, 0xd4, 0x89, 0xf2, 0x23, 0x58,
+	0x3e, 0x30, 0xdc, 0x04, 0x51, 0xd0, 0x47, 0xe4, 0xa2, 0x3e, 0x82, 0x06, 0x69, 0x54, 0x28, 0x96,
+	0x83, 0xaa, 0xf0, 0x29, 0xac, 0xf0, 0x77, 0xee, 0x72, 0x5a, 0xae, 0xc1, 0x3c, 0x7b, 0x11, 0x03,
+	0x66, 0xec, 0x43, 0xfe, 0x5d, 0x0e, 0x50, 0x87, 0xa6, 0x30, 0x91, 0x0a, 0x05, 0xbb, 0xdb, 0x50,
+	0xe4, 0x89, 0x74, 0x5c, 0x96, 0xe7, 0xd0, 0x19, 0x4c, 0x17, 0x3d, 0x42, 0xd2, 0xa4, 0x47, 0x48,
+	0xfe, 0x7d, 0x0e, 0x56, 0x1f, 0xb3, 0xd4, 0x36, 0x22, 0xc9, 0x4c, 0xef, 0xcd, 0x74, 0x49, 0xc2,
+	0x94, 0x27, 0xc5, 0x53, 0x5e, 0x68, 0x96, 0x42, 0xdc, 0x2c, 0x7d, 0x58, 0x13, 0x17, 0xfb, 0x66,
+	0xd2, 0xbc, 0x03, 0x85, 0x73, 0xd5, 0xf0, 0x44, 0x80, 0xac, 0xa6, 0xc2, 0xd5, 0xa3, 0x2e, 0xca,
+	0x10, 0xe4, 0xff, 0xe4, 0x60, 0x85, 0xba, 0x42, 0xf2, 0x98, 0xe9, 0xb7, 0x29, 0x43, 0xa1, 0xe7,
+	0x90, 0xe1, 0xb8, 0x9a, 0x94, 0xc2, 0xd0, 0x06, 0xe4, 0x3d, 0x52, 0x97, 0x32, 0x31, 0xf2, 0x1e,
+	0xab, 0xde, 0x2d, 0x7f, 0x78, 0x8a, 0x1d, 0x11, 0x5d, 0xe2, 0x8b, 0xd6, 0x24, 0x0e, 0x3e, 0xc3,
+	0x8e, 0xcb, 0xcb, 0xa2, 0xb2, 0x12, 0x7c, 0x06, 0x05, 0x4f, 0x31, 0x2a, 0x78, 0xee, 0x43, 0x95,
+	0x3f, 0xe1, 0x5d, 0x56, 0x9c, 0x94, 0xc6, 0x16, 0x27, 0x40, 0xc2, 0xb5, 0xdc, 0x85, 0xab, 0x09,
+	0xeb, 0x76, 0x70, 0xa8, 0xf9, 0xe5, 0xb3, 0x1d, 0x8a, 0x99, 0xba, 0x2c, 0xac, 0xba, 0x0e, 0x6b,
+	0x91, 0x51, 0x23, 0xee, 0xf2, 0x17, 0xb0, 0xde, 0x79, 0xe5, 0xab, 0xee, 0x20, 0x0d, 0xb9, 0xfc,
+	0xb9, 0xf2, 0x3e, 0xac, 0xb5, 0x1c, 0x62, 0xff, 0x0c, 0x9c, 0xfe, 0x95, 0x83, 0xf5, 0x8e, 0x7f,
+	0x4a, 0x3d, 0xf5, 0x14, 0x5f, 0xd6, 0x11, 0xa2, 0xda, 0x34, 0x9f, 0xa8, 0x4d, 0x03, 0x07, 0x91,
+	0x26, 0x38, 0xc8, 0x7b, 0x30, 0xef, 0x52, 0x5f, 0xac, 0x17, 0xc6, 0xbb, 0x29, 0xc7, 0x08, 0x6e,
+	0x7e, 0x7e, 0xec, 0xcd, 0x17, 0x67, 0xba, 0xf9, 0x4f, 0x01, 0xed, 0x9a, 0x58, 0x75, 0xde, 0x28,
+	0xaa, 0xe4, 0x3f, 0xe5, 0x61, 0x95, 0x27, 0x78, 0x91, 0x3c, 0x04, 0x7d, 0xd0, 0xa0, 0xe5, 0x26,
+	0x34, 0x68, 0xb7, 0x13, 0x76, 0x1a, 0x5f, 0x0c, 0x5f, 0xb6, 0x91, 0x8b, 0xf5, 0x56, 0x85, 0x29,
+	0xbd, 0xd5, 0xff, 0xc0, 0x92, 0x85, 0xcf, 0xbb, 0x31, 0xef, 0xe0, 0xe6, 0x5c, 0xb0, 0xf0, 0x79,
+	0x54, 0x18, 0x24, 0x3b, 0xb0, 0xe2, 0x25, 0x3a, 0xb0, 0xcf, 0xc3, 0xa4, 0x95, 0x34, 0xcf, 0x8c,
+	0x7d, 0x80, 0x7c, 0xc4, 0x53, 0x51, 0x92, 0x78, 0xba, 0x07, 0xc6, 0xd2, 0x45, 0x3e, 0x91, 0x2e,
+	0xe4, 0x0e, 0xac, 0xf2, 0x97, 0xea, 0x8d, 0xe4, 0x19, 0xf3, 0x62, 0xfd, 0x94, 0x83, 0x52, 0x53,
+	0xd7, 0xd9, 0x50, 0x2c, 0x18, 0x76, 0xe5, 0xb2, 0x86, 0x5d, 0xf9, 0xd8, 0xb0, 0x0b, 0x6d, 0x81,
+	0xe4, 0xa8, 0xe7, 0x22, 0x1a, 0xae, 0x8d, 0x54, 0x20, 0xac, 0xa6, 0x78, 0xa1, 0x9a, 0x3e, 0xde,
+	0x9f, 0x53, 0x28, 0x26, 0xfa, 0x00, 0x24, 0xdf, 0x31, 0xc5, 0x9d, 0xbe, 0x15, 0x48, 0x28, 0x0e,
+	0xde, 0x3c, 0x51, 0x0e, 0x3a, 0xc4, 0x77, 0x34, 0x86, 0xee, 0x3b, 0x66, 0xe3, 0x11, 0x54, 0xc2,
+	0x3d, 0x1a, 0x2c, 0x27, 0xca, 0x81, 0x90, 0x8a, 0x2e, 0xd1, 0x75, 0xa8, 0x38, 0x58, 0xf3, 0x1d,
+	0xd7, 0x38, 0x0b, 0xd4, 0x89, 0x36, 0x76, 0xca, 0x50, 0x74, 0x19, 0xa5, 0xfc, 0x10, 0x80, 0x5b,
+	0xec, 0x72, 0xea, 0xc9, 0xdf, 0x41, 0x79, 0x97, 0xd8, 0x17, 0x8c, 0xaa, 0x06, 0x92, 0xee, 0x7a,
+	0xc1, 0xe9, 0xba, 0xeb, 0x8d, 0x31, 0xc9, 0x06, 0x48, 0xae, 0xa3, 0xd5, 0xa5, 0xe4, 0xc5, 0x52,
+	0x16, 0x0a, 0x05, 0xd0, 0xcc, 0x42, 0x87, 0xa9, 0x96, 0x2e, 0x9e, 0x46, 0xf1, 0x25, 0xbf, 0xce,
+	0xc1, 0xca, 0x33, 0xa2, 0x1b, 0x3d, 0x76, 0x5c, 0x70, 0xa9, 0x5b, 0x00, 0x2e, 0x0e, 0x9b, 0xb3,
+	0xcc, 0x48, 0xdc, 0x9f, 0x53, 0x2a, 0x2e, 0x0e, 0x7a, 0xb3, 0xf7, 0xa1, 0xac, 0xea, 0x3a, 0x1b,
+	0x03, 0xd5, 0xf3, 0xc9, 0xc8, 0x11, 0x56, 0xde, 0x9f, 0x53, 0x4a, 0x2a, 0x5f, 0xd2, 0x39, 0x10,
+	0x6f, 0xf6, 0x39, 0x01, 0x17, 0x3a, 0xcc, 0x36, 0x91, 0xcd, 0xf6, 0xe7, 0x14, 0xd0, 0xc3, 0x2f,
+	0xb4, 0x45, 0xcb, 0x4f, 0xfb, 0x82, 0x13, 0xf1, 0xbb, 0xac, 0x45, 0x42, 0x71, 0x83, 0xed, 0xcf,
+	0x29, 0x65, 0x4d, 0xac, 0x77, 0x8a, 0x50, 0x38, 0x25, 0xfa, 0x85, 0xfc, 0x2d, 0x2c, 0x3d, 0xc1,
+	0x5e, 0x5c, 0xc1, 0xe9, 0xa5, 0xb1, 0xb8, 0xf6, 0x7c, 0x74, 0xed, 0xeb, 0x50, 0x24, 0xbd, 0x1e,
+	0x8d, 0x74, 0x3e, 0x04, 0x13, 0x5f, 0xb1, 0xba, 0xf1, 0x52, 0x27, 0xc8, 0x9f, 0xf0, 0xba, 0xf1,
+	0x52, 0x44, 0x5f, 0x14, 0xca, 0xf9, 0x9a, 0x24, 0xdf, 0x87, 0xe5, 0xaf, 0x54, 0xf3, 0xe5, 0xe5,
+	0xce, 0xeb, 0xc0, 0xf2, 0x13, 0x93, 0x9c, 0xc6, 0x89, 0x66, 0xad, 0x80, 0xea, 0x50, 0xb2, 0x55,
+	0xcf, 0xc3, 0x4e, 0x50, 0x8b, 0x05, 0x9f, 0xf2, 0xaf, 0x61, 0xb9, 0x65, 0xf4, 0x7a, 0x71, 0xa6,
+	0xef, 0x40, 0x99, 0x66, 0xc6, 0xb1, 0xd2, 0x94, 0x2c, 0x7c, 0x4e, 0x17, 0x14, 0x91, 0x98, 0x09,
+	0xa7, 0x49, 0x21, 0x12, 0x93, 0xfb, 0x4b, 0x1d, 0x4a, 0xee, 0x40, 0x35, 0x4d, 0x72, 0x2e, 0x4a,
+	0xf6, 0xe0, 0x53, 0x36, 0xa1, 0x16, 0x1d, 0xef, 0xda, 0xc4, 0x72, 0x31, 0xba, 0x3b, 0x72, 0x7e,
+	0xa2, 0xa7, 0xe1, 0x0d, 0x53, 0x20, 0xc3, 0xdd, 0x11, 0x19, 0x32, 0x90, 0x85, 0x1c, 0xf2, 0x4d,
+	0xa8, 0x3e, 0x76, 0xb5, 0x97, 0x81, 0xa2, 0x35, 0x90, 0xe8, 0xb4, 0x94, 0x4f, 0xc0, 0xe8, 0x92,
+	0x8e, 0x79, 0x38, 0x82, 0x10, 0x25, 0x86, 0x51, 0x61, 0x18, 0x51, 0xdd, 0x9a, 0x8f, 0xd5, 0xad,
+	0xb2, 0x09, 0xab, 0x9d, 0x73, 0x8c, 0xed, 0x23, 0xc7, 0x1e, 0xa8, 0x96, 0x1b, 0x1c, 0x70, 0x15,
+	0x4a, 0xba, 0x73, 0xd1, 0x75, 0x7c, 0x4b, 0x1c, 0x52, 0xd4, 0x9d, 0x0b, 0xc5, 0xb7, 0xd0, 0xa7,
+	0xb0, 0xd0, 0x77, 0x54, 0x0d, 0x77, 0x6d, 0xec, 0x18, 0x44, 0x9f, 0xde, 0x41, 0x57, 0x19, 0xfa,
+	0x73, 0x86, 0x2d, 0xff, 0x90, 0x83, 0xb5, 0xe4, 0x71, 0x42, 0x5c, 0xea, 0xe1, 0xa7, 0xdf, 0x61,
+	0x2d, 0xc8, 0x37, 0xe2, 0x0b, 0x35, 0x61, 0x89, 0x30, 0x54, 0xac, 0x77, 0x5d, 0xc3, 0xd2, 0xf0,
+	0x0c, 0x3f, 0x1a, 0x2c, 0x06, 0x14, 0x1d, 0x4a, 0x40, 0xaf, 0x90, 0x47, 0xb2, 0x1e, 0x5c, 0xa1,
+	0xf8, 0x64, 0xbd, 0x92, 0x21, 0x1e, 0x5c, 0xda, 0x2b, 0x19, 0xd8, 0x91, 0x3f, 0x82, 0x2b, 0xbc,
+	0x34, 0xa0, 0x66, 0x67, 0xe5, 0x98, 0x90, 0x70, 0x03, 0xaa, 0xac, 0x61, 0xa5, 0xd9, 0x29, 0xe8,
+	0xb8, 0x79, 0xdb, 0x4e, 0x3b, 0x6c, 0x5d, 0x7e, 0x04, 0x2b, 0x22, 0xd2, 0x63, 0x45, 0xdc, 0xac,
+	0x15, 0xc9, 0x37, 0xb0, 0x22, 0x92, 0xd5, 0xe5, 0x89, 0xd3, 0x92, 0xe5, 0xd3, 0x92, 0xbd, 0x80,
+	0x55, 0x05, 0x0b, 0xaf, 0x8b, 0xb1, 0x9f, 0xa2, 0x10, 0x9d, 0x43, 0x78, 0x9e, 0xd9, 0x75, 0xb1,
+	0x46, 0x2c, 0x3d, 0xfc, 0x0d, 0xc0, 0xf3, 0xcc, 0x0e, 0xdf, 0x91, 0xbf, 0x86, 0x2b, 0xbb, 0x64,
+	0x68, 0x13, 0x17, 0xa7, 0x38, 0xdf, 0x82, 0x85, 0x18, 0x67, 0x3e, 0x6d, 0xaf, 0x28, 0x10, 0xb2,
+	0x76, 0xa7, 0xf3, 0xbe, 0x02, 0xab, 0x4d, 0xcd, 0x33, 0xce, 0x54, 0x0f, 0xd3, 0x19, 0x7e, 0x50,
+	0x78, 0xaf, 0xc3, 0x5a, 0x72, 0x9b, 0x5f, 0x8e, 0xac, 0x03, 0x52, 0x7c, 0xeb, 0x80, 0xa8, 0xfa,
+	0x31, 0x76, 0xbd, 0x58, 0x2f, 0xcc, 0x06, 0xa8, 0xe2, 0xdd, 0xa3, 0xeb, 0x99, 0xeb, 0x37, 0x4a,
+	0x8b, 0x71, 0xf0, 0xf3, 0x12, 0x5b, 0xcb, 0x9f, 0xc3, 0x2a, 0xd3, 0x52, 0xfc, 0xb0, 0x11, 0x65,
+	0x9d, 0xf9, 0x57, 0xf4, 0x3b, 0x5d, 0xa4, 0x47, 0x88, 0x1c, 0x1e, 0xb4, 0x13, 0x69, 0x06, 0xf2,
+	0x5f, 0x72, 0xb0, 0x9a, 0x10, 0x5f, 0xb8, 0xdc, 0xcf, 0x2c, 0x7f, 0x94, 0x01, 0x0a, 0xf1, 0xce,
+	0xf5, 0x43, 0x28, 0x07, 0x3f, 0x67, 0xd6, 0xe7, 0xa7, 0x45, 0x73, 0x88, 0x7a, 0xe7, 0x10, 0x20,
+	0x2a, 0xce, 0xd1, 0x55, 0x58, 0x3d, 0x52, 0xda, 0x4f, 0xda, 0x87, 0xdd, 0xa7, 0xed, 0xc3, 0x56,
+	0xf7, 0xe4, 0xf0, 0xe9, 0xe1, 0xd1, 0x57, 0x87, 0xb5, 0x39, 0x54, 0x86, 0xc2, 0x49, 0x67, 0x4f,
+	0xa9, 0xe5, 0xe8, 0xaa, 0x79, 0x72, 0x7c, 0x54, 0xcb, 0xd3, 0xd5, 0xe3, 0xce, 0xee, 0xd3, 0x9a,
+	0x84, 0x2a, 0x30, 0xdf, 0x3c, 0x68, 0x37, 0x3b, 0xb5, 0xc2, 0x9d, 0xbb, 0x7c, 0x7c, 0xc4, 0xa6,
+	0x3d, 0x0b, 0x50, 0x56, 0xf6, 0x3a, 0x7b, 0xca, 0x8b, 0xbd, 0x16, 0x67, 0xf1, 0xb8, 0x7d, 0xb0,
+	0x57, 0xcb, 0xa1, 0x12, 0x48, 0xad, 0xb6, 0x52, 0xcb, 0xdf, 0xf9, 0x16, 0xaa, 0xb1, 0xe6, 0x02,
+	0xd5, 0x61, 0x6d, 0xf7, 0xe8, 0xd9, 0xb3, 0xf6, 0x71, 0xb7, 0x73, 0xdc, 0x3c, 0xde, 0x8b, 0x1d,
+	0x5f, 0x85, 0x52, 0xe7, 0xb8, 0xa9, 0x1c, 0xef, 0xb5, 0x6a, 0x39, 0x7a, 0x9a, 0xb2, 0xd7, 0x6c,
+	0xfd, 0xb2, 0x96, 0x47, 0x8b, 0x50, 0x79, 0xdc, 0x3e, 0x6c, 0x77, 0xf6, 0xdb, 0x87, 0x4f, 0x6a,
+	0x12, 0x3d, 0x90, 0x7f, 0xee, 0xb5, 0x6a, 0x85, 0x3b, 0x8f, 0xa0, 0xd2, 0xc2, 0xa6, 0x31, 0x34,
+	0x3c, 0xec, 0xd0, 0xd3, 0x0f, 0x8f, 0x0e, 0xf7, 0xb8, 0x1c, 0x5f, 0x74, 0x8e, 0x0e, 0xb9, 0x2a,
+	0x07, 0xed, 0xc3, 0xbd, 0x5a, 0x9e, 0x4a, 0xd4, 0xf9, 0xf2, 0xa0, 0x26, 0xd1, 0xc5, 0x6e, 0xe7,
+	0x45, 0xad, 0xb0, 0xfd, 0x87, 0x2b, 0x20, 0x35, 0x9f, 0xb7, 0x51, 0x13, 0x20, 0x1a, 0x22, 0xa1,
+	0xb0, 0xf2, 0x1b, 0x19, 0x2c, 0x35, 0xd6, 0x47, 0xac, 0xbd, 0x47, 0x7f, 0xbb, 0x96, 0xe7, 0xd0,
+	0x67, 0x50, 0x8d, 0x8d, 0x85, 0x50, 0x38, 0x0f, 0x1d, 0x9d, 0x15, 0x35, 0x6a, 0xe9, 0x1f, 0x16,
+	0xe5, 0x39, 0xf4, 0x09, 0x94, 0x03, 0x77, 0x43, 0x57, 0x03, 0x78, 0x6a, 0x5e, 0x94, 0x45, 0x78,
+	0x2f, 0x47, 0x85, 0x8f, 0x66, 0x43, 0x91, 0xf0, 0x23, 0xf3, 0xa2, 0x09, 0xc2, 0xef, 0xc1, 0x42,
+	0x3c, 0x58, 0xd0, 0xb5, 0x80, 0x49, 0x46, 0x08, 0x4d, 0x60, 0xd3, 0x82, 0xc5, 0x44, 0xcc, 0xa0,
+	0xeb, 0x69, 0x4d, 0x12, 0x8c, 0x46, 0x83, 0x8f, 0xe9, 0xf3, 0x08, 0xaa, 0xb1, 0xe9, 0x54, 0x64,
+	0xc9, 0xd1, 0x91, 0x55, 0x23, 0x95, 0x65, 0xb9, 0x26, 0xf1, 0x89, 0x52, 0xa4, 0x49, 0xc6, 0x9c,
+	0x69, 0x82, 0x26, 0xbb, 0x50, 0x8d, 0xf5, 0xac, 0x91, 0x0c, 0xa3, 0x8d, 0xec, 0x44, 0x26, 0x8b,
+	0x89, 0x91, 0x47, 0x64, 0x8e, 0xac, 0x39, 0x53, 0x23, 0x63, 0x62, 0x2b, 0xcf, 0xa1, 0xff, 0x07,
+	0x88, 0xc6, 0x1a, 0xd1, 0xed, 0x8e, 0xcc, 0x8f, 0xb2, 0xc9, 0xef, 0xe5, 0x50, 0x1b, 0x96, 0x53,
+	0x83, 0x06, 0xb4, 0x11, 0x9a, 0x34, 0x73, 0x02, 0x31, 0x96, 0xd5, 0x53, 0xa8, 0xa5, 0x67, 0x38,
+	0xe8, 0x66, 0xa6, 0x4e, 0x1d, 0x3c, 0x95, 0xd9, 0x3e, 0x77, 0x96, 0x88, 0xd3, 0xf5, 0x51, 0xdd,
+	0x62, 0x6c, 0xae, 0x8c, 0x8c, 0x53, 0x62, 0x62, 0x2d, 0xa7, 0x26, 0x3c, 0x31, 0x0d, 0x33, 0x47,
+	0x3f, 0x13, 0x2e, 0xed, 0x09, 0x2c, 0x26, 0x46, 0x3c, 0x91, 0x58, 0x59, 0x93, 0x9f, 0xc9, 0x31,
+	0x15, 0x9f, 0x5b, 0x44, 0x9e, 0x98, 0x31, 0xcd, 0x98, 0xc9, 0x89, 0x04, 0x9f, 0xb4, 0x13, 0x25,
+	0x19, 0xa1, 0xe4, 0x13, 0x93, 0x74, 0x22, 0xc1, 0x21, 0xe1, 0x44, 0x33, 0x90, 0xdf, 0xcb, 0x51,
+	0x65, 0xe2, 0x5d, 0x7d, 0xa4, 0x4c, 0x46, 0xaf, 0x3f, 0x51, 0x19, 0x88, 0xba, 0xc8, 0x48, 0x8e,
+	0x91, 0xce, 0x72, 0x3c, 0x8b, 0x77, 0x73, 0x68, 0x07, 0x4a, 0xa2, 0x78, 0x43, 0xeb, 0x01, 0x87,
+	0x64, 0xdf, 0xd6, 0x98, 0xd4, 0xec, 0x0b, 0x7d, 0x40, 0x90, 0x1c, 0x37, 0x95, 0x37, 0x67, 0x13,
+	0x25, 0x7d, 0x26, 0x4e, 0x3a, 0xe9, 0xc7, 0x79, 0x8d, 0xf4, 0x0b, 0x51, 0xd2, 0x67, 0xb4, 0x89,
+	0xa4, 0x3f, 0x85, 0xf0, 0x5e, 0x8e, 0x92, 0x06, 0xad, 0x5d, 0x44, 0x9a, 0x6a, 0xf6, 0xc6, 0x93,
+	0x06, 0x0d, 0x5e, 0x44, 0x9a, 0x6a, 0xf9, 0xc6, 0x90, 0x36, 0xa1, 0x1c, 0xf4, 0x51, 0x11, 0x69,
+	0xaa, 0xb1, 0x6b, 0xd4, 0x47, 0x01, 0xa2, 0xf2, 0xe3, 0xc1, 0xba, 0x10, 0xaf, 0x0a, 0x23, 0x4f,
+	0xca, 0x28, 0x21, 0x1b, 0xd7, 0xb3, 0x81, 0x01, 0x3b, 0xf4, 0x19, 0x7b, 0xfc, 0xb1, 0x87, 0x9b,
+	0xa6, 0x89, 0xc6, 0xf8, 0xcc, 0x04, 0x77, 0xfc, 0x10, 0x0a, 0xb4, 0x0f, 0x43, 0xe1, 0x10, 0x34,
+	0xd6, 0xb6, 0x35, 0xd6, 0x92, 0x9b, 0x31, 0x15, 0x9e, 0xc1, 0x42, 0xbc, 0x2f, 0x8a, 0xbd, 0x96,
+	0xa3, 0xcd, 0x59, 0xe3, 0x7a, 0x36, 0x30, 0xc1, 0x6e, 0x31, 0xd1, 0xc5, 0x4c, 0x8a, 0x8b, 0x1b,
+	0xc9, 0x24, 0x92, 0xea, 0x7b, 0x58, 0x78, 0xec, 0x87, 0xae, 0x9d, 0xe0, 0x35, 0xd2, 0xef, 0x4c,
+	0xe5, 0x45, 0x0b, 0x8b, 0xa8, 0xd1, 0x41, 0xe9, 0x79, 0xd8, 0xac, 0x49, 0x30, 0xde, 0xce, 0x44,
+	0xa6, 0xca, 0x68, 0x72, 0x26, 0xb0, 0x79, 0x0e, 0x4b, 0xc9, 0xee, 0x05, 0xdd, 0x88, 0x3d, 0x07,
+	0xa3, 0x5d, 0xcd, 0x74, 0xdd, 0xf6, 0xa1, 0x1a, 0xab, 0xe2, 0xa3, 0xc8, 0x1d, 0xed, 0x4c, 0x1a,
+	0xd7, 0x32, 0x61, 0x21, 0xa7, 0xa7, 0x89, 0x76, 0xa6, 0x85, 0x7b, 0xaa, 0x6f, 0x7a, 0x63, 0x9d,
+	0x71, 0x32, 0xb3, 0x9d, 0x8f, 0x7e, 0x7c, 0xbd, 0x91, 0xfb, 0xfb, 0xeb, 0x8d, 0xdc, 0x3f, 0x5f,
+	0x6f, 0xe4, 0xbe, 0x7e, 0xaf, 0x6f, 0x78, 0x03, 0xff, 0x74, 0x53, 0x23, 0xc3, 0x2d, 0x5b, 0xd5,
+	0x06, 0x17, 0x3a, 0x76, 0xe2, 0xab, 0xb3, 0xed, 0x2d, 0xd7, 0xd1, 0xe8, 0x7f, 0x58, 0x9e, 0x16,
+	0xd9, 0x39, 0xf7, 0xff, 0x3b, 0x00, 0x38, 0x5b, 0x42, 0xe9, 0x73, 0x29, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3897,6 +4435,10 @@ type APIClient interface {
 	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (API_ListRepoClient, error)
 	// DeleteRepo deletes a repo.
 	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// SetRepoQuota sets the storage quota for a repo or repo name prefix.
+	SetRepoQuota(ctx context.Context, in *SetRepoQuotaRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ListRepoQuota returns all storage quotas.
+	ListRepoQuota(ctx context.Context, in *ListRepoQuotaRequest, opts ...grpc.CallOption) (API_ListRepoQuotaClient, error)
 	// StartCommit creates a new write commit from a parent commit.
 	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
 	// FinishCommit turns a write commit into a read commit.
@@ -3947,6 +4489,8 @@ type APIClient interface {
 	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
 	// Fsck does a file system consistency check for pfs.
 	Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error)
+	// SweepOrphans deletes objects in chunk storage that are no longer tracked.
+	SweepOrphans(ctx context.Context, in *SweepOrphansRequest, opts ...grpc.CallOption) (API_SweepOrphansClient, error)
 	// FileSet API
 	// CreateFileSet creates a new file set.
 	CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error)
@@ -4031,6 +4575,47 @@ func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts
 	return out, nil
 }
 
+func (c *aPIClient) SetRepoQuota(ctx context.Context, in *SetRepoQuotaRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/SetRepoQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListRepoQuota(ctx context.Context, in *ListRepoQuotaRequest, opts ...grpc.CallOption) (API_ListRepoQuotaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs_v2.API/ListRepoQuota", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListRepoQuotaClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListRepoQuotaClient interface {
+	Recv() (*RepoQuota, error)
+	grpc.ClientStream
+}
+
+type aPIListRepoQuotaClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListRepoQuotaClient) Recv() (*RepoQuota, error) {
+	m := new(RepoQuota)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
 	out := new(Commit)
 	err := c.cc.Invoke(ctx, "/pfs_v2.API/StartCommit", in, out, opts...)
@@ -4068,7 +4653,7 @@ func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest,
 }
 
 func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs_v2.API/ListCommit", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs_v2.API/ListCommit", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4100,7 +4685,7 @@ func (x *aPIListCommitClient) Recv() (*CommitInfo, error) {
 }
 
 func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs_v2.API/SubscribeCommit", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs_v2.API/SubscribeCommit", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4132,7 +4717,7 @@ func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
 }
 
 func (c *aPIClient) InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (API_InspectCommitSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs_v2.API/InspectCommitSet", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs_v2.API/InspectCommitSet", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4164,7 +4749,7 @@ func (x *aPIInspectCommitSetClient) Recv() (*CommitInfo, error) {
 }
 
 func (c *aPIClient) ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs_v2.API/ListCommitSet", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs_v2.API/ListCommitSet", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4232,7 +4817,7 @@ func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest,
 }
 
 func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs_v2.API/ListBranch", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs_v2.API/ListBranch", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4273,7 +4858,7 @@ func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, o
 }
 
 func (c *aPIClient) ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs_v2.API/ModifyFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs_v2.API/ModifyFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4307,7 +4892,7 @@ func (x *aPIModifyFileClient) CloseAndRecv() (*types.Empty, error) {
 }
 
 func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs_v2.API/GetFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/pfs_v2.API/GetFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4339,7 +4924,7 @@ func (x *aPIGetFileClient) Recv() (*types.BytesValue, error) {
 }
 
 func (c *aPIClient) GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/pfs_v2.API/GetFileTAR", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[9], "/pfs_v2.API/GetFileTAR", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4380,7 +4965,7 @@ func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opt
 }
 
 func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[9], "/pfs_v2.API/ListFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[10], "/pfs_v2.API/ListFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4412,7 +4997,7 @@ func (x *aPIListFileClient) Recv() (*FileInfo, error) {
 }
 
 func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[10], "/pfs_v2.API/WalkFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[11], "/pfs_v2.API/WalkFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4444,7 +5029,7 @@ func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
 }
 
 func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[11], "/pfs_v2.API/GlobFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[12], "/pfs_v2.API/GlobFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4476,7 +5061,7 @@ func (x *aPIGlobFileClient) Recv() (*FileInfo, error) {
 }
 
 func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[12], "/pfs_v2.API/DiffFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[13], "/pfs_v2.API/DiffFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4526,7 +5111,7 @@ func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc
 }
 
 func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[13], "/pfs_v2.API/Fsck", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[14], "/pfs_v2.API/Fsck", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4557,8 +5142,40 @@ func (x *aPIFsckClient) Recv() (*FsckResponse, error) {
 	return m, nil
 }
 
+func (c *aPIClient) SweepOrphans(ctx context.Context, in *SweepOrphansRequest, opts ...grpc.CallOption) (API_SweepOrphansClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[15], "/pfs_v2.API/SweepOrphans", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPISweepOrphansClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_SweepOrphansClient interface {
+	Recv() (*SweepOrphansResponse, error)
+	grpc.ClientStream
+}
+
+type aPISweepOrphansClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPISweepOrphansClient) Recv() (*SweepOrphansResponse, error) {
+	m := new(SweepOrphansResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIClient) CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[14], "/pfs_v2.API/CreateFileSet", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[16], "/pfs_v2.API/CreateFileSet", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4655,6 +5272,10 @@ type APIServer interface {
 	ListRepo(*ListRepoRequest, API_ListRepoServer) error
 	// DeleteRepo deletes a repo.
 	DeleteRepo(context.Context, *DeleteRepoRequest) (*types.Empty, error)
+	// SetRepoQuota sets the storage quota for a repo or repo name prefix.
+	SetRepoQuota(context.Context, *SetRepoQuotaRequest) (*types.Empty, error)
+	// ListRepoQuota returns all storage quotas.
+	ListRepoQuota(*ListRepoQuotaRequest, API_ListRepoQuotaServer) error
 	// StartCommit creates a new write commit from a parent commit.
 	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
 	// FinishCommit turns a write commit into a read commit.
@@ -4705,6 +5326,8 @@ type APIServer interface {
 	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
 	// Fsck does a file system consistency check for pfs.
 	Fsck(*FsckRequest, API_FsckServer) error
+	// SweepOrphans deletes objects in chunk storage that are no longer tracked.
+	SweepOrphans(*SweepOrphansRequest, API_SweepOrphansServer) error
 	// FileSet API
 	// CreateFileSet creates a new file set.
 	CreateFileSet(API_CreateFileSetServer) error
@@ -4738,6 +5361,12 @@ func (*UnimplementedAPIServer) ListRepo(req *ListRepoRequest, srv API_ListRepoSe
 func (*UnimplementedAPIServer) DeleteRepo(ctx context.Context, req *DeleteRepoRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
 }
+func (*UnimplementedAPIServer) SetRepoQuota(ctx context.Context, req *SetRepoQuotaRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRepoQuota not implemented")
+}
+func (*UnimplementedAPIServer) ListRepoQuota(req *ListRepoQuotaRequest, srv API_ListRepoQuotaServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRepoQuota not implemented")
+}
 func (*UnimplementedAPIServer) StartCommit(ctx context.Context, req *StartCommitRequest) (*Commit, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartCommit not implemented")
 }
@@ -4813,6 +5442,9 @@ func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty)
 func (*UnimplementedAPIServer) Fsck(req *FsckRequest, srv API_FsckServer) error {
 	return status.Errorf(codes.Unimplemented, "method Fsck not implemented")
 }
+func (*UnimplementedAPIServer) SweepOrphans(req *SweepOrphansRequest, srv API_SweepOrphansServer) error {
+	return status.Errorf(codes.Unimplemented, "method SweepOrphans not implemented")
+}
 func (*UnimplementedAPIServer) CreateFileSet(srv API_CreateFileSetServer) error {
 	return status.Errorf(codes.Unimplemented, "method CreateFileSet not implemented")
 }
@@ -4914,6 +5546,45 @@ func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_SetRepoQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetRepoQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/SetRepoQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetRepoQuota(ctx, req.(*SetRepoQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListRepoQuota_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRepoQuotaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListRepoQuota(m, &aPIListRepoQuotaServer{stream})
+}
+
+type API_ListRepoQuotaServer interface {
+	Send(*RepoQuota) error
+	grpc.ServerStream
+}
+
+type aPIListRepoQuotaServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListRepoQuotaServer) Send(m *RepoQuota) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartCommitRequest)
 	if err := dec(in); err != nil {
@@ -5408,6 +6079,27 @@ func (x *aPIFsckServer) Send(m *FsckResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _API_SweepOrphans_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SweepOrphansRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).SweepOrphans(m, &aPISweepOrphansServer{stream})
+}
+
+type API_SweepOrphansServer interface {
+	Send(*SweepOrphansResponse) error
+	grpc.ServerStream
+}
+
+type aPISweepOrphansServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPISweepOrphansServer) Send(m *SweepOrphansResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _API_CreateFileSet_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(APIServer).CreateFileSet(&aPICreateFileSetServer{stream})
 }
@@ -5558,6 +6250,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRepo",
 			Handler:    _API_DeleteRepo_Handler,
 		},
+		{
+			MethodName: "SetRepoQuota",
+			Handler:    _API_SetRepoQuota_Handler,
+		},
 		{
 			MethodName: "StartCommit",
 			Handler:    _API_StartCommit_Handler,
@@ -5637,6 +6333,11 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _API_ListRepo_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListRepoQuota",
+			Handler:       _API_ListRepoQuota_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListCommit",
 			Handler:       _API_ListCommit_Handler,
@@ -5702,6 +6403,11 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _API_Fsck_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SweepOrphans",
+			Handler:       _API_SweepOrphans_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "CreateFileSet",
 			Handler:       _API_CreateFileSet_Handler,
@@ -5976,6 +6682,18 @@ func (m *RepoInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.SizeBytes != 0 {
 		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 		i--
@@ -5984,6 +6702,104 @@ func (m *RepoInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RepoUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FileCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChunkBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ChunkBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LogicalBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.LogicalBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxFiles != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxFiles))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6018,20 +6834,20 @@ func (m *RepoAuthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Permissions) > 0 {
-		dAtA8 := make([]byte, len(m.Permissions)*10)
-		var j7 int
+		dAtA10 := make([]byte, len(m.Permissions)*10)
+		var j9 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j9++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintPfs(dAtA, i, uint64(j7))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintPfs(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -6062,6 +6878,18 @@ func (m *BranchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Protection != nil {
+		{
+			size, err := m.Protection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Trigger != nil {
 		{
 			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
@@ -6143,6 +6971,80 @@ func (m *BranchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BranchProtection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BranchProtection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BranchProtection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BypassRole) > 0 {
+		i -= len(m.BypassRole)
+		copy(dAtA[i:], m.BypassRole)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.BypassRole)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NoForceMove {
+		i--
+		if m.NoForceMove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NoDelete {
+		i--
+		if m.NoDelete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequirePromotion {
+		i--
+		if m.RequirePromotion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NoDirectCommits {
+		i--
+		if m.NoDirectCommits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Trigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6466,6 +7368,16 @@ func (m *CommitInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ChunkBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ChunkBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FileCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileCount))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ValidatingTime != nil {
 		{
 			size, err := m.ValidatingTime.MarshalToSizedBuffer(dAtA[:i])
@@ -6772,6 +7684,16 @@ func (m *ListRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Usage {
+		i--
+		if m.Usage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Type) > 0 {
 		i -= len(m.Type)
 		copy(dAtA[i:], m.Type)
@@ -7384,6 +8306,18 @@ func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Protection != nil {
+		{
+			size, err := m.Protection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.NewCommitSet {
 		i--
 		if m.NewCommitSet {
@@ -8348,7 +9282,7 @@ func (m *FsckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CreateFileSetResponse) Marshal() (dAtA []byte, err error) {
+func (m *SweepOrphansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8358,12 +9292,12 @@ func (m *CreateFileSetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateFileSetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SweepOrphansRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateFileSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SweepOrphansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8372,17 +9306,32 @@ func (m *CreateFileSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FileSetId) > 0 {
-		i -= len(m.FileSetId)
-		copy(dAtA[i:], m.FileSetId)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+	if m.GracePeriod != nil {
+		{
+			size, err := m.GracePeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetFileSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *SweepOrphansResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8392,12 +9341,12 @@ func (m *GetFileSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SweepOrphansResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SweepOrphansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8406,9 +9355,26 @@ func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Commit != nil {
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrphanedSince != nil {
 		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OrphanedSince.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8416,12 +9382,19 @@ func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Object) > 0 {
+		i -= len(m.Object)
+		copy(dAtA[i:], m.Object)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Object)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddFileSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateFileSetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8431,12 +9404,12 @@ func (m *AddFileSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateFileSetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateFileSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8450,24 +9423,12 @@ func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.FileSetId)
 		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RenewFileSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetFileSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8477,12 +9438,12 @@ func (m *RenewFileSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RenewFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8491,8 +9452,93 @@ func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TtlSeconds != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.TtlSeconds))
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddFileSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FileSetId) > 0 {
+		i -= len(m.FileSetId)
+		copy(dAtA[i:], m.FileSetId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenewFileSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenewFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TtlSeconds != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TtlSeconds))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -8652,6 +9698,72 @@ func (m *RunLoadTestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetRepoQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRepoQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRepoQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRepoQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRepoQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRepoQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RunLoadTestResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8847,6 +9959,57 @@ func (m *RepoInfo_Details) Size() (n int) {
 	if m.SizeBytes != 0 {
 		n += 1 + sovPfs(uint64(m.SizeBytes))
 	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogicalBytes != 0 {
+		n += 1 + sovPfs(uint64(m.LogicalBytes))
+	}
+	if m.ChunkBytes != 0 {
+		n += 1 + sovPfs(uint64(m.ChunkBytes))
+	}
+	if m.FileCount != 0 {
+		n += 1 + sovPfs(uint64(m.FileCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MaxBytes))
+	}
+	if m.MaxFiles != 0 {
+		n += 1 + sovPfs(uint64(m.MaxFiles))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8914,6 +10077,38 @@ func (m *BranchInfo) Size() (n int) {
 		l = m.Trigger.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.Protection != nil {
+		l = m.Protection.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BranchProtection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoDirectCommits {
+		n += 2
+	}
+	if m.RequirePromotion {
+		n += 2
+	}
+	if m.NoDelete {
+		n += 2
+	}
+	if m.NoForceMove {
+		n += 2
+	}
+	l = len(m.BypassRole)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9065,6 +10260,12 @@ func (m *CommitInfo_Details) Size() (n int) {
 		l = m.ValidatingTime.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.FileCount != 0 {
+		n += 1 + sovPfs(uint64(m.FileCount))
+	}
+	if m.ChunkBytes != 0 {
+		n += 1 + sovPfs(uint64(m.ChunkBytes))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9188,6 +10389,9 @@ func (m *ListRepoRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.Usage {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9458,6 +10662,10 @@ func (m *CreateBranchRequest) Size() (n int) {
 	if m.NewCommitSet {
 		n += 2
 	}
+	if m.Protection != nil {
+		l = m.Protection.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9863,6 +11071,52 @@ func (m *FsckResponse) Size() (n int) {
 	return n
 }
 
+func (m *SweepOrphansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.GracePeriod != nil {
+		l = m.GracePeriod.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SweepOrphansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OrphanedSince != nil {
+		l = m.OrphanedSince.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CreateFileSetResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10002,21 +11256,49 @@ func (m *RunLoadTestRequest) Size() (n int) {
 	return n
 }
 
-func (m *RunLoadTestResponse) Size() (n int) {
+func (m *SetRepoQuotaRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Spec)
-	if l > 0 {
+	if m.Quota != nil {
+		l = m.Quota.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.Seed != 0 {
+	return n
+}
+
+func (m *ListRepoQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RunLoadTestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Seed != 0 {
 		n += 1 + sovPfs(uint64(m.Seed))
 	}
 	l = len(m.Error)
@@ -10752,6 +12034,307 @@ func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &RepoUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicalBytes", wireType)
+			}
+			m.LogicalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogicalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkBytes", wireType)
+			}
+			m.ChunkBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
+			}
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFiles", wireType)
+			}
+			m.MaxFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFiles |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11165,6 +12748,205 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Protection == nil {
+				m.Protection = &BranchProtection{}
+			}
+			if err := m.Protection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchProtection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchProtection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchProtection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoDirectCommits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoDirectCommits = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequirePromotion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequirePromotion = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoDelete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoDelete = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoForceMove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoForceMove = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassRole", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassRole = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12135,7 +13917,45 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 			if err := m.ValidatingTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
+			}
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkBytes", wireType)
+			}
+			m.ChunkBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12844,6 +14664,26 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Usage = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14465,6 +16305,42 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NewCommitSet = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Protection == nil {
+				m.Protection = &BranchProtection{}
+			}
+			if err := m.Protection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16247,7 +18123,201 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewFile == nil {
+				m.NewFile = &FileInfo{}
+			}
+			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldFile == nil {
+				m.OldFile = &FileInfo{}
+			}
+			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FsckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FsckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FsckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fix", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fix = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FsckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FsckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FsckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fix", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16257,33 +18327,29 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewFile == nil {
-				m.NewFile = &FileInfo{}
-			}
-			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Fix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16293,27 +18359,23 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldFile == nil {
-				m.OldFile = &FileInfo{}
-			}
-			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16337,7 +18399,7 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FsckRequest) Unmarshal(dAtA []byte) error {
+func (m *SweepOrphansRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16360,15 +18422,15 @@ func (m *FsckRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FsckRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SweepOrphansRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FsckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SweepOrphansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16385,7 +18447,43 @@ func (m *FsckRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Fix = bool(v != 0)
+			m.DryRun = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GracePeriod == nil {
+				m.GracePeriod = &types.Duration{}
+			}
+			if err := m.GracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16408,7 +18506,7 @@ func (m *FsckRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FsckResponse) Unmarshal(dAtA []byte) error {
+func (m *SweepOrphansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16431,15 +18529,15 @@ func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FsckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SweepOrphansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FsckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SweepOrphansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16467,11 +18565,67 @@ func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fix = string(dAtA[iNdEx:postIndex])
+			m.Object = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrphanedSince == nil {
+				m.OrphanedSince = &types.Timestamp{}
+			}
+			if err := m.OrphanedSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16499,7 +18653,7 @@ func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Tier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17256,6 +19410,144 @@ func (m *RunLoadTestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetRepoQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRepoQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRepoQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &RepoQuota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRepoQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRepoQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRepoQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RunLoadTestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
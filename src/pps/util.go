@@ -32,6 +32,9 @@ func init() {
 }
 
 func (j *Job) String() string {
+	if j.Branch != "" {
+		return fmt.Sprintf("%s@%s=%s", j.Pipeline.Name, j.Branch, j.ID)
+	}
 	return fmt.Sprintf("%s@%s", j.Pipeline.Name, j.ID)
 }
 
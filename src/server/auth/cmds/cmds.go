@@ -46,6 +46,46 @@ func requestOIDCLogin(c *client.APIClient, openBrowser bool) (string, error) {
 	return state, nil
 }
 
+// deviceLogin logs in with the OAuth 2.0 device authorization grant, which
+// doesn't need a browser on the same host as pachctl. The user approves the
+// login on any device, while this polls pachd until they do.
+func deviceLogin(c *client.APIClient) (*auth.AuthenticateResponse, error) {
+	loginInfo, err := c.GetOIDCDeviceLogin(c.Ctx(), &auth.GetOIDCDeviceLoginRequest{})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	fmt.Printf("To log in, visit the following URL on any device:\n\n%s\n\nand enter the code: %s\n\n",
+		loginInfo.VerificationURL, loginInfo.UserCode)
+	if loginInfo.VerificationURLComplete != "" {
+		fmt.Printf("Or visit the following URL, which includes the code:\n\n%s\n\n",
+			loginInfo.VerificationURLComplete)
+	}
+	fmt.Println("Waiting for the login to be approved...")
+
+	interval := time.Duration(loginInfo.Interval) * time.Second
+	var deadline time.Time
+	if loginInfo.ExpiresIn > 0 {
+		deadline = time.Now().Add(time.Duration(loginInfo.ExpiresIn) * time.Second)
+	}
+	for {
+		time.Sleep(interval)
+		resp, err := c.Authenticate(c.Ctx(), &auth.AuthenticateRequest{DeviceCode: loginInfo.DeviceCode})
+		switch {
+		case err == nil:
+			return resp, nil
+		case auth.IsErrAuthorizationPending(err):
+		case auth.IsErrSlowDown(err):
+			interval += 5 * time.Second
+		default:
+			return nil, errors.Wrapf(grpcutil.ScrubGRPC(err),
+				"authorization failed (Pachyderm logs may contain more information)")
+		}
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return nil, errors.New("the device login expired before it was approved, try logging in again")
+		}
+	}
+}
+
 func printRoleBinding(b *auth.RoleBinding) {
 	for principal, roles := range b.Entries {
 		roleList := make([]string, 0)
@@ -159,7 +199,7 @@ Activate Pachyderm's auth system, and restrict access to existing data to the ro
 						Id:           clientId,
 						Name:         clientId,
 						TrustedPeers: trustedPeers,
-						RedirectUris: []string{redirect},
+						RedirectUris: []string{redirect, identity.DeviceCallbackRedirectURI},
 					},
 				})
 				if err != nil {
@@ -200,7 +240,7 @@ Activate Pachyderm's auth system, and restrict access to existing data to the ro
 						Id:           clientId,
 						Name:         clientId,
 						TrustedPeers: trustedPeers,
-						RedirectUris: []string{redirect},
+						RedirectUris: []string{redirect, identity.DeviceCallbackRedirectURI},
 					},
 				})
 				if err != nil {
@@ -284,12 +324,14 @@ func DeactivateCmd() *cobra.Command {
 // GitHub account. Any resources that have been restricted to the email address
 // registered with your GitHub account will subsequently be accessible.
 func LoginCmd() *cobra.Command {
-	var noBrowser, enterprise, idToken bool
+	var noBrowser, enterprise, idToken, device bool
 	login := &cobra.Command{
 		Short: "Log in to Pachyderm",
 		Long: "Login to Pachyderm. Any resources that have been restricted to " +
 			"the account you have with your ID provider (e.g. GitHub, Okta) " +
-			"account will subsequently be accessible.",
+			"account will subsequently be accessible. On hosts without a " +
+			"browser (e.g. over SSH), use --device to approve the login from " +
+			"another device.",
 		Run: cmdutil.Run(func([]string) error {
 			c, err := newClient(enterprise)
 			if err != nil {
@@ -312,6 +354,10 @@ func LoginCmd() *cobra.Command {
 					return errors.Wrapf(grpcutil.ScrubGRPC(authErr),
 						"authorization failed (Pachyderm logs may contain more information)")
 				}
+			} else if device {
+				if resp, err = deviceLogin(c); err != nil {
+					return err
+				}
 			} else {
 				if state, err := requestOIDCLogin(c, !noBrowser); err == nil {
 					// Exchange OIDC token for Pachyderm token
@@ -340,6 +386,9 @@ func LoginCmd() *cobra.Command {
 		"If set, don't try to open a web browser")
 	login.PersistentFlags().BoolVarP(&idToken, "id-token", "t", false,
 		"If set, read an ID token on stdin to authenticate the user")
+	login.PersistentFlags().BoolVar(&device, "device", false,
+		"If set, log in by entering a code on another device, rather than "+
+			"being redirected from a browser on this host")
 	login.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Login for the active enterprise context")
 	return cmdutil.CreateAlias(login, "auth login")
 }
@@ -403,6 +452,13 @@ func WhoamiCmd() *cobra.Command {
 			if resp.Expiration != nil {
 				fmt.Printf("session expires: %v\n", resp.Expiration.Format(time.RFC822))
 			}
+			for _, scope := range resp.Scopes {
+				resource := scope.Resource.Type.String()
+				if scope.Resource.Name != "" {
+					resource += " " + scope.Resource.Name
+				}
+				fmt.Printf("scoped to %v on %s\n", scope.Permissions, resource)
+			}
 			return nil
 		}),
 	}
@@ -416,10 +472,16 @@ func GetRobotTokenCmd() *cobra.Command {
 	var enterprise bool
 	var quiet bool
 	var ttl string
+	var scopes []string
 	getAuthToken := &cobra.Command{
 		Use:   "{{alias}} [username]",
 		Short: "Get an auth token for a robot user with the specified name.",
-		Long:  "Get an auth token for a robot user with the specified name.",
+		Long: "Get an auth token for a robot user with the specified name. " +
+			"By default the token has all of the robot's permissions, but it can be " +
+			"restricted to some permissions on some resources with --scope.",
+		Example: `
+# Get a token for the robot "ci" that can only read and write to the repo "images"
+$ {{alias}} ci --scope repo:images:read,write`,
 		Run: cmdutil.RunBoundedArgs(1, 1, func(args []string) error {
 			c, err := newClient(enterprise)
 			if err != nil {
@@ -437,6 +499,9 @@ func GetRobotTokenCmd() *cobra.Command {
 				}
 				req.TTL = int64(d.Seconds())
 			}
+			if req.Scopes, err = auth.ParseTokenScopes(scopes); err != nil {
+				return err
+			}
 			resp, err := c.GetRobotToken(c.Ctx(), req)
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
@@ -455,6 +520,11 @@ func GetRobotTokenCmd() *cobra.Command {
 	getAuthToken.PersistentFlags().StringVar(&ttl, "ttl", "", "if set, the "+
 		"resulting auth token will have the given lifetime. If not set, the token does not expire."+
 		" This flag should be a golang duration (e.g. \"30s\" or \"1h2m3s\").")
+	getAuthToken.PersistentFlags().StringArrayVar(&scopes, "scope", nil, "if "+
+		"set, the resulting auth token will only have the given permissions on the given "+
+		"resource, of the form \"repo:<name>:<permissions>\" or \"cluster:<permissions>\". "+
+		"Permissions are comma separated, and may omit the resource type (e.g. \"write\" for REPO_WRITE). "+
+		"May be specified multiple times.")
 	getAuthToken.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Get a robot token for the enterprise context")
 	return cmdutil.CreateAlias(getAuthToken, "auth get-robot-token")
 }
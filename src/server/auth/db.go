@@ -20,3 +20,22 @@ ON auth.auth_tokens (subject);
 `)
 	return err
 }
+
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+//
+// CreateAuthTokenScopesTable sets up the postgres table which tracks the
+// scopes of scoped tokens. Each row allows a token one permission on one
+// resource, and is deleted with its token.
+func CreateAuthTokenScopesTable(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+CREATE TABLE IF NOT EXISTS auth.auth_token_scopes (
+	token_hash VARCHAR(4096) NOT NULL REFERENCES auth.auth_tokens(token_hash) ON DELETE CASCADE,
+	resource_type INT NOT NULL,
+	resource_name VARCHAR(4096) NOT NULL,
+	permission INT NOT NULL,
+	PRIMARY KEY(token_hash, resource_type, resource_name, permission)
+);
+`)
+	return err
+}
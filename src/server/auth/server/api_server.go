@@ -467,6 +467,37 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 		}
 		pachToken = t
 
+	case req.DeviceCode != "":
+		// Exchange the device code for an ID token, which fails until the user
+		// has approved the login
+		rawIDToken, err := a.exchangeOIDCDeviceCode(ctx, req.DeviceCode)
+		if err != nil {
+			return nil, err
+		}
+		_, claims, err := a.validateIDToken(ctx, rawIDToken)
+		if err != nil {
+			return nil, err
+		}
+
+		username := auth.UserPrefix + claims.Email
+
+		if err := a.expiredEnterpriseCheck(ctx, username); err != nil {
+			return nil, err
+		}
+
+		// Sync the user's group membership from the groups claim
+		if err := a.syncGroupMembership(ctx, claims); err != nil {
+			return nil, err
+		}
+
+		// As with OIDCState, the user has just logged in, so the token lasts for
+		// a whole session
+		t, err := a.generateAndInsertAuthToken(ctx, username, int64(60*a.env.Config.SessionDurationMinutes))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error storing auth token for user \"%s\"", username)
+		}
+		pachToken = t
+
 	default:
 		return nil, errors.Errorf("unrecognized authentication mechanism (old pachd?)")
 	}
@@ -486,8 +517,11 @@ func resourceKey(r *auth.Resource) string {
 	return fmt.Sprintf("%s:%s", r.Type, r.Name)
 }
 
-func (a *apiServer) evaluateRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, principal string, resource *auth.Resource, permissions map[auth.Permission]bool) (*authorizeRequest, error) {
-	request := newAuthorizeRequest(principal, permissions, a.getGroupsInTransaction)
+// evaluateRoleBindingInTransaction determines which of permissions principal
+// has on resource. scopes are the scopes of principal's token, if any, which
+// the permissions are restricted to.
+func (a *apiServer) evaluateRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, principal string, scopes []*auth.TokenScope, resource *auth.Resource, permissions map[auth.Permission]bool) (*authorizeRequest, error) {
+	request := newAuthorizeRequest(principal, permissions, scopePermissions(scopes, resource), a.getGroupsInTransaction)
 
 	// Special-case making spec repos world-readable, because the alternative breaks reading pipelines.
 	// TOOD: 2.0 - should we make this a user-configurable cluster binding instead of hard-coding it?
@@ -561,7 +595,7 @@ func (a *apiServer) AuthorizeInTransaction(
 		permissions[p] = true
 	}
 
-	request, err := a.evaluateRoleBindingInTransaction(txnCtx, me.Username, req.Resource, permissions)
+	request, err := a.evaluateRoleBindingInTransaction(txnCtx, me.Username, me.Scopes, req.Resource, permissions)
 	if err != nil {
 		return nil, err
 	}
@@ -605,7 +639,7 @@ func (a *apiServer) GetPermissionsForPrincipal(ctx context.Context, req *auth.Ge
 	var request *authorizeRequest
 	if err := a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 		var err error
-		request, err = a.evaluateRoleBindingInTransaction(txnCtx, req.Principal, req.Resource, permissions)
+		request, err = a.evaluateRoleBindingInTransaction(txnCtx, req.Principal, nil, req.Resource, permissions)
 		return err
 	}); err != nil {
 		return nil, err
@@ -628,7 +662,15 @@ func (a *apiServer) GetPermissions(ctx context.Context, req *auth.GetPermissions
 		return nil, err
 	}
 
-	return a.GetPermissionsForPrincipal(ctx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Subject, Resource: req.Resource})
+	var response *auth.GetPermissionsResponse
+	if err := a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		response, err = a.getPermissionsForPrincipalInTransaction(txnCtx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Subject, Resource: req.Resource}, callerInfo.Scopes)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 func (a *apiServer) GetPermissionsInTransaction(txnCtx *txncontext.TransactionContext, req *auth.GetPermissionsRequest) (*auth.GetPermissionsResponse, error) {
@@ -638,16 +680,16 @@ func (a *apiServer) GetPermissionsInTransaction(txnCtx *txncontext.TransactionCo
 		return nil, err
 	}
 
-	return a.getPermissionsForPrincipalInTransaction(txnCtx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Username, Resource: req.Resource})
+	return a.getPermissionsForPrincipalInTransaction(txnCtx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Username, Resource: req.Resource}, callerInfo.Scopes)
 }
 
-func (a *apiServer) getPermissionsForPrincipalInTransaction(txnCtx *txncontext.TransactionContext, req *auth.GetPermissionsForPrincipalRequest) (*auth.GetPermissionsResponse, error) {
+func (a *apiServer) getPermissionsForPrincipalInTransaction(txnCtx *txncontext.TransactionContext, req *auth.GetPermissionsForPrincipalRequest, scopes []*auth.TokenScope) (*auth.GetPermissionsResponse, error) {
 	permissions := make(map[auth.Permission]bool)
 	for p := range auth.Permission_name {
 		permissions[auth.Permission(p)] = true
 	}
 
-	request, err := a.evaluateRoleBindingInTransaction(txnCtx, req.Principal, req.Resource, permissions)
+	request, err := a.evaluateRoleBindingInTransaction(txnCtx, req.Principal, scopes, req.Resource, permissions)
 	if err != nil {
 		return nil, err
 	}
@@ -676,6 +718,7 @@ func (a *apiServer) WhoAmI(ctx context.Context, req *auth.WhoAmIRequest) (resp *
 	return &auth.WhoAmIResponse{
 		Username:   callerInfo.Subject,
 		Expiration: callerInfo.Expiration,
+		Scopes:     callerInfo.Scopes,
 	}, nil
 }
 
@@ -951,15 +994,15 @@ func (a *apiServer) GetRobotToken(ctx context.Context, req *auth.GetRobotTokenRe
 
 	subject = auth.RobotPrefix + subject
 
-	// generate new token, and write to postgres
-	var token string
-	var err error
-	if req.TTL > 0 {
-		token, err = a.generateAndInsertAuthToken(ctx, subject, req.TTL)
-	} else {
-		token, err = a.generateAndInsertAuthTokenNoTTL(ctx, subject)
+	if err := validateTokenScopes(req.Scopes); err != nil {
+		return nil, err
 	}
-	if err != nil {
+
+	// generate new token, and write it to postgres along with its scopes
+	token := uuid.NewWithoutDashes()
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		return a.insertAuthTokenInTransaction(ctx, sqlTx, auth.HashToken(token), subject, req.TTL, req.Scopes)
+	}); err != nil {
 		return nil, err
 	}
 	return &auth.GetRobotTokenResponse{
@@ -999,6 +1042,15 @@ func (a *apiServer) GetOIDCLogin(ctx context.Context, req *auth.GetOIDCLoginRequ
 	}, nil
 }
 
+// GetOIDCDeviceLogin implements the protobuf auth.GetOIDCDeviceLogin RPC
+func (a *apiServer) GetOIDCDeviceLogin(ctx context.Context, req *auth.GetOIDCDeviceLoginRequest) (resp *auth.GetOIDCDeviceLoginResponse, retErr error) {
+	a.LogReq(req)
+	// Don't log response to avoid logging the device code
+	defer func(start time.Time) { a.LogResp(req, nil, retErr, time.Since(start)) }(time.Now())
+
+	return a.startOIDCDeviceLogin(ctx)
+}
+
 // RevokeAuthToken implements the protobuf auth.RevokeAuthToken RPC
 func (a *apiServer) RevokeAuthToken(ctx context.Context, req *auth.RevokeAuthTokenRequest) (resp *auth.RevokeAuthTokenResponse, retErr error) {
 	a.LogReq(req)
@@ -1103,41 +1155,44 @@ func (a *apiServer) ModifyMembers(ctx context.Context, req *auth.ModifyMembersRe
 	}
 
 	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
-		members := a.members.ReadWrite(sqlTx)
-		var groupsProto auth.Groups
-		for _, username := range req.Add {
-			if err := members.Upsert(username, &groupsProto, func() error {
-				groupsProto.Groups = addToSet(groupsProto.Groups, req.Group)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		for _, username := range req.Remove {
-			if err := members.Upsert(username, &groupsProto, func() error {
-				groupsProto.Groups = removeFromSet(groupsProto.Groups, req.Group)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
+		return a.modifyMembersInTransaction(sqlTx, req.Group, req.Add, req.Remove)
+	}); err != nil {
+		return nil, err
+	}
 
-		groups := a.groups.ReadWrite(sqlTx)
-		var membersProto auth.Users
-		if err := groups.Upsert(req.Group, &membersProto, func() error {
-			membersProto.Usernames = addToSet(membersProto.Usernames, req.Add...)
-			membersProto.Usernames = removeFromSet(membersProto.Usernames, req.Remove...)
+	return &auth.ModifyMembersResponse{}, nil
+}
+
+// modifyMembersInTransaction adds and removes users from a group, updating
+// both the members and groups collections. This does no auth checks, so the
+// caller must do all relevant authorization.
+func (a *apiServer) modifyMembersInTransaction(sqlTx *sqlx.Tx, group string, add, remove []string) error {
+	members := a.members.ReadWrite(sqlTx)
+	var groupsProto auth.Groups
+	for _, username := range add {
+		if err := members.Upsert(username, &groupsProto, func() error {
+			groupsProto.Groups = addToSet(groupsProto.Groups, group)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	for _, username := range remove {
+		if err := members.Upsert(username, &groupsProto, func() error {
+			groupsProto.Groups = removeFromSet(groupsProto.Groups, group)
 			return nil
 		}); err != nil {
 			return err
 		}
-
-		return nil
-	}); err != nil {
-		return nil, err
 	}
 
-	return &auth.ModifyMembersResponse{}, nil
+	groups := a.groups.ReadWrite(sqlTx)
+	var membersProto auth.Users
+	return groups.Upsert(group, &membersProto, func() error {
+		membersProto.Usernames = addToSet(membersProto.Usernames, add...)
+		membersProto.Usernames = removeFromSet(membersProto.Usernames, remove...)
+		return nil
+	})
 }
 
 func addToSet(set map[string]bool, elems ...string) map[string]bool {
@@ -1454,13 +1509,12 @@ func (a *apiServer) RestoreAuthToken(ctx context.Context, req *auth.RestoreAuthT
 		}
 	}
 
-	if err := func() error {
-		if ttl > 0 {
-			return a.insertAuthToken(ctx, req.Token.HashedToken, req.Token.Subject, ttl)
-		} else {
-			return a.insertAuthTokenNoTTL(ctx, req.Token.HashedToken, req.Token.Subject)
-		}
-	}(); err != nil {
+	if err := validateTokenScopes(req.Token.Scopes); err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		return a.insertAuthTokenInTransaction(ctx, sqlTx, req.Token.HashedToken, req.Token.Subject, ttl, req.Token.Scopes)
+	}); err != nil {
 		return nil, errors.Wrapf(err, "error restoring auth token")
 	}
 
@@ -1506,9 +1560,40 @@ func (a *apiServer) lookupAuthTokenInfo(ctx context.Context, tokenHash string) (
 	if err != nil {
 		return nil, col.ErrNotFound{Type: "auth_tokens", Key: tokenHash}
 	}
+	if tokenInfo.Scopes, err = a.lookupAuthTokenScopes(ctx, tokenHash); err != nil {
+		return nil, err
+	}
 	return &tokenInfo, nil
 }
 
+// lookupAuthTokenScopes returns the scopes of a token, which are empty if the
+// token isn't scoped.
+func (a *apiServer) lookupAuthTokenScopes(ctx context.Context, tokenHash string) ([]*auth.TokenScope, error) {
+	var rows []struct {
+		ResourceType auth.ResourceType `db:"resource_type"`
+		ResourceName string            `db:"resource_name"`
+		Permission   auth.Permission   `db:"permission"`
+	}
+	if err := a.env.DB.SelectContext(ctx, &rows,
+		`SELECT resource_type, resource_name, permission
+		FROM auth.auth_token_scopes
+		WHERE token_hash = $1
+		ORDER BY resource_type, resource_name, permission`, tokenHash); err != nil {
+		return nil, errors.Wrapf(err, "error querying token scopes")
+	}
+	var scopes []*auth.TokenScope
+	for _, row := range rows {
+		if len(scopes) == 0 || scopes[len(scopes)-1].Resource.Type != row.ResourceType || scopes[len(scopes)-1].Resource.Name != row.ResourceName {
+			scopes = append(scopes, &auth.TokenScope{
+				Resource: &auth.Resource{Type: row.ResourceType, Name: row.ResourceName},
+			})
+		}
+		scope := scopes[len(scopes)-1]
+		scope.Permissions = append(scope.Permissions, row.Permission)
+	}
+	return scopes, nil
+}
+
 // we will sometimes have expiration values set in the passed, since we only remove those values in the deleteExpiredTokensRoutine() goroutine
 func (a *apiServer) listRobotTokens(ctx context.Context) ([]*auth.TokenInfo, error) {
 	robotTokens := make([]*auth.TokenInfo, 0)
@@ -1518,6 +1603,12 @@ func (a *apiServer) listRobotTokens(ctx context.Context) ([]*auth.TokenInfo, err
 		WHERE subject LIKE $1 || '%'`, auth.RobotPrefix); err != nil {
 		return nil, errors.Wrapf(err, "error querying token")
 	}
+	for _, tokenInfo := range robotTokens {
+		var err error
+		if tokenInfo.Scopes, err = a.lookupAuthTokenScopes(ctx, tokenInfo.HashedToken); err != nil {
+			return nil, err
+		}
+	}
 	return robotTokens, nil
 }
 
@@ -1529,15 +1620,6 @@ func (a *apiServer) generateAndInsertAuthToken(ctx context.Context, subject stri
 	return token, nil
 }
 
-func (a *apiServer) generateAndInsertAuthTokenNoTTL(ctx context.Context, subject string) (string, error) {
-	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthTokenNoTTL(ctx, auth.HashToken(token), subject); err != nil {
-		return "", err
-	}
-
-	return token, nil
-}
-
 // generates a token, and stores it's hash and supporting data in postgres
 func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subject string, ttlSeconds int64) error {
 	if _, err := a.env.DB.ExecContext(ctx,
@@ -1551,12 +1633,35 @@ func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subje
 	return nil
 }
 
-// TODO(acohen4): replace this function with what's implemented in postgres-integration once it lands
-func (a *apiServer) insertAuthTokenNoTTL(ctx context.Context, tokenHash string, subject string) error {
-	return a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		err := a.insertAuthTokenNoTTLInTransaction(txnCtx, tokenHash, subject)
-		return err
-	})
+// insertAuthTokenInTransaction stores a token's hash along with its scopes,
+// if it has any. If ttlSeconds is 0, the token doesn't expire.
+func (a *apiServer) insertAuthTokenInTransaction(ctx context.Context, sqlTx *sqlx.Tx, tokenHash string, subject string, ttlSeconds int64, scopes []*auth.TokenScope) error {
+	var err error
+	if ttlSeconds > 0 {
+		_, err = sqlTx.ExecContext(ctx,
+			`INSERT INTO auth.auth_tokens (token_hash, subject, expiration) 
+			VALUES ($1, $2, NOW() + $3 * interval '1 sec')`, tokenHash, subject, ttlSeconds)
+	} else {
+		_, err = sqlTx.ExecContext(ctx,
+			`INSERT INTO auth.auth_tokens (token_hash, subject) 
+			VALUES ($1, $2)`, tokenHash, subject)
+	}
+	if err != nil {
+		if dbutil.IsUniqueViolation(err) {
+			return errors.New("cannot overwrite existing token with same hash")
+		}
+		return errors.Wrapf(err, "error storing token")
+	}
+	for _, scope := range scopes {
+		for _, p := range scope.Permissions {
+			if _, err := sqlTx.ExecContext(ctx,
+				`INSERT INTO auth.auth_token_scopes (token_hash, resource_type, resource_name, permission)
+				VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`, tokenHash, scope.Resource.Type, scope.Resource.Name, p); err != nil {
+				return errors.Wrapf(err, "error storing token scope")
+			}
+		}
+	}
+	return nil
 }
 
 func (a *apiServer) insertAuthTokenNoTTLInTransaction(txnCtx *txncontext.TransactionContext, tokenHash string, subject string) error {
@@ -3,6 +3,7 @@ package server
 import (
 	"sort"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -16,20 +17,25 @@ type groupLookupFn func(txnCtx *txncontext.TransactionContext, subject string) (
 // This decouples evaluating role bindings from fetching the role bindings,
 // so we can easily try the cheapest/most likely options and exit early if
 // the permissions are all satisfied.
+//
+// If the subject's token is scoped, only the permissions in scope can be
+// satisfied, regardless of the subject's roles.
 type authorizeRequest struct {
 	subject              string
 	permissions          map[auth.Permission]bool
+	scope                map[auth.Permission]bool
 	roleMap              map[string]*auth.Role
 	satisfiedPermissions []auth.Permission
 	groupsForSubject     groupLookupFn
 	groups               []string
 }
 
-func newAuthorizeRequest(subject string, permissions map[auth.Permission]bool, groupsForSubject groupLookupFn) *authorizeRequest {
+func newAuthorizeRequest(subject string, permissions, scope map[auth.Permission]bool, groupsForSubject groupLookupFn) *authorizeRequest {
 	return &authorizeRequest{
 		subject:              subject,
 		roleMap:              make(map[string]*auth.Role),
 		permissions:          permissions,
+		scope:                scope,
 		groupsForSubject:     groupsForSubject,
 		satisfiedPermissions: make([]auth.Permission, 0),
 	}
@@ -125,6 +131,9 @@ func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding
 			r.roleMap[role] = roleDefinition.role
 
 			for _, permission := range roleDefinition.role.Permissions {
+				if r.scope != nil && !r.scope[permission] {
+					continue
+				}
 				if _, ok := r.permissions[permission]; ok {
 					r.satisfiedPermissions = append(r.satisfiedPermissions, permission)
 					delete(r.permissions, permission)
@@ -134,3 +143,57 @@ func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding
 	}
 	return nil
 }
+
+// scopePermissions returns the permissions that a token's scopes allow on a
+// resource, or nil if the token isn't scoped. Spec repos are in the scope of
+// the repo they belong to, and scopes on the cluster apply to every resource.
+func scopePermissions(scopes []*auth.TokenScope, resource *auth.Resource) map[auth.Permission]bool {
+	if len(scopes) == 0 {
+		return nil
+	}
+	resourceType := resource.Type
+	if resourceType == auth.ResourceType_SPEC_REPO {
+		resourceType = auth.ResourceType_REPO
+	}
+	permissions := make(map[auth.Permission]bool)
+	for _, scope := range scopes {
+		if scope.Resource.Type != auth.ResourceType_CLUSTER &&
+			(scope.Resource.Type != resourceType || scope.Resource.Name != resource.Name) {
+			continue
+		}
+		for _, p := range scope.Permissions {
+			permissions[p] = true
+		}
+	}
+	return permissions
+}
+
+// validateTokenScopes returns an error if any of a token's scopes are invalid.
+func validateTokenScopes(scopes []*auth.TokenScope) error {
+	for _, scope := range scopes {
+		if scope.Resource == nil {
+			return errors.New("token scope must have a resource")
+		}
+		switch scope.Resource.Type {
+		case auth.ResourceType_CLUSTER:
+			if scope.Resource.Name != "" {
+				return errors.New("token scope on the cluster cannot have a resource name")
+			}
+		case auth.ResourceType_REPO:
+			if scope.Resource.Name == "" {
+				return errors.New("token scope on a repo must have a repo name")
+			}
+		default:
+			return errors.Errorf("token scopes can only be on the cluster or a repo, not %v", scope.Resource.Type)
+		}
+		if len(scope.Permissions) == 0 {
+			return errors.Errorf("token scope on %v %q must have at least one permission", scope.Resource.Type, scope.Resource.Name)
+		}
+		for _, p := range scope.Permissions {
+			if _, ok := auth.Permission_name[int32(p)]; !ok || p == auth.Permission_PERMISSION_UNKNOWN {
+				return errors.Errorf("token scope has unknown permission %v", p)
+			}
+		}
+	}
+	return nil
+}
@@ -1,10 +1,12 @@
 package server
 
 import (
+	"encoding/json"
 	goerr "errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -127,6 +129,45 @@ func (c *oidcConfig) Ctx(ctx context.Context) context.Context {
 	return ctx
 }
 
+// userAccessURL rewrites a URL returned by the OIDC provider so that it is
+// accessible to users, if the provider has a separate user-accessible host.
+func (c *oidcConfig) userAccessURL(u string) (string, error) {
+	if c.userAccessAddress == "" || u == "" {
+		return u, nil
+	}
+	rewriteURL, err := url.Parse(u)
+	if err != nil {
+		return "", errors.Wrap(err, "could not parse URL for Localhost Issuer rewrite")
+	}
+	rewriteURL.Host = c.userAccessAddress
+	return rewriteURL.String(), nil
+}
+
+// postForm sends a form to one of the OIDC provider's endpoints, and decodes
+// the JSON response into resp. OAuth errors are returned in resp rather than
+// as an error, since they are part of the device login protocol.
+func (c *oidcConfig) postForm(ctx context.Context, endpoint string, form url.Values, resp interface{}) error {
+	client := http.DefaultClient
+	if c.rewriteClient != nil {
+		client = c.rewriteClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	httpResp, err := client.Do(req)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer httpResp.Body.Close()
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return errors.Wrapf(err, "could not decode response from %s (%s)", endpoint, httpResp.Status)
+	}
+	return nil
+}
+
 func (a *apiServer) getOIDCConfig(ctx context.Context) (*oidcConfig, error) {
 	config, ok := a.configCache.Load().(*auth.OIDCConfig)
 	if !ok {
@@ -160,17 +201,126 @@ func (a *apiServer) GetOIDCLoginURL(ctx context.Context) (string, string, error)
 		oauth2.SetAuthURLParam("response_type", "code"),
 		oauth2.SetAuthURLParam("nonce", nonce))
 
-	if config.userAccessAddress != "" {
-		rewriteURL, err := url.Parse(authURL)
-		if err != nil {
-			return "", "", errors.Wrap(err, "could not parse Auth URL for Localhost Issuer rewrite")
-		}
-		rewriteURL.Host = config.userAccessAddress
-		authURL = rewriteURL.String()
+	authURL, err = config.userAccessURL(authURL)
+	if err != nil {
+		return "", "", err
 	}
 	return authURL, state, nil
 }
 
+// deviceAuthorizationResponse is the response from an OIDC provider's device
+// authorization endpoint (see RFC 8628 section 3.2).
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+	Error                   string `json:"error"`
+	ErrorDescription        string `json:"error_description"`
+}
+
+// deviceTokenResponse is the response from an OIDC provider's token endpoint
+// to a device access token request (see RFC 8628 section 3.5).
+type deviceTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// startOIDCDeviceLogin starts an OAuth 2.0 device authorization grant with
+// the OIDC provider. pachd makes the requests to the provider on behalf of the
+// caller, since it holds the client secret.
+func (a *apiServer) startOIDCDeviceLogin(ctx context.Context) (*auth.GetOIDCDeviceLoginResponse, error) {
+	config, err := a.getOIDCConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var claims struct {
+		DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	}
+	if err := config.oidcProvider.Claims(&claims); err != nil {
+		return nil, errors.Wrap(err, "could not read OIDC provider configuration")
+	}
+	if claims.DeviceAuthorizationEndpoint == "" {
+		return nil, errors.New("the OIDC provider does not support device login")
+	}
+
+	var resp deviceAuthorizationResponse
+	if err := config.postForm(ctx, claims.DeviceAuthorizationEndpoint, url.Values{
+		"client_id":     {config.ClientID},
+		"client_secret": {config.ClientSecret},
+		"scope":         {strings.Join(config.Scopes, " ")},
+	}, &resp); err != nil {
+		return nil, errors.Wrap(err, "could not start device login")
+	}
+	if resp.Error != "" || resp.DeviceCode == "" {
+		return nil, errors.Errorf("could not start device login: %s %s", resp.Error, resp.ErrorDescription)
+	}
+	verificationURL, err := config.userAccessURL(resp.VerificationURI)
+	if err != nil {
+		return nil, err
+	}
+	verificationURLComplete, err := config.userAccessURL(resp.VerificationURIComplete)
+	if err != nil {
+		return nil, err
+	}
+	// RFC 8628 section 3.2: if no interval is provided, clients must use 5s
+	interval := resp.Interval
+	if interval <= 0 {
+		interval = 5
+	}
+	return &auth.GetOIDCDeviceLoginResponse{
+		UserCode:                resp.UserCode,
+		VerificationURL:         verificationURL,
+		VerificationURLComplete: verificationURLComplete,
+		DeviceCode:              resp.DeviceCode,
+		ExpiresIn:               resp.ExpiresIn,
+		Interval:                interval,
+	}, nil
+}
+
+// exchangeOIDCDeviceCode requests an ID token for a device code from the OIDC
+// provider. It returns auth.ErrAuthorizationPending (or auth.ErrSlowDown) if
+// the user hasn't approved the login yet.
+func (a *apiServer) exchangeOIDCDeviceCode(ctx context.Context, deviceCode string) (string, error) {
+	config, err := a.getOIDCConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	var resp deviceTokenResponse
+	if err := config.postForm(ctx, config.oauthConfig.Endpoint.TokenURL, url.Values{
+		"grant_type":    {deviceCodeGrantType},
+		"device_code":   {deviceCode},
+		"client_id":     {config.ClientID},
+		"client_secret": {config.ClientSecret},
+	}, &resp); err != nil {
+		return "", errors.Wrap(err, "could not exchange device code")
+	}
+	switch resp.Error {
+	case "":
+	case "authorization_pending":
+		return "", auth.ErrAuthorizationPending
+	case "slow_down":
+		return "", auth.ErrSlowDown
+	default:
+		// As with the auth code flow, don't give the user details of the
+		// error, but log them for cluster administrators.
+		logrus.Errorf("could not exchange device code (%q): %s %s", half(deviceCode), resp.Error, resp.ErrorDescription)
+		if resp.Error == "expired_token" {
+			return "", errors.New("device login expired, try logging in again")
+		}
+		return "", errors.WithStack(errAuthFailed)
+	}
+	if resp.IDToken == "" {
+		return "", errors.New("missing id token")
+	}
+	return resp.IDToken, nil
+}
+
 // OIDCStateToEmail takes the state token created for the OIDC session and
 // uses it discover the email of the user who obtained the code (or verify that
 // the code belongs to them). This is how Pachyderm currently implements OIDC
@@ -398,5 +548,7 @@ func (a *apiServer) handleOIDCExchangeInternal(ctx context.Context, authCode, st
 func (a *apiServer) serveOIDC() error {
 	// serve OIDC handler to exchange the auth code
 	http.HandleFunc("/authorization-code/callback", a.handleOIDCExchange)
+	// serve SCIM endpoints, so that an IdP can provision users and groups
+	http.HandleFunc(scimPathPrefix, a.handleSCIM)
 	return http.ListenAndServe(fmt.Sprintf(":%v", a.env.Config.OidcPort), nil)
 }
@@ -207,6 +207,9 @@ func init() {
 				auth.Permission_CLUSTER_ENTERPRISE_GET_CODE,
 				auth.Permission_CLUSTER_ENTERPRISE_DEACTIVATE,
 				auth.Permission_CLUSTER_DELETE_ALL,
+				auth.Permission_CLUSTER_SET_REPO_QUOTA,
+				auth.Permission_CLUSTER_EDIT_NOTIFICATIONS,
+				auth.Permission_CLUSTER_LIST_NOTIFICATIONS,
 			}),
 	})
 }
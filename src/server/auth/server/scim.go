@@ -0,0 +1,817 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// This file implements a SCIM 2.0 (RFC 7643, RFC 7644) service provider, so
+// that an IdP can push user and group changes to Pachyderm as they happen,
+// rather than waiting for users to log in again.
+//
+// SCIM users are Pachyderm users (subjects with the "user:" prefix) and SCIM
+// groups are Pachyderm groups (with the "group:" prefix), which matches the
+// subjects created from the OIDC groups claim in syncGroupMembership. The ID
+// of each resource is its name without the prefix. Deactivating or deleting a
+// user removes it from all groups and revokes all of its tokens.
+
+const (
+	scimPathPrefix = "/scim/v2/"
+
+	scimContentType = "application/scim+json"
+
+	scimUserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// scimFilterRegex matches the only filters we support, which are the equality
+// filters that IdPs use to look up a user or group before provisioning it
+var scimFilterRegex = regexp.MustCompile(`^\s*(\w+)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// scimMemberPathRegex matches a patch path selecting a single group member,
+// e.g. members[value eq "alice@example.com"]
+var scimMemberPathRegex = regexp.MustCompile(`^members\[\s*value\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*\]$`)
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+}
+
+// scimMember is a reference to a group member (in a group) or to a group (in
+// a user)
+type scimMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type scimUser struct {
+	Schemas  []string     `json:"schemas"`
+	ID       string       `json:"id"`
+	UserName string       `json:"userName"`
+	Active   *bool        `json:"active,omitempty"`
+	Groups   []scimMember `json:"groups,omitempty"`
+	Meta     *scimMeta    `json:"meta,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id"`
+	DisplayName string       `json:"displayName"`
+	Members     []scimMember `json:"members"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// scimError is both an error and the SCIM error response that it's returned
+// to the client as
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+	Status   string   `json:"status"`
+
+	status int
+}
+
+func (e *scimError) Error() string {
+	return e.Detail
+}
+
+func newSCIMError(status int, scimType, format string, args ...interface{}) *scimError {
+	return &scimError{
+		Schemas:  []string{scimErrorSchema},
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+		Status:   strconv.Itoa(status),
+		status:   status,
+	}
+}
+
+// handleSCIM serves all SCIM requests. The caller must send a Pachyderm token
+// as a bearer token, and must be able to modify group membership and revoke
+// user tokens on the cluster.
+func (a *apiServer) handleSCIM(w http.ResponseWriter, req *http.Request) {
+	status, resp, err := a.serveSCIM(req)
+	if err != nil {
+		var scimErr *scimError
+		if !errors.As(err, &scimErr) {
+			logrus.Errorf("error serving SCIM request %s %s: %v", req.Method, req.URL.Path, err)
+			scimErr = newSCIMError(http.StatusInternalServerError, "", "internal error")
+		}
+		status, resp = scimErr.status, scimErr
+	}
+	if resp == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logrus.Errorf("error writing SCIM response: %v", err)
+	}
+}
+
+func (a *apiServer) serveSCIM(req *http.Request) (int, interface{}, error) {
+	ctx, err := a.authorizeSCIMRequest(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	parts := strings.SplitN(strings.TrimPrefix(req.URL.Path, scimPathPrefix), "/", 2)
+	var id string
+	if len(parts) == 2 {
+		id = parts[1]
+	}
+	switch parts[0] {
+	case "Users":
+		return a.serveSCIMUsers(ctx, req, id)
+	case "Groups":
+		return a.serveSCIMGroups(ctx, req, id)
+	case "ServiceProviderConfig":
+		if req.Method == http.MethodGet && id == "" {
+			return http.StatusOK, scimServiceProviderConfig(), nil
+		}
+	default:
+		return 0, nil, newSCIMError(http.StatusNotFound, "", "unknown SCIM endpoint %q", req.URL.Path)
+	}
+	return 0, nil, newSCIMError(http.StatusMethodNotAllowed, "", "%s is not supported on %q", req.Method, req.URL.Path)
+}
+
+// authorizeSCIMRequest checks the request's bearer token, and returns a
+// context that carries it, as if the request had been made over GRPC
+func (a *apiServer) authorizeSCIMRequest(req *http.Request) (context.Context, error) {
+	token := strings.TrimSpace(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+	if token == "" {
+		return nil, newSCIMError(http.StatusUnauthorized, "", "a Pachyderm token must be provided as a bearer token")
+	}
+	ctx := metadata.NewIncomingContext(req.Context(), metadata.Pairs(auth.ContextTokenKey, token))
+	resp, err := a.Authorize(ctx, &auth.AuthorizeRequest{
+		Resource: &auth.Resource{Type: auth.ResourceType_CLUSTER},
+		Permissions: []auth.Permission{
+			auth.Permission_CLUSTER_AUTH_MODIFY_GROUP_MEMBERS,
+			auth.Permission_CLUSTER_AUTH_GET_GROUP_USERS,
+			auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS,
+		},
+	})
+	if err != nil {
+		switch {
+		case auth.IsErrNotActivated(err):
+			return nil, newSCIMError(http.StatusNotFound, "", "%v", err)
+		case auth.IsErrBadToken(err), auth.IsErrExpiredToken(err):
+			return nil, newSCIMError(http.StatusUnauthorized, "", "%v", err)
+		}
+		return nil, err
+	}
+	if !resp.Authorized {
+		return nil, newSCIMError(http.StatusForbidden, "", "%s is not authorized to provision users and groups, missing %v", resp.Principal, resp.Missing)
+	}
+	return ctx, nil
+}
+
+func (a *apiServer) serveSCIMUsers(ctx context.Context, req *http.Request, id string) (int, interface{}, error) {
+	switch {
+	case req.Method == http.MethodGet && id == "":
+		users, err := a.listSCIMUsers(ctx, req.URL.Query().Get("filter"))
+		if err != nil {
+			return 0, nil, err
+		}
+		resp, err := newSCIMListResponse(users, req)
+		return http.StatusOK, resp, err
+	case req.Method == http.MethodGet:
+		user, err := a.getSCIMUser(ctx, id)
+		return http.StatusOK, user, err
+	case req.Method == http.MethodPost && id == "":
+		var user scimUser
+		if err := decodeSCIMRequest(req, &user); err != nil {
+			return 0, nil, err
+		}
+		created, err := a.createSCIMUser(ctx, &user)
+		return http.StatusCreated, created, err
+	case req.Method == http.MethodPut && id != "":
+		var user scimUser
+		if err := decodeSCIMRequest(req, &user); err != nil {
+			return 0, nil, err
+		}
+		if user.UserName != "" && user.UserName != id {
+			return 0, nil, newSCIMError(http.StatusBadRequest, "mutability", "users cannot be renamed")
+		}
+		replaced, err := a.setSCIMUserActive(ctx, id, user.Active == nil || *user.Active)
+		return http.StatusOK, replaced, err
+	case req.Method == http.MethodPatch && id != "":
+		var patch scimPatchRequest
+		if err := decodeSCIMRequest(req, &patch); err != nil {
+			return 0, nil, err
+		}
+		active, err := scimUserPatchActive(&patch)
+		if err != nil {
+			return 0, nil, err
+		}
+		patched, err := a.setSCIMUserActive(ctx, id, active)
+		return http.StatusOK, patched, err
+	case req.Method == http.MethodDelete && id != "":
+		found, err := a.deactivateSCIMUser(ctx, id)
+		if err == nil && !found {
+			err = newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+		}
+		return http.StatusNoContent, nil, err
+	}
+	return 0, nil, newSCIMError(http.StatusMethodNotAllowed, "", "%s is not supported on %q", req.Method, req.URL.Path)
+}
+
+func newSCIMUser(id string, groups *auth.Groups) *scimUser {
+	active := true
+	user := &scimUser{
+		Schemas:  []string{scimUserSchema},
+		ID:       id,
+		UserName: id,
+		Active:   &active,
+		Meta:     &scimMeta{ResourceType: "User"},
+	}
+	for _, group := range sortedSet(groups.Groups) {
+		if strings.HasPrefix(group, auth.GroupPrefix) {
+			name := strings.TrimPrefix(group, auth.GroupPrefix)
+			user.Groups = append(user.Groups, scimMember{Value: name, Display: name})
+		}
+	}
+	return user
+}
+
+func (a *apiServer) getSCIMUser(ctx context.Context, id string) (*scimUser, error) {
+	var groups auth.Groups
+	if err := a.members.ReadOnly(ctx).Get(auth.UserPrefix+id, &groups); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+		}
+		return nil, err
+	}
+	return newSCIMUser(id, &groups), nil
+}
+
+func (a *apiServer) listSCIMUsers(ctx context.Context, filter string) ([]interface{}, error) {
+	if filter != "" {
+		attr, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		if attr != "username" && attr != "id" {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "users can only be filtered by userName or id")
+		}
+		user, err := a.getSCIMUser(ctx, value)
+		if err != nil {
+			var scimErr *scimError
+			if errors.As(err, &scimErr) && scimErr.status == http.StatusNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []interface{}{user}, nil
+	}
+
+	var users []interface{}
+	groups := &auth.Groups{}
+	if err := a.members.ReadOnly(ctx).List(groups, col.DefaultOptions(), func(subject string) error {
+		if strings.HasPrefix(subject, auth.UserPrefix) {
+			users = append(users, newSCIMUser(strings.TrimPrefix(subject, auth.UserPrefix), groups))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].(*scimUser).ID < users[j].(*scimUser).ID
+	})
+	return users, nil
+}
+
+func (a *apiServer) createSCIMUser(ctx context.Context, user *scimUser) (*scimUser, error) {
+	if user.UserName == "" {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "userName must be set")
+	}
+	// Users that are provisioned as inactive aren't stored, as they can't
+	// have any tokens or group memberships
+	if user.Active != nil && !*user.Active {
+		return &scimUser{
+			Schemas:  []string{scimUserSchema},
+			ID:       user.UserName,
+			UserName: user.UserName,
+			Active:   user.Active,
+			Meta:     &scimMeta{ResourceType: "User"},
+		}, nil
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		return a.members.ReadWrite(sqlTx).Create(auth.UserPrefix+user.UserName, &auth.Groups{})
+	}); err != nil {
+		if col.IsErrExists(err) {
+			return nil, newSCIMError(http.StatusConflict, "uniqueness", "user %q already exists", user.UserName)
+		}
+		return nil, err
+	}
+	return newSCIMUser(user.UserName, &auth.Groups{}), nil
+}
+
+// setSCIMUserActive activates or deactivates a user, and returns the user's
+// resource. Both are idempotent: deactivated users aren't stored, so
+// activating a user provisions it again, and deactivating a user that isn't
+// provisioned only revokes its tokens.
+func (a *apiServer) setSCIMUserActive(ctx context.Context, id string, active bool) (*scimUser, error) {
+	if active {
+		return a.activateSCIMUser(ctx, id)
+	}
+	if _, err := a.deactivateSCIMUser(ctx, id); err != nil {
+		return nil, err
+	}
+	user := newSCIMUser(id, &auth.Groups{})
+	user.Active = &active
+	return user, nil
+}
+
+// activateSCIMUser provisions a user if it isn't already, keeping the groups
+// of users that are.
+func (a *apiServer) activateSCIMUser(ctx context.Context, id string) (*scimUser, error) {
+	var groups auth.Groups
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		return a.members.ReadWrite(sqlTx).Upsert(auth.UserPrefix+id, &groups, func() error { return nil })
+	}); err != nil {
+		return nil, err
+	}
+	return newSCIMUser(id, &groups), nil
+}
+
+// deactivateSCIMUser removes a user from all of its groups and revokes all of
+// its tokens, so that it has no access to the cluster until it logs in again.
+// It returns false if the user wasn't provisioned.
+func (a *apiServer) deactivateSCIMUser(ctx context.Context, id string) (bool, error) {
+	subject := auth.UserPrefix + id
+	var found bool
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		found = false
+		// The user's tokens are revoked even if they aren't a member of any
+		// groups, as they may have logged in without being provisioned.
+		if err := a.deleteAuthTokensForSubjectInTransaction(sqlTx, subject); err != nil {
+			return err
+		}
+		members := a.members.ReadWrite(sqlTx)
+		var groupsProto auth.Groups
+		if err := members.Get(subject, &groupsProto); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		found = true
+		groups := a.groups.ReadWrite(sqlTx)
+		var membersProto auth.Users
+		for group := range groupsProto.Groups {
+			if err := groups.Upsert(group, &membersProto, func() error {
+				membersProto.Usernames = removeFromSet(membersProto.Usernames, subject)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return members.Delete(subject)
+	}); err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// scimUserPatchActive returns the value of the user's active attribute after
+// a patch. Since users have no other mutable attributes, other changes are
+// ignored.
+func scimUserPatchActive(patch *scimPatchRequest) (bool, error) {
+	active := true
+	for _, op := range patch.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+		case "remove":
+			continue
+		default:
+			return false, newSCIMError(http.StatusBadRequest, "invalidSyntax", "unknown patch operation %q", op.Op)
+		}
+		value := op.Value
+		if op.Path == "" {
+			var attrs map[string]json.RawMessage
+			if err := json.Unmarshal(op.Value, &attrs); err != nil {
+				return false, newSCIMError(http.StatusBadRequest, "invalidValue", "patch value must be an object if no path is set")
+			}
+			var ok bool
+			if value, ok = attrs["active"]; !ok {
+				continue
+			}
+		} else if op.Path != "active" {
+			continue
+		}
+		var err error
+		if active, err = parseSCIMBool(value); err != nil {
+			return false, err
+		}
+	}
+	return active, nil
+}
+
+// parseSCIMBool parses a boolean attribute. Some IdPs send booleans as
+// strings, so those are accepted too.
+func parseSCIMBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, newSCIMError(http.StatusBadRequest, "invalidValue", "%s is not a boolean", value)
+}
+
+func (a *apiServer) serveSCIMGroups(ctx context.Context, req *http.Request, id string) (int, interface{}, error) {
+	switch {
+	case req.Method == http.MethodGet && id == "":
+		groups, err := a.listSCIMGroups(ctx, req.URL.Query().Get("filter"))
+		if err != nil {
+			return 0, nil, err
+		}
+		resp, err := newSCIMListResponse(groups, req)
+		return http.StatusOK, resp, err
+	case req.Method == http.MethodGet:
+		group, err := a.getSCIMGroup(ctx, id)
+		return http.StatusOK, group, err
+	case req.Method == http.MethodPost && id == "":
+		var group scimGroup
+		if err := decodeSCIMRequest(req, &group); err != nil {
+			return 0, nil, err
+		}
+		created, err := a.createSCIMGroup(ctx, &group)
+		return http.StatusCreated, created, err
+	case req.Method == http.MethodPut && id != "":
+		var group scimGroup
+		if err := decodeSCIMRequest(req, &group); err != nil {
+			return 0, nil, err
+		}
+		if group.DisplayName != "" && group.DisplayName != id {
+			return 0, nil, newSCIMError(http.StatusBadRequest, "mutability", "groups cannot be renamed")
+		}
+		replaced, err := a.updateSCIMGroup(ctx, id, func(map[string]bool) (map[string]bool, error) {
+			return addToSet(nil, scimMemberValues(group.Members)...), nil
+		})
+		return http.StatusOK, replaced, err
+	case req.Method == http.MethodPatch && id != "":
+		var patch scimPatchRequest
+		if err := decodeSCIMRequest(req, &patch); err != nil {
+			return 0, nil, err
+		}
+		patched, err := a.updateSCIMGroup(ctx, id, func(members map[string]bool) (map[string]bool, error) {
+			return applySCIMGroupPatch(id, members, &patch)
+		})
+		return http.StatusOK, patched, err
+	case req.Method == http.MethodDelete && id != "":
+		return http.StatusNoContent, nil, a.deleteSCIMGroup(ctx, id)
+	}
+	return 0, nil, newSCIMError(http.StatusMethodNotAllowed, "", "%s is not supported on %q", req.Method, req.URL.Path)
+}
+
+func newSCIMGroup(id string, users *auth.Users) *scimGroup {
+	group := &scimGroup{
+		Schemas:     []string{scimGroupSchema},
+		ID:          id,
+		DisplayName: id,
+		Members:     []scimMember{},
+		Meta:        &scimMeta{ResourceType: "Group"},
+	}
+	for _, subject := range sortedSet(users.Usernames) {
+		if strings.HasPrefix(subject, auth.UserPrefix) {
+			name := strings.TrimPrefix(subject, auth.UserPrefix)
+			group.Members = append(group.Members, scimMember{Value: name, Display: name})
+		}
+	}
+	return group
+}
+
+func (a *apiServer) getSCIMGroup(ctx context.Context, id string) (*scimGroup, error) {
+	var users auth.Users
+	if err := a.groups.ReadOnly(ctx).Get(auth.GroupPrefix+id, &users); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+		}
+		return nil, err
+	}
+	return newSCIMGroup(id, &users), nil
+}
+
+func (a *apiServer) listSCIMGroups(ctx context.Context, filter string) ([]interface{}, error) {
+	if filter != "" {
+		attr, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		if attr != "displayname" && attr != "id" {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "groups can only be filtered by displayName or id")
+		}
+		group, err := a.getSCIMGroup(ctx, value)
+		if err != nil {
+			var scimErr *scimError
+			if errors.As(err, &scimErr) && scimErr.status == http.StatusNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []interface{}{group}, nil
+	}
+
+	var groups []interface{}
+	users := &auth.Users{}
+	if err := a.groups.ReadOnly(ctx).List(users, col.DefaultOptions(), func(name string) error {
+		if strings.HasPrefix(name, auth.GroupPrefix) {
+			groups = append(groups, newSCIMGroup(strings.TrimPrefix(name, auth.GroupPrefix), users))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].(*scimGroup).ID < groups[j].(*scimGroup).ID
+	})
+	return groups, nil
+}
+
+func (a *apiServer) createSCIMGroup(ctx context.Context, group *scimGroup) (*scimGroup, error) {
+	if group.DisplayName == "" {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "displayName must be set")
+	}
+	name := auth.GroupPrefix + group.DisplayName
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		if err := a.groups.ReadWrite(sqlTx).Create(name, &auth.Users{}); err != nil {
+			if col.IsErrExists(err) {
+				return newSCIMError(http.StatusConflict, "uniqueness", "group %q already exists", group.DisplayName)
+			}
+			return err
+		}
+		return a.modifyMembersInTransaction(sqlTx, name, scimMemberValues(group.Members), nil)
+	}); err != nil {
+		return nil, err
+	}
+	return a.getSCIMGroup(ctx, group.DisplayName)
+}
+
+// updateSCIMGroup sets the user members of a group to the result of f, which
+// is passed the group's current user members. Members that aren't users, such
+// as robots, aren't managed by SCIM and are left alone.
+func (a *apiServer) updateSCIMGroup(ctx context.Context, id string, f func(map[string]bool) (map[string]bool, error)) (*scimGroup, error) {
+	name := auth.GroupPrefix + id
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		var users auth.Users
+		if err := a.groups.ReadWrite(sqlTx).Get(name, &users); err != nil {
+			if col.IsErrNotFound(err) {
+				return newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+			}
+			return err
+		}
+		current := make(map[string]bool)
+		for subject := range users.Usernames {
+			if strings.HasPrefix(subject, auth.UserPrefix) {
+				current[subject] = true
+			}
+		}
+		updated, err := f(addToSet(nil, setToList(current)...))
+		if err != nil {
+			return err
+		}
+		var add, remove []string
+		for subject := range updated {
+			if !current[subject] {
+				add = append(add, subject)
+			}
+		}
+		for subject := range current {
+			if !updated[subject] {
+				remove = append(remove, subject)
+			}
+		}
+		return a.modifyMembersInTransaction(sqlTx, name, add, remove)
+	}); err != nil {
+		return nil, err
+	}
+	return a.getSCIMGroup(ctx, id)
+}
+
+func (a *apiServer) deleteSCIMGroup(ctx context.Context, id string) error {
+	name := auth.GroupPrefix + id
+	return dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		groups := a.groups.ReadWrite(sqlTx)
+		var users auth.Users
+		if err := groups.Get(name, &users); err != nil {
+			if col.IsErrNotFound(err) {
+				return newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+			}
+			return err
+		}
+		if err := a.modifyMembersInTransaction(sqlTx, name, nil, setToList(users.Usernames)); err != nil {
+			return err
+		}
+		return groups.Delete(name)
+	})
+}
+
+// applySCIMGroupPatch applies the operations in a patch to the set of a
+// group's user members
+func applySCIMGroupPatch(id string, members map[string]bool, patch *scimPatchRequest) (map[string]bool, error) {
+	for _, op := range patch.Operations {
+		var add, remove []string
+		// setsMembers is true if the operation's value is the group's members,
+		// as opposed to a single member or another attribute
+		setsMembers, removeAll := false, false
+		path := strings.TrimSpace(op.Path)
+		switch {
+		case path == "":
+			// The value is a partial group resource
+			var group scimGroup
+			if err := json.Unmarshal(op.Value, &group); err != nil {
+				return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "patch value must be a group if no path is set")
+			}
+			if group.DisplayName != "" && group.DisplayName != id {
+				return nil, newSCIMError(http.StatusBadRequest, "mutability", "groups cannot be renamed")
+			}
+			add = scimMemberValues(group.Members)
+			setsMembers = group.Members != nil
+		case strings.EqualFold(path, "displayName"):
+			var displayName string
+			if err := json.Unmarshal(op.Value, &displayName); err != nil || displayName != id {
+				return nil, newSCIMError(http.StatusBadRequest, "mutability", "groups cannot be renamed")
+			}
+			continue
+		case strings.EqualFold(path, "members"):
+			if len(op.Value) > 0 {
+				var values []scimMember
+				if err := json.Unmarshal(op.Value, &values); err != nil {
+					return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "members must be a list of members")
+				}
+				add = scimMemberValues(values)
+			}
+			setsMembers = true
+		default:
+			match := scimMemberPathRegex.FindStringSubmatch(path)
+			if match == nil {
+				return nil, newSCIMError(http.StatusBadRequest, "invalidPath", "unsupported patch path %q", op.Path)
+			}
+			value, err := strconv.Unquote(`"` + match[1] + `"`)
+			if err != nil {
+				return nil, newSCIMError(http.StatusBadRequest, "invalidPath", "unsupported patch path %q", op.Path)
+			}
+			add = []string{auth.UserPrefix + value}
+		}
+
+		switch strings.ToLower(op.Op) {
+		case "add":
+		case "replace":
+			removeAll = setsMembers
+		case "remove":
+			// Removing "members" without a value removes all of them
+			removeAll = setsMembers && len(op.Value) == 0
+			add, remove = nil, add
+		default:
+			return nil, newSCIMError(http.StatusBadRequest, "invalidSyntax", "unknown patch operation %q", op.Op)
+		}
+		if removeAll {
+			members = make(map[string]bool)
+		}
+		members = removeFromSet(members, remove...)
+		members = addToSet(members, add...)
+	}
+	return members, nil
+}
+
+// scimMemberValues returns the subjects of a list of group members
+func scimMemberValues(members []scimMember) []string {
+	subjects := make([]string, 0, len(members))
+	for _, m := range members {
+		subjects = append(subjects, auth.UserPrefix+m.Value)
+	}
+	return subjects
+}
+
+// parseSCIMFilter parses a filter of the form `attr eq "value"`, returning the
+// attribute (lowercased, as attribute names are case-insensitive) and value
+func parseSCIMFilter(filter string) (string, string, error) {
+	match := scimFilterRegex.FindStringSubmatch(filter)
+	if match == nil {
+		return "", "", newSCIMError(http.StatusBadRequest, "invalidFilter", "unsupported filter %q, only equality filters are supported", filter)
+	}
+	value, err := strconv.Unquote(`"` + match[2] + `"`)
+	if err != nil {
+		return "", "", newSCIMError(http.StatusBadRequest, "invalidFilter", "invalid filter value in %q", filter)
+	}
+	return strings.ToLower(match[1]), value, nil
+}
+
+// newSCIMListResponse pages through a list of resources using the request's
+// startIndex and count parameters
+func newSCIMListResponse(resources []interface{}, req *http.Request) (*scimListResponse, error) {
+	startIndex, count := 1, len(resources)
+	if s := req.URL.Query().Get("startIndex"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "invalid startIndex %q", s)
+		}
+		if i > 1 {
+			startIndex = i
+		}
+	}
+	if s := req.URL.Query().Get("count"); s != "" {
+		c, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "invalid count %q", s)
+		}
+		if c < count {
+			count = c
+		}
+		if count < 0 {
+			count = 0
+		}
+	}
+	page := []interface{}{}
+	if startIndex <= len(resources) {
+		end := startIndex - 1 + count
+		if end > len(resources) {
+			end = len(resources)
+		}
+		page = resources[startIndex-1 : end]
+	}
+	return &scimListResponse{
+		Schemas:      []string{scimListResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}, nil
+}
+
+func decodeSCIMRequest(req *http.Request, v interface{}) error {
+	if err := json.NewDecoder(req.Body).Decode(v); err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidSyntax", "could not parse request body: %v", err)
+	}
+	return nil
+}
+
+func scimServiceProviderConfig() map[string]interface{} {
+	supported := func(b bool) map[string]interface{} {
+		return map[string]interface{}{"supported": b}
+	}
+	return map[string]interface{}{
+		"schemas":        []string{scimServiceProviderConfigSchema},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": 0},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "Pachyderm token",
+			"description": "A Pachyderm auth token, sent as a bearer token",
+			"primary":     true,
+		}},
+		"meta": scimMeta{ResourceType: "ServiceProviderConfig"},
+	}
+}
+
+func sortedSet(set map[string]bool) []string {
+	list := setToList(set)
+	sort.Strings(list)
+	return list
+}
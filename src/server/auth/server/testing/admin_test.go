@@ -769,6 +769,61 @@ func TestRobotUserACL(t *testing.T) {
 	require.NoError(t, robotClient.FinishCommit(repo2, commit.Branch.Name, commit.ID))
 }
 
+// TestScopedRobotToken tests that a scoped robot token can only use the
+// permissions in its scope, even if the robot has other permissions.
+func TestScopedRobotToken(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	rootClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+
+	// root creates two repos, and makes a robot a writer on both
+	robotUser := tu.UniqueString("ci")
+	repo, otherRepo := tu.UniqueString("TestScopedRobotToken"), tu.UniqueString("TestScopedRobotToken")
+	for _, r := range []string{repo, otherRepo} {
+		require.NoError(t, rootClient.CreateRepo(r))
+		require.NoError(t, rootClient.ModifyRepoRoleBinding(r, robot(robotUser), []string{auth.RepoWriterRole}))
+	}
+
+	// Generate a token for the robot that can only write to the first repo
+	scopes, err := auth.ParseTokenScopes([]string{fmt.Sprintf("repo:%s:read,write", repo)})
+	require.NoError(t, err)
+	resp, err := rootClient.GetRobotToken(rootClient.Ctx(),
+		&auth.GetRobotTokenRequest{Robot: robotUser, Scopes: scopes})
+	require.NoError(t, err)
+	robotClient := rootClient.WithCtx(context.Background())
+	robotClient.SetAuthToken(resp.Token)
+
+	who, err := robotClient.WhoAmI(robotClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+	require.Equal(t, robot(robotUser), who.Username)
+	require.Equal(t, scopes, who.Scopes)
+
+	// The robot can commit to the repo in its scope
+	commit, err := robotClient.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, robotClient.FinishCommit(repo, commit.Branch.Name, commit.ID))
+	permissions, err := robotClient.GetPermissions(robotClient.Ctx(), &auth.GetPermissionsRequest{
+		Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: repo},
+	})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []auth.Permission{auth.Permission_REPO_READ, auth.Permission_REPO_WRITE}, permissions.Permissions)
+
+	// But not to the other repo, even though the robot is a writer on it
+	_, err = robotClient.StartCommit(otherRepo, "master")
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	// Invalid scopes are rejected
+	_, err = rootClient.GetRobotToken(rootClient.Ctx(), &auth.GetRobotTokenRequest{
+		Robot:  robotUser,
+		Scopes: []*auth.TokenScope{{Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: repo}}},
+	})
+	require.YesError(t, err)
+}
+
 // TestGroupRoleBinding tests that a group can be added to a role binding
 // and confers access to members
 func TestGroupRoleBinding(t *testing.T) {
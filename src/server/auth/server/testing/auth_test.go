@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"context"
 	"crypto/sha256"
 	"fmt"
 	"io"
@@ -23,6 +24,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/clientsdk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 	"github.com/pachyderm/pachyderm/v2/src/license"
@@ -2876,3 +2878,155 @@ func TestLoad(t *testing.T) {
 	require.NoError(t, cmdutil.Encoder("", buf).EncodeProto(resp))
 	require.Equal(t, "", resp.Error, buf.String())
 }
+
+// watchEventRepo returns the repo that a Watch event is about.
+func watchEventRepo(event *pps.WatchEvent) *pfs.Repo {
+	switch {
+	case event.RepoInfo != nil:
+		return event.RepoInfo.Repo
+	case event.BranchInfo != nil:
+		return event.BranchInfo.Branch.Repo
+	case event.CommitInfo != nil:
+		return event.CommitInfo.Commit.Branch.Repo
+	case event.JobInfo != nil:
+		return client.NewRepo(event.JobInfo.Job.Pipeline.Name)
+	default:
+		return client.NewRepo(event.PipelineInfo.Pipeline.Name)
+	}
+}
+
+// watchCursor returns a cursor that watching from sends the events of
+// everything done after watchCursor returns.
+func watchCursor(t *testing.T, c *client.APIClient) string {
+	t.Helper()
+	repo := tu.UniqueString("watch-cursor")
+	require.NoError(t, c.CreateRepo(repo))
+	cursors := make(chan string, 1)
+	ctx, cancel := context.WithCancel(c.Ctx())
+	defer cancel()
+	go c.WithCtx(ctx).WatchEvents("", []*pfs.Repo{client.NewRepo(repo)}, nil, func(event *pps.WatchEvent) error {
+		cursors <- event.Cursor
+		return errutil.ErrBreak
+	})
+	// Watching from the head doesn't send anything until the watch has
+	// started, so keep writing to the repo until an event is sent.
+	var cursor string
+	require.NoError(t, backoff.Retry(func() error {
+		if err := c.PutFile(client.NewCommit(repo, "master", ""), "/file", strings.NewReader("foo")); err != nil {
+			return err
+		}
+		select {
+		case cursor = <-cursors:
+			return nil
+		case <-time.After(time.Second):
+			return errors.New("watch hasn't started yet")
+		}
+	}, backoff.NewTestingBackOff()))
+	return cursor
+}
+
+// TestWatch tests that Watch only sends the events of the requested repos and
+// pipelines, and only those that the caller can read, including the deletion
+// of repos that the caller could read.
+func TestWatch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	// Only the events of the requested repo and pipeline (including the
+	// pipeline's spec and meta repos) are sent.
+	cursor := watchCursor(t, aliceClient)
+	repo, other := tu.UniqueString("watch"), tu.UniqueString("other")
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	require.NoError(t, aliceClient.CreateRepo(other))
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, aliceClient.CreatePipeline(
+		pipeline,
+		"", // default image: DefaultUserImage
+		[]string{"bash"},
+		[]string{"cp /pfs/*/* /pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/*"),
+		"", // default output branch: master
+		false,
+	))
+	require.NoError(t, aliceClient.PutFile(client.NewCommit(other, "master", ""), "/file", strings.NewReader("foo")))
+	require.NoError(t, aliceClient.CreateBranch(repo, "done", "", "", nil))
+	var sawPipeline, sawSpecRepo bool
+	require.NoError(t, aliceClient.WithCtx(ctx).WatchEvents(cursor, []*pfs.Repo{client.NewRepo(repo)}, []*pps.Pipeline{client.NewPipeline(pipeline)}, func(event *pps.WatchEvent) error {
+		r := watchEventRepo(event)
+		require.True(t, r.Name == repo || r.Name == pipeline, "unexpected event for repo %v", r)
+		if event.PipelineInfo != nil {
+			require.Equal(t, "", event.PipelineInfo.AuthToken)
+			sawPipeline = true
+		}
+		if r.Type == pfs.SpecRepoType {
+			sawSpecRepo = true
+		}
+		if event.BranchInfo != nil && event.BranchInfo.Branch.Name == "done" {
+			return errutil.ErrBreak
+		}
+		return nil
+	}))
+	require.True(t, sawPipeline)
+	require.True(t, sawSpecRepo)
+
+	// Watching a repo that the caller can't read fails.
+	err := bobClient.WithCtx(ctx).WatchEvents("", []*pfs.Repo{client.NewRepo(other)}, nil, func(*pps.WatchEvent) error {
+		return errutil.ErrBreak
+	})
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	// Without filters, only the events of repos that the caller can read are
+	// sent. The deletion of a repo that the caller could read is sent, even
+	// though the repo's role binding has been deleted with it.
+	cursor = watchCursor(t, aliceClient)
+	events := make(chan *pps.WatchEvent, 100)
+	go bobClient.WithCtx(ctx).WatchEvents(cursor, nil, nil, func(event *pps.WatchEvent) error {
+		events <- event
+		return nil
+	})
+	next := func() *pps.WatchEvent {
+		select {
+		case event := <-events:
+			require.NotEqual(t, other, watchEventRepo(event).Name)
+			return event
+		case <-time.After(time.Minute):
+			t.Fatal("timed out waiting for watch event")
+			return nil
+		}
+	}
+	readable := tu.UniqueString("readable")
+	require.NoError(t, aliceClient.CreateRepo(readable))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(readable, bob, []string{auth.RepoReaderRole}))
+	require.NoError(t, aliceClient.PutFile(client.NewCommit(other, "master", ""), "/file", strings.NewReader("bar")))
+	require.NoError(t, aliceClient.PutFile(client.NewCommit(readable, "master", ""), "/file", strings.NewReader("foo")))
+	for {
+		if event := next(); event.CommitInfo != nil && watchEventRepo(event).Name == readable {
+			break
+		}
+	}
+	require.NoError(t, aliceClient.DeleteRepo(readable, false))
+	done := tu.UniqueString("done")
+	require.NoError(t, aliceClient.CreateRepo(done))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(done, bob, []string{auth.RepoReaderRole}))
+	require.NoError(t, aliceClient.PutFile(client.NewCommit(done, "master", ""), "/file", strings.NewReader("foo")))
+	var sawDelete bool
+	for {
+		event := next()
+		if event.RepoInfo != nil && event.RepoInfo.Repo.Name == readable && event.Operation == pps.WatchOperation_WATCH_DELETE {
+			sawDelete = true
+		}
+		if event.CommitInfo != nil && watchEventRepo(event).Name == done {
+			break
+		}
+	}
+	require.True(t, sawDelete)
+}
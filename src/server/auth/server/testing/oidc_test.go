@@ -42,6 +42,42 @@ func TestOIDCAuthCodeFlow(t *testing.T) {
 	tu.DeleteAll(t)
 }
 
+// TestOIDCDeviceFlow tests that we can log in with the device authorization
+// grant, approving the login separately from the client that polls for it
+func TestOIDCDeviceFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	tu.ConfigureOIDCProvider(t)
+	defer tu.DeleteAll(t)
+
+	testClient := tu.GetUnauthenticatedPachClient(t)
+	loginInfo, err := testClient.GetOIDCDeviceLogin(testClient.Ctx(), &auth.GetOIDCDeviceLoginRequest{})
+	require.NoError(t, err)
+	require.NotEqual(t, "", loginInfo.UserCode)
+	require.NotEqual(t, "", loginInfo.VerificationURL)
+
+	// The login is pending until it's approved
+	_, err = testClient.Authenticate(testClient.Ctx(),
+		&auth.AuthenticateRequest{DeviceCode: loginInfo.DeviceCode})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrAuthorizationPending(err) || auth.IsErrSlowDown(err))
+
+	tu.DoDeviceLogin(t, testClient, loginInfo.UserCode)
+	authResp, err := testClient.Authenticate(testClient.Ctx(),
+		&auth.AuthenticateRequest{DeviceCode: loginInfo.DeviceCode})
+	require.NoError(t, err)
+	testClient.SetAuthToken(authResp.PachToken)
+
+	// Check that testClient authenticated as the right user
+	whoAmIResp, err := testClient.WhoAmI(testClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+	require.Equal(t, user(tu.DexMockConnectorEmail), whoAmIResp.Username)
+
+	tu.DeleteAll(t)
+}
+
 // TestOIDCTrustedApp tests using an ID token issued to another OIDC app to authenticate.
 func TestOIDCTrustedApp(t *testing.T) {
 	if testing.Short() {
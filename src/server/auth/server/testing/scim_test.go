@@ -0,0 +1,189 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+)
+
+// scimRequest sends a request to pachd's SCIM endpoint, decoding the response
+// into resp if it's non-nil, and returns the response's status code
+func scimRequest(t *testing.T, c *client.APIClient, token, method, path string, body, resp interface{}) int {
+	t.Helper()
+	var reqBody bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&reqBody).Encode(body))
+	}
+	req, err := http.NewRequest(method, fmt.Sprintf("http://%s/scim/v2/%s", tu.PachHost(c), path), &reqBody)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/scim+json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	httpResp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer httpResp.Body.Close()
+	if resp != nil {
+		require.NoError(t, json.NewDecoder(httpResp.Body).Decode(resp))
+	}
+	return httpResp.StatusCode
+}
+
+type scimTestUser struct {
+	ID     string `json:"id"`
+	Active bool   `json:"active"`
+	Groups []struct {
+		Value string `json:"value"`
+	} `json:"groups"`
+}
+
+type scimTestList struct {
+	TotalResults int               `json:"totalResults"`
+	Resources    []json.RawMessage `json:"Resources"`
+}
+
+// TestSCIMProvisioning tests that users and groups can be provisioned over
+// SCIM, and that deactivating a user revokes its tokens
+func TestSCIMProvisioning(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+
+	rootClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+	rootToken := rootClient.AuthToken()
+	alice, bob := tu.UniqueString("alice"), tu.UniqueString("bob")
+
+	// Requests must be authenticated, by a user that can modify group membership
+	require.Equal(t, http.StatusUnauthorized, scimRequest(t, rootClient, "", http.MethodGet, "Users", nil, nil))
+	bobClient := tu.GetAuthenticatedPachClient(t, robot(bob))
+	require.Equal(t, http.StatusForbidden, scimRequest(t, rootClient, bobClient.AuthToken(), http.MethodGet, "Users", nil, nil))
+
+	// Provision alice and bob, and a group containing both of them
+	for _, u := range []string{alice, bob} {
+		var created scimTestUser
+		require.Equal(t, http.StatusCreated, scimRequest(t, rootClient, rootToken, http.MethodPost, "Users",
+			map[string]interface{}{"userName": u, "active": true}, &created))
+		require.Equal(t, u, created.ID)
+	}
+	require.Equal(t, http.StatusConflict, scimRequest(t, rootClient, rootToken, http.MethodPost, "Users",
+		map[string]interface{}{"userName": alice}, nil))
+	require.Equal(t, http.StatusCreated, scimRequest(t, rootClient, rootToken, http.MethodPost, "Groups",
+		map[string]interface{}{
+			"displayName": "eng",
+			"members":     []map[string]string{{"value": alice}, {"value": bob}},
+		}, nil))
+	users, err := rootClient.GetUsers(rootClient.Ctx(), &auth.GetUsersRequest{Group: group("eng")})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{user(alice), user(bob)}, users.Usernames)
+
+	// Look up alice with a filter, as IdPs do before provisioning
+	var list scimTestList
+	require.Equal(t, http.StatusOK, scimRequest(t, rootClient, rootToken, http.MethodGet,
+		fmt.Sprintf("Users?filter=userName%%20eq%%20%%22%s%%22", alice), nil, &list))
+	require.Equal(t, 1, list.TotalResults)
+	var aliceResource scimTestUser
+	require.NoError(t, json.Unmarshal(list.Resources[0], &aliceResource))
+	require.Equal(t, alice, aliceResource.ID)
+	require.Equal(t, 1, len(aliceResource.Groups))
+	require.Equal(t, "eng", aliceResource.Groups[0].Value)
+
+	// Removing bob from the group takes effect immediately
+	require.Equal(t, http.StatusOK, scimRequest(t, rootClient, rootToken, http.MethodPatch, "Groups/eng",
+		map[string]interface{}{
+			"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+			"Operations": []map[string]string{{"op": "remove", "path": fmt.Sprintf("members[value eq %q]", bob)}},
+		}, nil))
+	users, err = rootClient.GetUsers(rootClient.Ctx(), &auth.GetUsersRequest{Group: group("eng")})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{user(alice)}, users.Usernames)
+
+	// Give alice a token, then deactivate her, which revokes it
+	_, err = rootClient.RestoreAuthToken(rootClient.Ctx(), &auth.RestoreAuthTokenRequest{
+		Token: &auth.TokenInfo{
+			HashedToken: fmt.Sprintf("%x", sha256.Sum256([]byte("alice-scim-token"))),
+			Subject:     user(alice),
+		},
+	})
+	require.NoError(t, err)
+	aliceClient := tu.GetUnauthenticatedPachClient(t)
+	aliceClient.SetAuthToken("alice-scim-token")
+	whoAmI, err := aliceClient.WhoAmI(aliceClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+	require.Equal(t, user(alice), whoAmI.Username)
+
+	var deactivated scimTestUser
+	require.Equal(t, http.StatusOK, scimRequest(t, rootClient, rootToken, http.MethodPatch, "Users/"+alice,
+		map[string]interface{}{
+			"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+			"Operations": []map[string]interface{}{{"op": "replace", "path": "active", "value": false}},
+		}, &deactivated))
+	require.False(t, deactivated.Active)
+	_, err = aliceClient.WhoAmI(aliceClient.Ctx(), &auth.WhoAmIRequest{})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrBadToken(err), err.Error())
+	users, err = rootClient.GetUsers(rootClient.Ctx(), &auth.GetUsersRequest{Group: group("eng")})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(users.Usernames))
+
+	// Deactivating is idempotent, and alice can be reactivated, with PATCH or
+	// PUT, after being deactivated
+	setActive := func(method string, active bool) {
+		var body interface{} = map[string]interface{}{"userName": alice, "active": active}
+		if method == http.MethodPatch {
+			body = map[string]interface{}{
+				"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+				"Operations": []map[string]interface{}{{"op": "replace", "path": "active", "value": active}},
+			}
+		}
+		var resource scimTestUser
+		require.Equal(t, http.StatusOK, scimRequest(t, rootClient, rootToken, method, "Users/"+alice, body, &resource))
+		require.Equal(t, alice, resource.ID)
+		require.Equal(t, active, resource.Active)
+	}
+	setActive(http.MethodPatch, false)
+	require.Equal(t, http.StatusNotFound, scimRequest(t, rootClient, rootToken, http.MethodGet, "Users/"+alice, nil, nil))
+	setActive(http.MethodPatch, true)
+	var reactivated scimTestUser
+	require.Equal(t, http.StatusOK, scimRequest(t, rootClient, rootToken, http.MethodGet, "Users/"+alice, nil, &reactivated))
+	require.True(t, reactivated.Active)
+	require.Equal(t, 0, len(reactivated.Groups))
+	setActive(http.MethodPut, true)
+	setActive(http.MethodPut, false)
+	setActive(http.MethodPut, false)
+	setActive(http.MethodPut, true)
+	setActive(http.MethodPatch, false)
+
+	// Deleting the group removes it from its members
+	require.Equal(t, http.StatusNoContent, scimRequest(t, rootClient, rootToken, http.MethodDelete, "Groups/eng", nil, nil))
+	require.Equal(t, http.StatusNotFound, scimRequest(t, rootClient, rootToken, http.MethodGet, "Groups/eng", nil, nil))
+	groups, err := rootClient.GetGroupsForPrincipal(rootClient.Ctx(), &auth.GetGroupsForPrincipalRequest{Principal: user(bob)})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(groups.Groups))
+
+	// Deleting a user that isn't provisioned, such as one that was deactivated
+	// and has since logged in again, still revokes its tokens
+	_, err = rootClient.RestoreAuthToken(rootClient.Ctx(), &auth.RestoreAuthTokenRequest{
+		Token: &auth.TokenInfo{
+			HashedToken: fmt.Sprintf("%x", sha256.Sum256([]byte("alice-scim-token-2"))),
+			Subject:     user(alice),
+		},
+	})
+	require.NoError(t, err)
+	aliceClient.SetAuthToken("alice-scim-token-2")
+	_, err = aliceClient.WhoAmI(aliceClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, scimRequest(t, rootClient, rootToken, http.MethodDelete, "Users/"+alice, nil, nil))
+	_, err = aliceClient.WhoAmI(aliceClient.Ctx(), &auth.WhoAmIRequest{})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrBadToken(err), err.Error())
+}
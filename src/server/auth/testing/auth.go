@@ -125,6 +125,11 @@ func (a *InactiveAPIServer) GetOIDCLogin(context.Context, *auth.GetOIDCLoginRequ
 	return nil, auth.ErrNotActivated
 }
 
+// GetOIDCDeviceLogin implements the GetOIDCDeviceLogin RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetOIDCDeviceLogin(context.Context, *auth.GetOIDCDeviceLoginRequest) (*auth.GetOIDCDeviceLoginResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // RevokeAuthToken implements the RevokeAuthToken RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) RevokeAuthToken(context.Context, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -328,10 +328,12 @@ func PachctlCmd() *cobra.Command {
 
 Environment variables:
   PACH_CONFIG=<path>, the path where pachctl will attempt to load your config.
+  OTEL_EXPORTER_OTLP_ENDPOINT=<url>, the OTLP/HTTP collector to export traces
+    to, if PACH_TRACE is set (e.g. http://localhost:4318).
   JAEGER_ENDPOINT=<host>:<port>, the Jaeger server to connect to, if PACH_TRACE
-    is set
-  PACH_TRACE={true,false}, if true, and JAEGER_ENDPOINT is set, attach a Jaeger
-    trace to any outgoing RPCs.
+    is set and OTEL_EXPORTER_OTLP_ENDPOINT is not.
+  PACH_TRACE={true,false}, if true, and OTEL_EXPORTER_OTLP_ENDPOINT or
+    JAEGER_ENDPOINT is set, attach a trace to any outgoing RPCs.
   PACH_TRACE_DURATION=<duration>, the amount of time for which PPS should trace
     a pipeline after 'pachctl create-pipeline' (PACH_TRACE must also be set).
 `,
@@ -760,6 +762,18 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(subscribeDocs, "subscribe"))
 
+	protectDocs := &cobra.Command{
+		Short: "Protect a Pachyderm resource from changes.",
+		Long:  "Protect a Pachyderm resource from changes.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(protectDocs, "protect"))
+
+	setDocs := &cobra.Command{
+		Short: "Set a property of a Pachyderm resource.",
+		Long:  "Set a property of a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(setDocs, "set"))
+
 	putDocs := &cobra.Command{
 		Short: "Insert data into Pachyderm.",
 		Long:  "Insert data into Pachyderm.",
@@ -814,6 +828,18 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(runDocs, "run"))
 
+	promoteDocs := &cobra.Command{
+		Short: "Promote a candidate version of a Pachyderm resource.",
+		Long:  "Promote a candidate version of a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(promoteDocs, "promote"))
+
+	rollbackDocs := &cobra.Command{
+		Short: "Roll a Pachyderm resource back to a previous version.",
+		Long:  "Roll a Pachyderm resource back to a previous version.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(rollbackDocs, "rollback"))
+
 	editDocs := &cobra.Command{
 		Short: "Edit the value of an existing Pachyderm resource.",
 		Long:  "Edit the value of an existing Pachyderm resource.",
@@ -877,8 +903,12 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"glob",
 			"inspect",
 			"list",
+			"promote",
+			"protect",
 			"put",
 			"restart",
+			"rollback",
+			"set",
 			"squash",
 			"start",
 			"stop",
@@ -14,7 +14,7 @@ func main() {
 	// Remove kubernetes client flags from the spf13 flag set
 	// (we link the kubernetes client, so otherwise they're in 'pachctl --help')
 	pflag.CommandLine = pflag.NewFlagSet(os.Args[0], pflag.ExitOnError)
-	tracing.InstallJaegerTracerFromEnv()
+	tracing.InstallTracerFromEnv()
 	err := func() error {
 		defer tracing.CloseAndReportTraces()
 		return cmd.PachctlCmd().Execute()
@@ -62,11 +62,13 @@ import (
 )
 
 var mode string
+var storageFailover bool
 var readiness bool
 
 func init() {
 	flag.StringVar(&mode, "mode", "full", "Pachd currently supports three modes: full, enterprise and sidecar. full includes everything you need in a full pachd node. Enterprise runs the Enterprise Server. Sidecar runs only PFS, the Auth service, and a stripped-down version of PPS.")
 	flag.BoolVar(&readiness, "readiness", false, "Run readiness check.")
+	flag.BoolVar(&storageFailover, "storage-failover", false, "Serve chunks from the storage replica rather than the primary object storage bucket.")
 	flag.Parse()
 }
 
@@ -111,13 +113,13 @@ func doEnterpriseMode(config interface{}) (retErr error) {
 		log.Errorf("Unrecognized log level %s, falling back to default of \"info\"", logLevel)
 		log.SetLevel(log.InfoLevel)
 	}
-	// must run InstallJaegerTracer before InitWithKube (otherwise InitWithKube
-	// may create a pach client before tracing is active, not install the Jaeger
+	// must run InstallTracerFromEnv before InitWithKube (otherwise InitWithKube
+	// may create a pach client before tracing is active, not install the tracing
 	// gRPC interceptor in the client, and not propagate traces)
-	if endpoint := tracing.InstallJaegerTracerFromEnv(); endpoint != "" {
-		log.Printf("connecting to Jaeger at %q", endpoint)
+	if endpoint := tracing.InstallTracerFromEnv(); endpoint != "" {
+		log.Printf("reporting traces to %q", endpoint)
 	} else {
-		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
+		log.Printf("no trace collector found (neither OTEL_EXPORTER_OTLP_ENDPOINT nor JAEGER_COLLECTOR_SERVICE_HOST is set)")
 	}
 	env := serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
 	profileutil.StartCloudProfiler("pachyderm-pachd-enterprise", env.Config())
@@ -378,13 +380,13 @@ func doSidecarMode(config interface{}) (retErr error) {
 		log.Errorf("Unrecognized log level %s, falling back to default of \"info\"", logLevel)
 		log.SetLevel(log.InfoLevel)
 	}
-	// must run InstallJaegerTracer before InitWithKube (otherwise InitWithKube
-	// may create a pach client before tracing is active, not install the Jaeger
+	// must run InstallTracerFromEnv before InitWithKube (otherwise InitWithKube
+	// may create a pach client before tracing is active, not install the tracing
 	// gRPC interceptor in the client, and not propagate traces)
-	if endpoint := tracing.InstallJaegerTracerFromEnv(); endpoint != "" {
-		log.Printf("connecting to Jaeger at %q", endpoint)
+	if endpoint := tracing.InstallTracerFromEnv(); endpoint != "" {
+		log.Printf("reporting traces to %q", endpoint)
 	} else {
-		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
+		log.Printf("no trace collector found (neither OTEL_EXPORTER_OTLP_ENDPOINT nor JAEGER_COLLECTOR_SERVICE_HOST is set)")
 	}
 	env := serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
 	profileutil.StartCloudProfiler("pachyderm-pachd-sidecar", env.Config())
@@ -392,6 +394,9 @@ func doSidecarMode(config interface{}) (retErr error) {
 	if env.Config().EtcdPrefix == "" {
 		env.Config().EtcdPrefix = col.DefaultPrefix
 	}
+	if storageFailover {
+		env.Config().StorageFailover = true
+	}
 	authInterceptor := authmw.NewInterceptor(env.AuthServer)
 	server, err := grpcutil.NewServer(
 		context.Background(),
@@ -530,11 +535,11 @@ func doFullMode(config interface{}) (retErr error) {
 		log.SetLevel(log.InfoLevel)
 	}
 
-	// must run InstallJaegerTracer before InitWithKube/pach client initialization
-	if endpoint := tracing.InstallJaegerTracerFromEnv(); endpoint != "" {
-		log.Printf("connecting to Jaeger at %q", endpoint)
+	// must run InstallTracerFromEnv before InitWithKube/pach client initialization
+	if endpoint := tracing.InstallTracerFromEnv(); endpoint != "" {
+		log.Printf("reporting traces to %q", endpoint)
 	} else {
-		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
+		log.Printf("no trace collector found (neither OTEL_EXPORTER_OTLP_ENDPOINT nor JAEGER_COLLECTOR_SERVICE_HOST is set)")
 	}
 	env := serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
 	profileutil.StartCloudProfiler("pachyderm-pachd-full", env.Config())
@@ -542,6 +547,9 @@ func doFullMode(config interface{}) (retErr error) {
 	if env.Config().EtcdPrefix == "" {
 		env.Config().EtcdPrefix = col.DefaultPrefix
 	}
+	if storageFailover {
+		env.Config().StorageFailover = true
+	}
 
 	// TODO: currently all pachds attempt to apply migrations, we should coordinate this
 	if err := dbutil.WaitUntilReady(context.Background(), log.StandardLogger(), env.GetDBClient()); err != nil {
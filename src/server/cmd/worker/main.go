@@ -17,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	debugserver "github.com/pachyderm/pachyderm/v2/src/server/debug/server"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
 	"github.com/pachyderm/pachyderm/v2/src/version"
 	"github.com/pachyderm/pachyderm/v2/src/version/versionpb"
@@ -26,6 +27,9 @@ import (
 )
 
 func main() {
+	// The worker re-executes itself to run sandboxed user code
+	driver.ExecSandbox()
+
 	log.SetFormatter(logutil.FormatterFunc(logutil.Pretty))
 
 	// append pachyderm bins to path to allow use of pachctl
@@ -35,8 +39,8 @@ func main() {
 }
 
 func do(config interface{}) error {
-	// must run InstallJaegerTracer before InitWithKube/pach client initialization
-	tracing.InstallJaegerTracerFromEnv()
+	// must run InstallTracerFromEnv before InitWithKube/pach client initialization
+	tracing.InstallTracerFromEnv()
 	env := serviceenv.InitServiceEnv(serviceenv.NewConfiguration(config))
 
 	// Enable cloud profilers if the configuration allows.
@@ -57,7 +61,7 @@ func do(config interface{}) error {
 
 	// Construct worker API server.
 	workerRcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
-	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, "/")
+	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, env.Config().PPSWorkerRootPath)
 	if err != nil {
 		return err
 	}
@@ -98,7 +102,7 @@ func do(config interface{}) error {
 	}
 
 	// If server ever exits, return error
-	if _, err := server.ListenTCP("", env.Config().PPSWorkerPort); err != nil {
+	if _, err := server.ListenTCP(env.Config().PPSWorkerBindIP, env.Config().PPSWorkerPort); err != nil {
 		return err
 	}
 	return server.Wait()
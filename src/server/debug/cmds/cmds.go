@@ -1,6 +1,7 @@
 package cmds
 
 import (
+	"fmt"
 	"os"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/debug"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/spf13/cobra"
 )
@@ -104,6 +106,52 @@ func Cmds() []*cobra.Command {
 	dump.Flags().Int64VarP(&limit, "limit", "l", 0, "Limit sets the limit for the number of commits / jobs that are returned for each repo / pipeline in the dump.")
 	commands = append(commands, cmdutil.CreateAlias(dump, "debug dump"))
 
+	var orphans, dryRun bool
+	var gracePeriod time.Duration
+	storageGC := &cobra.Command{
+		Short: "Run garbage collection on the cluster's object storage.",
+		Long: "Run garbage collection on the cluster's object storage. " +
+			"Chunks that are no longer referenced are collected continuously by pachd; " +
+			"with --orphans, objects which are not tracked in the database at all " +
+			"(e.g. after the database has been restored from a backup) are found and deleted. " +
+			"An object is only deleted after it has been seen to be orphaned by sweeps " +
+			"spanning at least --grace-period (at least 1h), so a first sweep will not delete anything. " +
+			"A --dry-run sweep neither records nor deletes anything.",
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			if !orphans {
+				return errors.New("unreferenced chunks are collected by pachd automatically, pass --orphans to sweep untracked objects")
+			}
+			c, err := client.NewOnUserMachine("debug-storage-gc")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var found, deleted int
+			if err := c.SweepOrphans(dryRun, gracePeriod, func(resp *pfs.SweepOrphansResponse) error {
+				found++
+				since, err := types.TimestampFromProto(resp.OrphanedSince)
+				if err != nil {
+					return err
+				}
+				action := "found"
+				if resp.Deleted {
+					action = "deleted"
+					deleted++
+				}
+				fmt.Printf("%s %s in the %s tier (orphaned since %s)\n", action, resp.Object, resp.Tier, since.Format(time.RFC3339))
+				return nil
+			}); err != nil {
+				return err
+			}
+			fmt.Printf("%d orphaned objects found, %d deleted\n", found, deleted)
+			return nil
+		}),
+	}
+	storageGC.Flags().BoolVar(&orphans, "orphans", false, "Sweep object storage for objects that are not tracked in the database.")
+	storageGC.Flags().BoolVar(&dryRun, "dry-run", false, "Report orphaned objects without recording or deleting them.")
+	storageGC.Flags().DurationVar(&gracePeriod, "grace-period", 24*time.Hour, "How long an object must have been orphaned before it is deleted.")
+	commands = append(commands, cmdutil.CreateAlias(storageGC, "debug storage-gc"))
+
 	debug := &cobra.Command{
 		Short: "Debug commands for analyzing a running cluster.",
 		Long:  "Debug commands for analyzing a running cluster.",
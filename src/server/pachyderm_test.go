@@ -2599,6 +2599,241 @@ func TestUpdatePipeline(t *testing.T) {
 	require.Equal(t, "buzz\n", buffer.String())
 }
 
+func TestPipelineCandidate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestPipelineCandidate_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("pipeline")
+	createPipeline := func(output string, candidate *pps.CandidateSpec) {
+		_, err := c.PpsAPIClient.CreatePipeline(
+			context.Background(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipeline),
+				Transform: &pps.Transform{
+					Cmd:   []string{"bash"},
+					Stdin: []string{fmt.Sprintf("echo %s >/pfs/out/file", output)},
+				},
+				ParallelismSpec: &pps.ParallelismSpec{Constant: 1},
+				Input:           client.NewPFSInput(dataRepo, "/"),
+				Update:          candidate != nil,
+				Candidate:       candidate,
+			})
+		require.NoError(t, err)
+	}
+	var i int
+	commitData := func() *pfs.Commit {
+		i++
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		return commit
+	}
+	getOutput := func(branch, commitID string) string {
+		_, err := c.WaitCommit(pipeline, branch, commitID)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(client.NewCommit(pipeline, branch, commitID), "file", &buf))
+		return buf.String()
+	}
+	createPipeline("current", nil)
+	require.Equal(t, "current\n", getOutput("master", commitData().ID))
+
+	// A canary runs some of the output commits, and the current version runs
+	// the rest
+	createPipeline("canary", &pps.CandidateSpec{CanaryPercent: 50})
+	outputs := make(map[uint64]string)
+	for j := 0; j < 20; j++ {
+		commit := commitData()
+		output := getOutput("master", commit.ID)
+		jobInfo, err := c.InspectJob(pipeline, commit.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+		// each version writes its own output
+		if prev, ok := outputs[jobInfo.PipelineVersion]; ok {
+			require.Equal(t, prev, output)
+		}
+		outputs[jobInfo.PipelineVersion] = output
+	}
+	require.Equal(t, 2, len(outputs))
+	require.Equal(t, "current\n", outputs[1])
+	pipelineInfo, err := c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pipelineInfo.Version)
+
+	// A shadow candidate replaces the canary. It runs every output commit, but
+	// only writes to its shadow branch, while the current version keeps
+	// writing the output branch.
+	createPipeline("shadow", &pps.CandidateSpec{ShadowBranch: "shadow"})
+	for j := 0; j < 3; j++ {
+		commit := commitData()
+		require.Equal(t, "current\n", getOutput("master", commit.ID))
+		require.Equal(t, "shadow\n", getOutput("shadow", commit.ID))
+		jobInfo, err := c.InspectJob(pipeline, commit.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), jobInfo.PipelineVersion)
+	}
+
+	// Promoting the shadow makes it the current version, and deletes its
+	// shadow branch
+	require.NoError(t, c.PromotePipeline(pipeline))
+	require.Equal(t, "shadow\n", getOutput("master", commitData().ID))
+	pipelineInfo, err = c.InspectPipeline(pipeline, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), pipelineInfo.Version)
+	require.Nil(t, pipelineInfo.Details.Candidate)
+	_, err = c.InspectBranch(pipeline, "shadow")
+	require.YesError(t, err)
+	require.YesError(t, c.PromotePipeline(pipeline))
+}
+
+func TestRollbackPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestRollbackPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := tu.UniqueString("pipeline")
+	pipelineCommit := client.NewCommit(pipelineName, "master", "")
+	createPipeline := func(output string) {
+		require.NoError(t, c.CreatePipeline(
+			pipelineName,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("echo %s >/pfs/out/file", output)},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			client.NewPFSInput(dataRepo, "/*"),
+			"",
+			true,
+		))
+	}
+	putFile := func(content string) {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, "file", strings.NewReader(content)))
+		require.NoError(t, c.FinishCommit(dataRepo, "master", ""))
+		_, err = c.WaitCommitSetAll(commit.ID)
+		require.NoError(t, err)
+	}
+	requireOutput := func(expected string) {
+		var buffer bytes.Buffer
+		require.NoError(t, c.GetFile(pipelineCommit, "file", &buffer))
+		require.Equal(t, expected, buffer.String())
+	}
+
+	createPipeline("foo")
+	putFile("1")
+	requireOutput("foo\n")
+	createPipeline("bar")
+	putFile("2")
+	requireOutput("bar\n")
+
+	// Rolling back creates a third version with the spec of the first
+	require.NoError(t, c.RollbackPipeline(pipelineName, 1, false))
+	pipelineInfo, err := c.InspectPipeline(pipelineName, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), pipelineInfo.Version)
+	require.Equal(t, []string{"echo foo >/pfs/out/file"}, pipelineInfo.Details.Transform.Stdin)
+	putFile("3")
+	requireOutput("foo\n")
+
+	// The current version and unknown versions can't be rolled back to
+	require.YesError(t, c.RollbackPipeline(pipelineName, 3, false))
+	require.YesError(t, c.RollbackPipeline(pipelineName, 10, false))
+}
+
+func TestRollbackPipelineUnchangedDatum(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestRollbackPipelineUnchangedDatum_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := tu.UniqueString("pipeline")
+	pipelineCommit := client.NewCommit(pipelineName, "master", "")
+	createPipeline := func(output string) {
+		require.NoError(t, c.CreatePipeline(
+			pipelineName,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("for f in /pfs/%s/*; do echo %s >/pfs/out/$(basename $f); done", dataRepo, output)},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			client.NewPFSInput(dataRepo, "/*"),
+			"",
+			true,
+		))
+	}
+	putFile := func(file string) {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, file, strings.NewReader(file)))
+		require.NoError(t, c.FinishCommit(dataRepo, "master", ""))
+		_, err = c.WaitCommitSetAll(commit.ID)
+		require.NoError(t, err)
+	}
+	requireOutputs := func(expected map[string]string) {
+		for file, output := range expected {
+			var buffer bytes.Buffer
+			require.NoError(t, c.GetFile(pipelineCommit, file, &buffer))
+			require.Equal(t, output+"\n", buffer.String(), "output of %s", file)
+		}
+	}
+
+	createPipeline("foo")
+	putFile("a")
+	createPipeline("bar")
+	putFile("b")
+	requireOutputs(map[string]string{"a": "foo", "b": "bar"})
+
+	// A rollback only processes new and changed datums, so "b" keeps the
+	// output of version 2, although the pipeline was rolled back to version 1
+	require.NoError(t, c.RollbackPipeline(pipelineName, 1, false))
+	putFile("c")
+	requireOutputs(map[string]string{"a": "foo", "b": "bar", "c": "foo"})
+
+	// Reprocessing processes every datum with the version rolled back to
+	require.NoError(t, c.RollbackPipeline(pipelineName, 2, true))
+	_, err := c.WaitCommit(pipelineName, "master", "")
+	require.NoError(t, err)
+	requireOutputs(map[string]string{"a": "bar", "b": "bar", "c": "bar"})
+
+	// Reusing outputs restores the salt of version 1, which the current
+	// outputs weren't processed with, as the last rollback reprocessed, so
+	// every datum is processed again with version 1
+	require.NoError(t, c.RollbackPipelineReuseOutputs(pipelineName, 1))
+	_, err = c.WaitCommit(pipelineName, "master", "")
+	require.NoError(t, err)
+	requireOutputs(map[string]string{"a": "foo", "b": "foo", "c": "foo"})
+
+	// Version 2 has the same salt, as it didn't reprocess, so rolling back to
+	// it keeps the current outputs
+	require.NoError(t, c.RollbackPipelineReuseOutputs(pipelineName, 2))
+	putFile("d")
+	requireOutputs(map[string]string{"a": "foo", "b": "foo", "c": "foo", "d": "bar"})
+
+	_, err = c.PpsAPIClient.RollbackPipeline(c.Ctx(), &pps.RollbackPipelineRequest{
+		Pipeline:     client.NewPipeline(pipelineName),
+		Version:      1,
+		Reprocess:    true,
+		ReuseOutputs: true,
+	})
+	require.YesError(t, err)
+}
+
 func TestUpdatePipelineWithInProgressCommitsAndStats(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -9128,6 +9363,55 @@ func TestListDatum(t *testing.T) {
 	require.Equal(t, 25, len(dis))
 }
 
+func TestPersistedDatumLogs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPersistedDatumLogs_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit1, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, "master", commit1.ID))
+
+	pipeline := tu.UniqueString("TestPersistedDatumLogs")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			"echo persisted-log-line",
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	_, err = c.WaitCommitSetAll(commit1.ID)
+	require.NoError(t, err)
+
+	jobInfo, err := c.InspectJob(pipeline, commit1.ID, false)
+	require.NoError(t, err)
+	dis, err := c.ListDatumAll(pipeline, jobInfo.Job.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dis))
+	datumInfo, err := c.InspectDatum(pipeline, jobInfo.Job.ID, dis[0].Datum.ID)
+	require.NoError(t, err)
+	require.NotNil(t, datumInfo.LogFile)
+
+	// The user code's output is persisted to the meta commit
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(datumInfo.LogFile.Commit, datumInfo.LogFile.Path, &buf))
+	require.True(t, strings.Contains(buf.String(), "persisted-log-line"))
+}
+
 func TestDebug(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
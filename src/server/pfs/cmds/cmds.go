@@ -159,6 +159,7 @@ or type (e.g. csv, binary, images, etc).`,
 
 	var all bool
 	var repoType string
+	var usage bool
 	listRepo := &cobra.Command{
 		Short: "Return a list of repos.",
 		Long:  "Return a list of repos. By default, hide system repos like pipeline metadata",
@@ -175,7 +176,12 @@ or type (e.g. csv, binary, images, etc).`,
 			if repoType == "" && !all {
 				repoType = pfs.UserRepoType // default to user
 			}
-			repoInfos, err := c.ListRepoByType(repoType)
+			var repoInfos []*pfs.RepoInfo
+			if usage {
+				repoInfos, err = c.ListRepoUsage(repoType)
+			} else {
+				repoInfos, err = c.ListRepoByType(repoType)
+			}
 			if err != nil {
 				return err
 			}
@@ -191,6 +197,13 @@ or type (e.g. csv, binary, images, etc).`,
 				return errors.New("cannot set --output (-o) without --raw")
 			}
 
+			if usage {
+				writer := tabwriter.NewWriter(os.Stdout, pretty.RepoUsageHeader)
+				for _, repoInfo := range repoInfos {
+					pretty.PrintRepoUsage(writer, repoInfo, fullTimestamps)
+				}
+				return writer.Flush()
+			}
 			header := pretty.RepoHeader
 			if (len(repoInfos) > 0) && (repoInfos[0].AuthInfo != nil) {
 				header = pretty.RepoAuthHeader
@@ -206,8 +219,71 @@ or type (e.g. csv, binary, images, etc).`,
 	listRepo.Flags().AddFlagSet(timestampFlags)
 	listRepo.Flags().BoolVar(&all, "all", false, "include system repos of all types")
 	listRepo.Flags().StringVar(&repoType, "type", "", "only include repos of the given type")
+	listRepo.Flags().BoolVar(&usage, "usage", false, "include the storage used by the heads of each repo's branches")
 	commands = append(commands, cmdutil.CreateAlias(listRepo, "list repo"))
 
+	var quotaPrefix string
+	var maxBytes int64
+	var maxFiles int64
+	setRepoQuota := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Set the storage quota of a repo.",
+		Long:  "Set the storage quota of a repo, or, with --prefix, a quota shared by all repos whose names start with the prefix. Commits that would exceed the quota finish with an error. Setting both limits to 0 removes the quota.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			if (len(args) == 1) == (quotaPrefix != "") {
+				return errors.Errorf("either a repo name or the --prefix flag needs to be provided")
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if quotaPrefix != "" {
+				return c.SetRepoPrefixQuota(quotaPrefix, maxBytes, maxFiles)
+			}
+			return c.SetRepoQuota(args[0], maxBytes, maxFiles)
+		}),
+	}
+	setRepoQuota.Flags().StringVar(&quotaPrefix, "prefix", "", "apply the quota to all repos whose names start with this prefix")
+	setRepoQuota.Flags().Int64Var(&maxBytes, "max-bytes", 0, "the maximum total size of the files in the repo's branch heads; 0 means unlimited")
+	setRepoQuota.Flags().Int64Var(&maxFiles, "max-files", 0, "the maximum number of files in the repo's branch heads; 0 means unlimited")
+	shell.RegisterCompletionFunc(setRepoQuota, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(setRepoQuota, "set repo-quota"))
+
+	listRepoQuota := &cobra.Command{
+		Short: "Return a list of repo quotas.",
+		Long:  "Return a list of repo quotas.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			quotas, err := c.ListRepoQuota()
+			if err != nil {
+				return err
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				for _, quota := range quotas {
+					if err := encoder.EncodeProto(quota); err != nil {
+						return err
+					}
+				}
+				return nil
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.RepoQuotaHeader)
+			for _, quota := range quotas {
+				pretty.PrintRepoQuota(writer, quota)
+			}
+			return writer.Flush()
+		}),
+	}
+	listRepoQuota.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(listRepoQuota, "list repo-quota"))
+
 	var force bool
 	deleteRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
@@ -849,6 +925,32 @@ Any pachctl command that can take a commit, can take a branch name instead.`,
 	createBranch.Flags().BoolVar(&trigger.All, "trigger-all", false, "Only trigger when all conditions are met, rather than when any are met.")
 	commands = append(commands, cmdutil.CreateAlias(createBranch, "create branch"))
 
+	protection := &pfs.BranchProtection{}
+	protectBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch>",
+		Short: "Set the protection rules of a branch.",
+		Long:  "Set the protection rules of a branch, replacing any existing rules. Calling this with no rule flags removes the branch's protection.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.ProtectBranch(branch.Repo.Name, branch.Name, protection)
+		}),
+	}
+	protectBranch.Flags().BoolVar(&protection.NoDirectCommits, "no-direct-commits", false, "Forbid starting commits directly on the branch.")
+	protectBranch.Flags().BoolVar(&protection.RequirePromotion, "require-promotion", false, "Only allow the head to be moved to a commit from another branch.")
+	protectBranch.Flags().BoolVar(&protection.NoDelete, "no-delete", false, "Forbid deleting the branch.")
+	protectBranch.Flags().BoolVar(&protection.NoForceMove, "no-force-move", false, "Forbid moving the head back to a previous commit.")
+	protectBranch.Flags().StringVar(&protection.BypassRole, "bypass-role", "", "A role on the repo (e.g. repoOwner) that is exempt from the rules.")
+	shell.RegisterCompletionFunc(protectBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(protectBranch, "protect branch"))
+
 	inspectBranch := &cobra.Command{
 		Use:   "{{alias}}  <repo>@<branch>",
 		Short: "Return info about a branch.",
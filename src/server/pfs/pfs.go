@@ -111,6 +111,20 @@ type ErrCommitOnOutputBranch struct {
 	Branch *pfs.Branch
 }
 
+// ErrBranchProtected represents an error where an operation would violate the
+// BranchProtection rules of a branch.
+type ErrBranchProtected struct {
+	Branch *pfs.Branch
+	Reason string
+}
+
+// ErrQuotaExceeded represents an error where a commit would cause a repo, or
+// a set of repos sharing a name prefix, to exceed its storage quota.
+type ErrQuotaExceeded struct {
+	Quota *pfs.RepoQuota
+	Usage *pfs.RepoUsage
+}
+
 // ErrSquashWithoutChildren represents an error when attempting to squash a
 // commit that has no children.  Since squash works by removing a commit and
 // leaving its data in any child commits, a squash would result in data loss in
@@ -197,6 +211,19 @@ func (e ErrCommitOnOutputBranch) Error() string {
 	return fmt.Sprintf("cannot start a commit on an output branch: %s", e.Branch)
 }
 
+func (e ErrBranchProtected) Error() string {
+	return fmt.Sprintf("branch %s is protected: %s", e.Branch, e.Reason)
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	target := fmt.Sprintf("repo %s", e.Quota.Repo)
+	if e.Quota.Repo == nil {
+		target = fmt.Sprintf("repos with prefix %q", e.Quota.Prefix)
+	}
+	return fmt.Sprintf("storage quota exceeded for %s: %d bytes in %d files (limit %d bytes, %d files)",
+		target, e.Usage.LogicalBytes, e.Usage.FileCount, e.Quota.MaxBytes, e.Quota.MaxFiles)
+}
+
 func (e ErrSquashWithoutChildren) Error() string {
 	return fmt.Sprintf("cannot squash a commit that has no children as that would cause data loss, use the drop operation instead: %s", e.Commit)
 }
@@ -220,6 +247,8 @@ var (
 	ambiguousCommitRe         = regexp.MustCompile("commit .+ is ambiguous")
 	inconsistentCommitRe      = regexp.MustCompile("branch already has a commit in this transaction")
 	commitOnOutputBranchRe    = regexp.MustCompile("cannot start a commit on an output branch")
+	branchProtectedRe         = regexp.MustCompile("branch [^ ]+ is protected")
+	quotaExceededRe           = regexp.MustCompile("storage quota exceeded for")
 	squashWithoutChildrenRe   = regexp.MustCompile("cannot squash a commit that has no children")
 	dropWithChildrenRe        = regexp.MustCompile("cannot drop a commit that has children")
 )
@@ -357,6 +386,24 @@ func IsCommitOnOutputBranchErr(err error) bool {
 	return commitOnOutputBranchRe.MatchString(err.Error())
 }
 
+// IsBranchProtectedErr returns true if the err is due to an operation that
+// violates a branch's protection rules.
+func IsBranchProtectedErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return branchProtectedRe.MatchString(err.Error())
+}
+
+// IsQuotaExceededErr returns true if the err is due to a repo exceeding its
+// storage quota.
+func IsQuotaExceededErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return quotaExceededRe.MatchString(err.Error())
+}
+
 func IsSquashWithoutChildrenErr(err error) bool {
 	if err == nil {
 		return false
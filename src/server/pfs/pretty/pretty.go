@@ -19,6 +19,10 @@ const (
 	RepoHeader = "NAME\tCREATED\tSIZE (MASTER)\tDESCRIPTION\t\n"
 	// RepoAuthHeader is the header for repos with auth information attached.
 	RepoAuthHeader = "NAME\tCREATED\tSIZE (MASTER)\tACCESS LEVEL\t\n"
+	// RepoUsageHeader is the header for repos with usage information attached.
+	RepoUsageHeader = "NAME\tCREATED\tLOGICAL SIZE\tCHUNK SIZE\tFILES\t\n"
+	// RepoQuotaHeader is the header for repo quotas.
+	RepoQuotaHeader = "REPO\tPREFIX\tMAX SIZE\tMAX FILES\t\n"
 	// CommitHeader is the header for commits.
 	CommitHeader = "REPO\tBRANCH\tCOMMIT\tFINISHED\tSIZE\tORIGIN\tDESCRIPTION\n"
 	// CommitSetHeader is the header for commitsets.
@@ -53,6 +57,41 @@ func PrintRepoInfo(w io.Writer, repoInfo *pfs.RepoInfo, fullTimestamps bool) {
 	fmt.Fprintln(w)
 }
 
+// PrintRepoUsage pretty-prints repo info with the storage usage of the repo.
+func PrintRepoUsage(w io.Writer, repoInfo *pfs.RepoInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", repoInfo.Repo)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", repoInfo.Created.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(repoInfo.Created))
+	}
+	usage := repoInfo.Details.GetUsage()
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(usage.GetLogicalBytes())))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(usage.GetChunkBytes())))
+	fmt.Fprintf(w, "%d\t", usage.GetFileCount())
+	fmt.Fprintln(w)
+}
+
+// PrintRepoQuota pretty-prints a repo quota.
+func PrintRepoQuota(w io.Writer, quota *pfs.RepoQuota) {
+	if quota.Repo != nil {
+		fmt.Fprintf(w, "%s\t-\t", quota.Repo)
+	} else {
+		fmt.Fprintf(w, "-\t%s\t", quota.Prefix)
+	}
+	if quota.MaxBytes > 0 {
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(quota.MaxBytes)))
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
+	if quota.MaxFiles > 0 {
+		fmt.Fprintf(w, "%d\t", quota.MaxFiles)
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
+	fmt.Fprintln(w)
+}
+
 // PrintableRepoInfo is a wrapper around RepoInfo containing any formatting options
 // used within the template to conditionally print information.
 type PrintableRepoInfo struct {
@@ -107,6 +146,27 @@ func printTrigger(trigger *pfs.Trigger) string {
 	return fmt.Sprintf("%s on %s", trigger.Branch, cond)
 }
 
+func printProtection(protection *pfs.BranchProtection) string {
+	var rules []string
+	if protection.NoDirectCommits {
+		rules = append(rules, "no direct commits")
+	}
+	if protection.RequirePromotion {
+		rules = append(rules, "require promotion")
+	}
+	if protection.NoDelete {
+		rules = append(rules, "no delete")
+	}
+	if protection.NoForceMove {
+		rules = append(rules, "no force move")
+	}
+	result := strings.Join(rules, ", ")
+	if protection.BypassRole != "" {
+		result += fmt.Sprintf(" (bypass: %s)", protection.BypassRole)
+	}
+	return result
+}
+
 // PrintBranch pretty-prints a Branch.
 func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	fmt.Fprintf(w, "%s\t", branchInfo.Branch.Name)
@@ -129,7 +189,8 @@ func PrintDetailedBranchInfo(branchInfo *pfs.BranchInfo) error {
 		`Name: {{.Branch.Repo.Name}}@{{.Branch.Name}}{{if .Head}}
 Head Commit: {{ .Head.Branch.Repo.Name}}@{{.Head.ID}} {{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Repo.Name}}@{{.Name}} {{end}} {{end}}{{if .Trigger}}
-Trigger: {{printTrigger .Trigger}} {{end}}
+Trigger: {{printTrigger .Trigger}} {{end}}{{if .Protection}}
+Protection: {{printProtection .Protection}} {{end}}
 `)
 	if err != nil {
 		return err
@@ -312,7 +373,8 @@ var funcMap = template.FuncMap{
 	"prettyAgo":    pretty.Ago,
 	"prettySize":   pretty.Size,
 	"fileType":     fileType,
-	"printTrigger": printTrigger,
+	"printTrigger":    printTrigger,
+	"printProtection": printProtection,
 }
 
 // CompactPrintCommit renders 'c' as a compact string, e.g.
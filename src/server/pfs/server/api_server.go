@@ -135,7 +135,7 @@ func (a *apiServer) InspectRepo(ctx context.Context, request *pfs.InspectRepoReq
 func (a *apiServer) ListRepo(request *pfs.ListRepoRequest, srv pfs.API_ListRepoServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
-	return a.driver.listRepo(srv.Context(), true, request.Type, srv.Send)
+	return a.driver.listRepo(srv.Context(), true, request.Type, request.Usage, srv.Send)
 }
 
 // DeleteRepoInTransaction is identical to DeleteRepo except that it can run
@@ -156,6 +156,28 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+// SetRepoQuota implements the protobuf pfs.SetRepoQuota RPC
+func (a *apiServer) SetRepoQuota(ctx context.Context, request *pfs.SetRepoQuotaRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if request.Quota == nil {
+		return nil, errors.New("quota cannot be nil")
+	}
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.setRepoQuota(txnCtx, request.Quota)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ListRepoQuota implements the protobuf pfs.ListRepoQuota RPC
+func (a *apiServer) ListRepoQuota(request *pfs.ListRepoQuotaRequest, srv pfs.API_ListRepoQuotaServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	return a.driver.listRepoQuota(srv.Context(), srv.Send)
+}
+
 // StartCommitInTransaction is identical to StartCommit except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) StartCommitInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.StartCommitRequest) (*pfs.Commit, error) {
@@ -298,7 +320,7 @@ func (a *apiServer) ClearCommit(ctx context.Context, request *pfs.ClearCommitReq
 // CreateBranchInTransaction is identical to CreateBranch except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) CreateBranchInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.CreateBranchRequest) error {
-	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger)
+	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger, request.Protection)
 }
 
 // CreateBranch implements the protobuf pfs.CreateBranch RPC
@@ -388,10 +410,15 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	}
 	func() { a.Log(commit, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(commit, nil, retErr, time.Since(start)) }(time.Now())
+	// Writes that add data are rejected once the repo is at its quota, but
+	// deletes aren't, so that a full repo can be cleaned up
+	checkQuota := func() error {
+		return a.driver.checkRepoQuotaReached(server.Context(), commit.Branch.Repo)
+	}
 	return metrics.ReportRequestWithThroughput(func() (int64, error) {
 		var bytesRead int64
 		if err := a.driver.modifyFile(server.Context(), commit, func(uw *fileset.UnorderedWriter) error {
-			n, err := a.modifyFile(server.Context(), uw, server)
+			n, err := a.modifyFile(server.Context(), uw, server, checkQuota)
 			if err != nil {
 				return err
 			}
@@ -409,8 +436,9 @@ type modifyFileSource interface {
 }
 
 // modifyFile reads from a modifyFileSource until io.EOF and writes changes to an UnorderedWriter.
-// SetCommit messages will result in an error.
-func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter, server modifyFileSource) (int64, error) {
+// SetCommit messages will result in an error. If checkQuota is set, it's called
+// once, before the first message that adds data.
+func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter, server modifyFileSource, checkQuota func() error) (int64, error) {
 	var bytesRead int64
 	for {
 		msg, err := server.Recv()
@@ -420,6 +448,15 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 			}
 			return bytesRead, err
 		}
+		switch msg.Body.(type) {
+		case *pfs.ModifyFileRequest_AddFile, *pfs.ModifyFileRequest_CopyFile:
+			if checkQuota != nil {
+				if err := checkQuota(); err != nil {
+					return bytesRead, err
+				}
+				checkQuota = nil
+			}
+		}
 		switch mod := msg.Body.(type) {
 		case *pfs.ModifyFileRequest_AddFile:
 			var err error
@@ -725,12 +762,25 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 	return nil
 }
 
+// SweepOrphans implements the protobuf pfs.SweepOrphans RPC
+func (a *apiServer) SweepOrphans(request *pfs.SweepOrphansRequest, server pfs.API_SweepOrphansServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	sent := 0
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("stream containing %d messages", sent), retErr, time.Since(start))
+	}(time.Now())
+	return a.driver.sweepOrphans(server.Context(), request, func(resp *pfs.SweepOrphansResponse) error {
+		sent++
+		return server.Send(resp)
+	})
+}
+
 // CreateFileSet implements the pfs.CreateFileset RPC
 func (a *apiServer) CreateFileSet(server pfs.API_CreateFileSetServer) (retErr error) {
 	func() { a.Log(nil, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(nil, nil, retErr, time.Since(start)) }(time.Now())
 	fsID, err := a.driver.createFileSet(server.Context(), func(uw *fileset.UnorderedWriter) error {
-		_, err := a.modifyFile(server.Context(), uw, server)
+		_, err := a.modifyFile(server.Context(), uw, server, nil)
 		return err
 	})
 	if err != nil {
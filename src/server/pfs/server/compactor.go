@@ -3,7 +3,6 @@ package server
 import (
 	"time"
 
-	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
@@ -25,11 +24,11 @@ type compactor struct {
 	compactionQueue *work.TaskQueue
 }
 
-func newCompactor(ctx context.Context, storage *fileset.Storage, etcdClient *etcd.Client, etcdPrefix string, maxFanIn int) (*compactor, error) {
+func newCompactor(ctx context.Context, storage *fileset.Storage, env Env, maxFanIn int) (*compactor, error) {
 	if maxFanIn < 2 {
 		panic(maxFanIn)
 	}
-	compactionQueue, err := work.NewTaskQueue(ctx, etcdClient, etcdPrefix, storageTaskNamespace)
+	compactionQueue, err := newStorageTaskQueue(ctx, env)
 	if err != nil {
 		return nil, err
 	}
@@ -66,10 +65,17 @@ func (c *compactor) Compact(ctx context.Context, ids []fileset.ID, ttl time.Dura
 					workTasks[i] = &work.Task{Data: any}
 				}
 				results := make([]fileset.ID, len(tasks))
+				pending := len(workTasks)
+				compactionTasksPendingMetric.Add(float64(pending))
+				defer func() { compactionTasksPendingMetric.Sub(float64(pending)) }()
 				if err := master.RunSubtasks(workTasks, func(_ context.Context, taskInfo *work.TaskInfo) error {
+					pending--
+					compactionTasksPendingMetric.Dec()
 					if taskInfo.State == work.State_FAILURE {
+						compactionTasksMetric.WithLabelValues("error").Inc()
 						return errors.New(taskInfo.Reason)
 					}
+					compactionTasksMetric.WithLabelValues("success").Inc()
 					res, err := deserializeCompactionResult(taskInfo.Result)
 					if err != nil {
 						return err
@@ -99,8 +105,22 @@ func (c *compactor) Compact(ctx context.Context, ids []fileset.ID, ttl time.Dura
 	})
 }
 
-func compactionWorker(ctx context.Context, storage *fileset.Storage, etcdClient *etcd.Client, etcdPrefix string) error {
-	worker := work.NewWorker(etcdClient, etcdPrefix, storageTaskNamespace)
+func newStorageTaskQueue(ctx context.Context, env Env) (*work.TaskQueue, error) {
+	if env.WorkBackend == work.PostgresBackend {
+		return work.NewPostgresTaskQueue(ctx, env.DB, env.Listener, storageTaskNamespace)
+	}
+	return work.NewTaskQueue(ctx, env.EtcdClient, env.EtcdPrefix, storageTaskNamespace)
+}
+
+func newStorageWorker(env Env) *work.Worker {
+	if env.WorkBackend == work.PostgresBackend {
+		return work.NewPostgresWorker(env.DB, env.Listener, storageTaskNamespace)
+	}
+	return work.NewWorker(env.EtcdClient, env.EtcdPrefix, storageTaskNamespace)
+}
+
+func compactionWorker(ctx context.Context, storage *fileset.Storage, env Env) error {
+	worker := newStorageWorker(env)
 	return backoff.RetryUntilCancel(ctx, func() error {
 		return worker.Run(ctx, func(ctx context.Context, subtask *work.Task) (*types.Any, error) {
 			var result *types.Any
@@ -74,24 +74,34 @@ type driver struct {
 	prefix     string
 
 	// collections
-	repos    col.PostgresCollection
-	commits  col.PostgresCollection
-	branches col.PostgresCollection
+	repos      col.PostgresCollection
+	commits    col.PostgresCollection
+	branches   col.PostgresCollection
+	repoQuotas col.PostgresCollection
 
 	storage     *fileset.Storage
 	commitStore commitStore
+	// coldTierMinAge is how long ago a chunk must have last been referenced by
+	// a commit to be moved to the cold tier, it is 0 if there is no cold tier.
+	coldTierMinAge time.Duration
+	// orphanSweepInterval is how often the PFS master sweeps chunk storage for
+	// orphaned objects, it is 0 if orphans are only swept on request.
+	orphanSweepInterval time.Duration
 }
 
 func newDriver(env Env) (*driver, error) {
 	storageConfig := env.StorageConfig
 	objClient := env.ObjectClient
-	// test object storage.
-	if err := func() error {
-		ctx, cf := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cf()
-		return obj.TestStorage(ctx, objClient)
-	}(); err != nil {
-		return nil, err
+	// test object storage, unless it is unavailable and chunks are being
+	// served from the replica.
+	if !storageConfig.StorageFailover {
+		if err := func() error {
+			ctx, cf := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cf()
+			return obj.TestStorage(ctx, objClient)
+		}(); err != nil {
+			return nil, err
+		}
 	}
 	repos := pfsdb.Repos(env.DB, env.Listener)
 	commits := pfsdb.Commits(env.DB, env.Listener)
@@ -105,14 +115,25 @@ func newDriver(env Env) (*driver, error) {
 		repos:      repos,
 		commits:    commits,
 		branches:   branches,
+		repoQuotas: pfsdb.RepoQuotas(env.DB, env.Listener),
 		env:        env,
 	}
 	// Setup tracker and chunk / fileset storage.
-	tracker := track.NewPostgresTracker(env.DB)
+	tracker := track.MonitoredTracker(track.NewPostgresTracker(env.DB))
 	chunkStorageOpts, err := chunk.StorageOptions(&storageConfig)
 	if err != nil {
 		return nil, err
 	}
+	if storageConfig.StorageColdTierURL != "" {
+		if d.coldTierMinAge, err = time.ParseDuration(storageConfig.StorageColdTierMinAge); err != nil {
+			return nil, errors.Wrapf(err, "could not parse cold tier minimum age")
+		}
+	}
+	if storageConfig.StorageOrphanSweepInterval != "" {
+		if d.orphanSweepInterval, err = time.ParseDuration(storageConfig.StorageOrphanSweepInterval); err != nil {
+			return nil, errors.Wrapf(err, "could not parse orphan sweep interval")
+		}
+	}
 	memCache := storageConfig.ChunkMemoryCache()
 	keyStore := chunk.NewPostgresKeyStore(env.DB)
 	secret, err := getOrCreateKey(context.TODO(), keyStore, "default")
@@ -121,9 +142,18 @@ func newDriver(env Env) (*driver, error) {
 	}
 	chunkStorageOpts = append(chunkStorageOpts, chunk.WithSecret(secret))
 	chunkStorage := chunk.NewStorage(objClient, memCache, env.DB, tracker, chunkStorageOpts...)
+	if storageConfig.StorageFailover {
+		n, err := chunkStorage.UnreplicatedChunks(context.TODO())
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			log.Warnf("failed over to the storage replica with %d chunks that were never replicated, reading them will fail", n)
+		}
+	}
 	d.storage = fileset.NewStorage(fileset.NewPostgresStore(env.DB), tracker, chunkStorage, fileset.StorageOptions(&storageConfig)...)
 	// Setup compaction queue and worker.
-	go compactionWorker(env.BackgroundContext, d.storage, env.EtcdClient, env.EtcdPrefix)
+	go compactionWorker(env.BackgroundContext, d.storage, env)
 	d.commitStore = newPostgresCommitStore(env.DB, tracker, d.storage)
 	return d, nil
 }
@@ -255,7 +285,7 @@ func (d *driver) getPermissions(ctx context.Context, repo *pfs.Repo) ([]auth.Per
 	return resp.Permissions, resp.Roles, nil
 }
 
-func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string, cb func(*pfs.RepoInfo) error) error {
+func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string, includeUsage bool, cb func(*pfs.RepoInfo) error) error {
 	authSeemsActive := true
 	repoInfo := &pfs.RepoInfo{}
 
@@ -265,6 +295,17 @@ func (d *driver) listRepo(ctx context.Context, includeAuth bool, repoType string
 			return err
 		}
 		repoInfo.SizeBytesUpperBound = size
+		if includeUsage {
+			var usage *pfs.RepoUsage
+			if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+				var err error
+				usage, err = d.repoUsage(txnCtx, repoInfo.Repo)
+				return err
+			}); err != nil {
+				return err
+			}
+			repoInfo.Details = &pfs.RepoInfo_Details{SizeBytes: size, Usage: usage}
+		}
 		if includeAuth && authSeemsActive {
 			permissions, roles, err := d.getPermissions(ctx, repoInfo.Repo)
 			if err == nil {
@@ -297,6 +338,19 @@ func (d *driver) deleteAllBranchesFromRepos(txnCtx *txncontext.TransactionContex
 			branchInfos = append(branchInfos, bi)
 		}
 	}
+	if force {
+		// Deleting the whole repo is an owner-level operation, so a forced
+		// deletion overrides any branch protection rules.
+		for _, bi := range branchInfos {
+			if bi.Protection == nil {
+				continue
+			}
+			bi.Protection = nil
+			if err := d.branches.ReadWrite(txnCtx.SqlTx).Put(bi.Branch, bi); err != nil {
+				return errors.Wrapf(err, "error clearing protection on branch %s", bi.Branch)
+			}
+		}
+	}
 	// sort ascending provenance
 	sort.Slice(branchInfos, func(i, j int) bool { return len(branchInfos[i].Provenance) < len(branchInfos[j].Provenance) })
 	for i := range branchInfos {
@@ -465,6 +519,11 @@ func (d *driver) startCommit(
 			}
 			branchInfo.Branch = branch
 		}
+		if branchInfo.Protection.GetNoDirectCommits() {
+			if err := d.enforceProtection(txnCtx, branchInfo, "commits may not be started on it directly"); err != nil {
+				return err
+			}
+		}
 		// If the parent is unspecified, use the current head of the branch
 		if parent == nil {
 			parent = branchInfo.Head
@@ -1638,7 +1697,7 @@ func (d *driver) clearCommit(ctx context.Context, commit *pfs.Commit) error {
 //
 // This invariant is assumed to hold for all branches upstream of 'branch', but not
 // for 'branch' itself once 'b.Provenance' has been set.
-func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, commit *pfs.Commit, provenance []*pfs.Branch, trigger *pfs.Trigger) error {
+func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, commit *pfs.Commit, provenance []*pfs.Branch, trigger *pfs.Trigger, protection *pfs.BranchProtection) error {
 	// Validate arguments
 	if branch == nil {
 		return errors.New("branch cannot be nil")
@@ -1676,6 +1735,15 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 		if trigger != nil && trigger.Branch != "" {
 			branchInfo.Trigger = trigger
 		}
+		if err := d.checkProtectionUpdate(txnCtx, branchInfo, protection); err != nil {
+			return err
+		}
+		if protection != nil {
+			branchInfo.Protection = protection
+			if proto.Equal(protection, &pfs.BranchProtection{}) {
+				branchInfo.Protection = nil
+			}
+		}
 		return nil
 	}); err != nil {
 		return err
@@ -1688,6 +1756,9 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 		if err != nil {
 			return errors.Wrapf(err, "unable to inspect %s", commit)
 		}
+		if err := d.checkHeadMove(txnCtx, branchInfo, ci); err != nil {
+			return err
+		}
 
 		// Verify the provenance of the new branch head and lock in its upstream commits
 		for _, provBranch := range provenance {
@@ -1921,6 +1992,11 @@ func (d *driver) deleteBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	}
 
 	if branchInfo.Branch != nil {
+		if branchInfo.Protection.GetNoDelete() {
+			if err := d.enforceProtection(txnCtx, branchInfo, "it may not be deleted"); err != nil {
+				return err
+			}
+		}
 		if !force {
 			if len(branchInfo.Subvenance) > 0 {
 				return errors.Errorf("branch %s has %v as subvenance, deleting it would break those branches", branch.Name, branchInfo.Subvenance)
@@ -1945,7 +2021,7 @@ func (d *driver) deleteBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 				return err
 			}
 			del(&subvBranchInfo.DirectProvenance, branch)
-			if err := d.createBranch(txnCtx, subvBranch, nil, subvBranchInfo.DirectProvenance, nil); err != nil {
+			if err := d.createBranch(txnCtx, subvBranch, nil, subvBranchInfo.DirectProvenance, nil, nil); err != nil {
 				return err
 			}
 		}
@@ -1998,7 +2074,7 @@ func (d *driver) addBranchProvenance(txnCtx *txncontext.TransactionContext, bran
 
 func (d *driver) deleteAll(ctx context.Context) error {
 	var repoInfos []*pfs.RepoInfo
-	if err := d.listRepo(ctx, !includeAuth, "", func(repoInfo *pfs.RepoInfo) error {
+	if err := d.listRepo(ctx, !includeAuth, "", false, func(repoInfo *pfs.RepoInfo) error {
 		repoInfos = append(repoInfos, repoInfo)
 		return nil
 	}); err != nil {
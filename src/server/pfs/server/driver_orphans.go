@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+const defaultOrphanGracePeriod = 24 * time.Hour
+
+func (d *driver) sweepOrphans(ctx context.Context, request *pfs.SweepOrphansRequest, cb func(*pfs.SweepOrphansResponse) error) error {
+	gracePeriod := defaultOrphanGracePeriod
+	if request.GracePeriod != nil {
+		var err error
+		if gracePeriod, err = types.DurationFromProto(request.GracePeriod); err != nil {
+			return err
+		}
+	}
+	if gracePeriod < chunk.MinOrphanGracePeriod {
+		return errors.Errorf("grace period must be at least %v", chunk.MinOrphanGracePeriod)
+	}
+	return d.storage.ChunkStorage().SweepOrphans(ctx, gracePeriod, request.DryRun, func(orphan chunk.Orphan) error {
+		since, err := types.TimestampProto(orphan.Since)
+		if err != nil {
+			return err
+		}
+		return cb(&pfs.SweepOrphansResponse{
+			Object:        orphan.Path,
+			OrphanedSince: since,
+			Deleted:       orphan.Deleted,
+			Tier:          string(orphan.Tier),
+		})
+	})
+}
+
+// sweepOrphansForever sweeps chunk storage for orphaned objects every
+// orphanSweepInterval, using the default grace period, until the context is
+// cancelled.
+func (d *driver) sweepOrphansForever(ctx context.Context) error {
+	ticker := time.NewTicker(d.orphanSweepInterval)
+	defer ticker.Stop()
+	for {
+		if err := d.storage.ChunkStorage().SweepOrphans(ctx, defaultOrphanGracePeriod, false, func(orphan chunk.Orphan) error {
+			if orphan.Deleted {
+				log.Infof("deleted orphaned object %s in the %s tier (orphaned since %v)", orphan.Path, orphan.Tier, orphan.Since)
+			}
+			return nil
+		}); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			log.Errorf("during orphan sweep: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -24,6 +24,7 @@ type Env struct {
 	EtcdClient   *etcd.Client
 	TxnEnv       *txnenv.TransactionEnv
 	Listener     col.PostgresListener
+	WorkBackend  string
 
 	AuthServer authserver.APIServer
 	// TODO: a reasonable repo metadata solution would let us get rid of this circular dependency
@@ -55,6 +56,7 @@ func EnvFromServiceEnv(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv)
 		Listener:     env.GetPostgresListener(),
 		EtcdPrefix:   etcdPrefix,
 		EtcdClient:   env.GetEtcdClient(),
+		WorkBackend:  env.Config().WorkBackend,
 
 		AuthServer:    env.AuthServer(),
 		GetPPSServer:  env.PpsServer,
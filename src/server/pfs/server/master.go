@@ -50,6 +50,23 @@ func (d *driver) master(ctx context.Context) {
 		eg.Go(func() error {
 			return d.finishCommits(ctx)
 		})
+		if d.coldTierMinAge > 0 {
+			eg.Go(func() error {
+				tierer := chunk.NewTierer(d.storage.ChunkStorage(), d.coldTierMinAge, d.recentChunks)
+				return tierer.RunForever(ctx)
+			})
+		}
+		if d.orphanSweepInterval > 0 && !d.env.StorageConfig.StorageFailover {
+			eg.Go(func() error {
+				return d.sweepOrphansForever(ctx)
+			})
+		}
+		if d.env.StorageConfig.StorageReplicaURL != "" && !d.env.StorageConfig.StorageFailover {
+			eg.Go(func() error {
+				replicator := chunk.NewReplicator(d.storage.ChunkStorage())
+				return replicator.RunForever(ctx)
+			})
+		}
 		return eg.Wait()
 	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
 		log.Errorf("error in pfs master: %v", err)
@@ -58,7 +75,7 @@ func (d *driver) master(ctx context.Context) {
 }
 
 func (d *driver) finishCommits(ctx context.Context) error {
-	compactor, err := newCompactor(d.env.BackgroundContext, d.storage, d.etcdClient, d.prefix, d.env.StorageConfig.StorageCompactionMaxFanIn)
+	compactor, err := newCompactor(d.env.BackgroundContext, d.storage, d.env, d.env.StorageConfig.StorageCompactionMaxFanIn)
 	if err != nil {
 		return err
 	}
@@ -98,32 +115,50 @@ func (d *driver) finishCommits(ctx context.Context) error {
 					return err
 				}
 				compactingDuration := time.Since(start)
+				commitFinishingDurationMetric.WithLabelValues("compacting").Observe(compactingDuration.Seconds())
 				// Validate the commit.
 				start = time.Now()
-				var size int64
+				var details *pfs.CommitInfo_Details
 				var validationError string
 				if err := miscutil.LogStep(fmt.Sprintf("validating commit %v", commit.ID), func() error {
 					var err error
-					size, validationError, err = d.validate(ctx, totalId)
+					details, validationError, err = d.validate(ctx, totalId)
 					return err
 				}); err != nil {
 					return err
 				}
 				validatingDuration := time.Since(start)
+				commitFinishingDurationMetric.WithLabelValues("validating").Observe(validatingDuration.Seconds())
+				// Read the quotas of the commit's repo before finishing it, as
+				// prefix quotas list repos.
+				quotaScopes, err := d.repoQuotaScopes(ctx, commit.Branch.Repo)
+				if err != nil {
+					return err
+				}
 				// Finish the commit.
 				return miscutil.LogStep(fmt.Sprintf("finish commit %v", commit.ID), func() error {
 					return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 						commitInfo := &pfs.CommitInfo{}
 						if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(pfsdb.CommitKey(commit), commitInfo, func() error {
 							commitInfo.Finished = txnCtx.Timestamp
-							commitInfo.SizeBytesUpperBound = size
+							commitInfo.SizeBytesUpperBound = details.SizeBytes
 							if commitInfo.Details == nil {
 								commitInfo.Details = &pfs.CommitInfo_Details{}
 							}
-							commitInfo.Details.SizeBytes = size
+							commitInfo.Details.SizeBytes = details.SizeBytes
+							commitInfo.Details.FileCount = details.FileCount
+							commitInfo.Details.ChunkBytes = details.ChunkBytes
 							if commitInfo.Error == "" {
 								commitInfo.Error = validationError
 							}
+							if commitInfo.Error == "" {
+								if err := d.checkRepoQuota(txnCtx, commitInfo, quotaScopes); err != nil {
+									if !pfsserver.IsQuotaExceededErr(err) {
+										return err
+									}
+									commitInfo.Error = err.Error()
+								}
+							}
 							commitInfo.Details.CompactingTime = types.DurationProto(compactingDuration)
 							commitInfo.Details.ValidatingTime = types.DurationProto(validatingDuration)
 							return nil
@@ -151,15 +186,18 @@ func (d *driver) finishCommits(ctx context.Context) error {
 	}, watch.IgnoreDelete)
 }
 
+// validate checks the file set for path collisions, and computes the size,
+// file count and distinct chunk bytes used by the commit.
 // TODO(2.0 optional): Improve the performance of this by doing a logarithmic lookup per new file,
 // rather than a linear scan through all of the files.
-func (d *driver) validate(ctx context.Context, id *fileset.ID) (int64, string, error) {
+func (d *driver) validate(ctx context.Context, id *fileset.ID) (*pfs.CommitInfo_Details, string, error) {
 	fs, err := d.storage.Open(ctx, []fileset.ID{*id})
 	if err != nil {
-		return 0, "", err
+		return nil, "", err
 	}
 	var prev *index.Index
-	var size int64
+	details := &pfs.CommitInfo_Details{}
+	chunks := make(map[string]struct{})
 	var validationError string
 	if err := fs.Iterate(ctx, func(f fileset.File) error {
 		idx := f.Index()
@@ -171,12 +209,19 @@ func (d *driver) validate(ctx context.Context, id *fileset.ID) (int64, string, e
 			}
 		}
 		prev = idx
-		size += index.SizeBytes(idx)
+		details.SizeBytes += index.SizeBytes(idx)
+		details.FileCount++
+		for _, dataRef := range idx.File.DataRefs {
+			if _, ok := chunks[string(dataRef.Ref.Id)]; !ok {
+				chunks[string(dataRef.Ref.Id)] = struct{}{}
+				details.ChunkBytes += dataRef.Ref.SizeBytes
+			}
+		}
 		return nil
 	}); err != nil {
-		return 0, "", err
+		return nil, "", err
 	}
-	return size, validationError, nil
+	return details, validationError, nil
 }
 
 // finishAliasDescendents will traverse the given commit's descendents, finding all
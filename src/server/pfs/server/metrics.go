@@ -0,0 +1,30 @@
+package server
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	commitFinishingDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_master",
+		Name:      "commit_finishing_duration_seconds",
+		Help:      "Time spent by the PFS master finishing commits, by step (compacting or validating)",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"step"})
+
+	compactionTasksPendingMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_master",
+		Name:      "compaction_tasks_pending",
+		Help:      "Number of compaction tasks that have been created and have not yet completed",
+	})
+
+	compactionTasksMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_master",
+		Name:      "compaction_tasks_total",
+		Help:      "Number of compaction tasks completed, by result (success or error)",
+	}, []string{"result"})
+)
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// enforceProtection returns an ErrBranchProtected with 'reason' unless the
+// caller holds the branch's bypass role on its repo.
+func (d *driver) enforceProtection(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo, reason string) error {
+	if role := branchInfo.Protection.GetBypassRole(); role != "" {
+		resp, err := d.env.AuthServer.GetPermissionsInTransaction(txnCtx, &auth.GetPermissionsRequest{
+			Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: branchInfo.Branch.Repo.Name},
+		})
+		if err != nil && !auth.IsErrNotActivated(err) {
+			return err
+		}
+		for _, r := range resp.GetRoles() {
+			if r == role {
+				return nil
+			}
+		}
+	}
+	return errors.EnsureStack(pfsserver.ErrBranchProtected{Branch: branchInfo.Branch, Reason: reason})
+}
+
+// checkProtectionUpdate verifies that the caller may replace the protection
+// rules of 'branchInfo' with 'protection'. Changing protection rules requires
+// the same permission as modifying the repo's role bindings.
+func (d *driver) checkProtectionUpdate(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo, protection *pfs.BranchProtection) error {
+	if protection == nil {
+		return nil
+	}
+	if proto.Equal(protection, &pfs.BranchProtection{}) && branchInfo.Protection == nil {
+		return nil
+	}
+	if proto.Equal(protection, branchInfo.Protection) {
+		return nil
+	}
+	return d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, branchInfo.Branch.Repo, auth.Permission_REPO_MODIFY_BINDINGS)
+}
+
+// checkHeadMove verifies that moving the head of the protected branch
+// 'branchInfo' to 'newHead' doesn't violate its protection rules.
+func (d *driver) checkHeadMove(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo, newHead *pfs.CommitInfo) error {
+	protection := branchInfo.Protection
+	if protection == nil {
+		return nil
+	}
+	if branchInfo.Head != nil && branchInfo.Head.ID == newHead.Commit.ID {
+		return nil
+	}
+	if protection.RequirePromotion && proto.Equal(newHead.Commit.Branch, branchInfo.Branch) {
+		if err := d.enforceProtection(txnCtx, branchInfo, "the head may only be moved to a commit from another branch"); err != nil {
+			return err
+		}
+	}
+	if protection.NoForceMove && branchInfo.Head != nil {
+		descends, err := d.descendsFrom(txnCtx, newHead, branchInfo.Head)
+		if err != nil {
+			return err
+		}
+		if !descends {
+			if err := d.enforceProtection(txnCtx, branchInfo, "the head may only be moved to a descendant of its current head"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// descendsFrom returns whether 'head' is 'commitInfo' or one of its ancestors.
+// Commits are compared by commitset, so that a commit from another branch
+// descends from this branch's head if its history includes the commit that
+// the head is an alias of. The empty commit that a branch is created with
+// when it has no head is an ancestor of every commit.
+func (d *driver) descendsFrom(txnCtx *txncontext.TransactionContext, commitInfo *pfs.CommitInfo, head *pfs.Commit) (bool, error) {
+	headInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(head, headInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if headInfo.ParentCommit == nil && headInfo.Origin.GetKind() == pfs.OriginKind_AUTO {
+		return true, nil
+	}
+	for {
+		if commitInfo.Commit.ID == head.ID {
+			return true, nil
+		}
+		if commitInfo.ParentCommit == nil {
+			return false, nil
+		}
+		parent := commitInfo.ParentCommit
+		commitInfo = &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(parent, commitInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+	}
+}
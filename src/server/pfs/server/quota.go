@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+var repoTypes = []string{pfs.UserRepoType, pfs.MetaRepoType, pfs.SpecRepoType}
+
+// setRepoQuota creates or replaces 'quota', or deletes it if it sets no limits.
+func (d *driver) setRepoQuota(txnCtx *txncontext.TransactionContext, quota *pfs.RepoQuota) error {
+	if (quota.Repo == nil) == (quota.Prefix == "") {
+		return errors.New("exactly one of repo and prefix must be set on a quota")
+	}
+	if quota.MaxBytes < 0 || quota.MaxFiles < 0 {
+		return errors.New("quota limits cannot be negative")
+	}
+	quotas := d.repoQuotas.ReadWrite(txnCtx.SqlTx)
+	if quota.MaxBytes == 0 && quota.MaxFiles == 0 {
+		if err := quotas.Delete(quota); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	if quota.Repo != nil {
+		if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(quota.Repo, &pfs.RepoInfo{}); err != nil {
+			if col.IsErrNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: quota.Repo}
+			}
+			return err
+		}
+	}
+	return quotas.Put(quota, quota)
+}
+
+func (d *driver) listRepoQuota(ctx context.Context, cb func(*pfs.RepoQuota) error) error {
+	quota := &pfs.RepoQuota{}
+	return d.repoQuotas.ReadOnly(ctx).List(quota, col.DefaultOptions(), func(string) error {
+		return cb(proto.Clone(quota).(*pfs.RepoQuota))
+	})
+}
+
+func addUsage(usage *pfs.RepoUsage, details *pfs.CommitInfo_Details) {
+	usage.LogicalBytes += details.SizeBytes
+	usage.ChunkBytes += details.ChunkBytes
+	usage.FileCount += details.FileCount
+}
+
+// repoUsage sums the usage of the finished heads of 'repo's branches.
+func (d *driver) repoUsage(txnCtx *txncontext.TransactionContext, repo *pfs.Repo) (*pfs.RepoUsage, error) {
+	usage := &pfs.RepoUsage{}
+	if err := d.addReposUsage(txnCtx, usage, []*pfs.Repo{repo}, map[string]bool{}); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// addReposUsage adds the usage of the finished heads of the branches of
+// 'repos' to 'usage'. Heads are resolved past alias commits, which share the
+// file set of the commit they alias, so each distinct commit is only counted
+// once; 'seen' holds the keys of the commits already counted.
+func (d *driver) addReposUsage(txnCtx *txncontext.TransactionContext, usage *pfs.RepoUsage, repos []*pfs.Repo, seen map[string]bool) error {
+	for _, repo := range repos {
+		repoInfo := &pfs.RepoInfo{}
+		if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(repo, repoInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				continue // deleted since the quota's repos were listed
+			}
+			return err
+		}
+		for _, branch := range repoInfo.Branches {
+			branchInfo := &pfs.BranchInfo{}
+			if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(branch, branchInfo); err != nil {
+				return err
+			}
+			if branchInfo.Head == nil {
+				continue
+			}
+			commitInfo, err := d.resolveAlias(txnCtx, branchInfo.Head)
+			if err != nil {
+				return err
+			}
+			key := pfsdb.CommitKey(commitInfo.Commit)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			if commitInfo.Details != nil {
+				addUsage(usage, commitInfo.Details)
+			}
+		}
+	}
+	return nil
+}
+
+func quotaExceeded(quota *pfs.RepoQuota, usage *pfs.RepoUsage) bool {
+	return (quota.MaxBytes > 0 && usage.LogicalBytes > quota.MaxBytes) ||
+		(quota.MaxFiles > 0 && usage.FileCount > quota.MaxFiles)
+}
+
+// quotaReached returns whether 'usage' leaves no room under 'quota' for new
+// data.
+func quotaReached(quota *pfs.RepoQuota, usage *pfs.RepoUsage) bool {
+	return (quota.MaxBytes > 0 && usage.LogicalBytes >= quota.MaxBytes) ||
+		(quota.MaxFiles > 0 && usage.FileCount >= quota.MaxFiles)
+}
+
+// quotaScope is a quota that applies to a repo, along with every other repo it
+// applies to.
+type quotaScope struct {
+	quota *pfs.RepoQuota
+	repos []*pfs.Repo
+}
+
+// repoQuotaScopes returns the quotas that apply to 'repo': its own quota, and
+// the quotas of the prefixes of its name. It reads the repos matching each
+// prefix outside of a transaction, so that quota checks don't scan every repo
+// while holding one open.
+func (d *driver) repoQuotaScopes(ctx context.Context, repo *pfs.Repo) ([]*quotaScope, error) {
+	quotas := d.repoQuotas.ReadOnly(ctx)
+	var scopes []*quotaScope
+	quota := &pfs.RepoQuota{}
+	if err := quotas.Get(&pfs.RepoQuota{Repo: repo}, quota); err == nil {
+		scopes = append(scopes, &quotaScope{quota: proto.Clone(quota).(*pfs.RepoQuota)})
+	} else if !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	var prefixScopes []*quotaScope
+	if err := quotas.GetByIndex(pfsdb.RepoQuotasPrefixIndex, "true", quota, col.DefaultOptions(), func(string) error {
+		if strings.HasPrefix(repo.Name, quota.Prefix) {
+			prefixScopes = append(prefixScopes, &quotaScope{quota: proto.Clone(quota).(*pfs.RepoQuota)})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(prefixScopes) == 0 {
+		return scopes, nil
+	}
+	repoInfo := &pfs.RepoInfo{}
+	for _, repoType := range repoTypes {
+		if err := d.repos.ReadOnly(ctx).GetByIndex(pfsdb.ReposTypeIndex, repoType, repoInfo, col.DefaultOptions(), func(string) error {
+			if proto.Equal(repoInfo.Repo, repo) {
+				return nil
+			}
+			for _, scope := range prefixScopes {
+				if strings.HasPrefix(repoInfo.Repo.Name, scope.quota.Prefix) {
+					scope.repos = append(scope.repos, proto.Clone(repoInfo.Repo).(*pfs.Repo))
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return append(scopes, prefixScopes...), nil
+}
+
+// checkRepoQuota returns an ErrQuotaExceeded if finishing 'commitInfo', whose
+// details have been computed, would put its repo over one of 'scopes'.
+func (d *driver) checkRepoQuota(txnCtx *txncontext.TransactionContext, commitInfo *pfs.CommitInfo, scopes []*quotaScope) error {
+	repo := commitInfo.Commit.Branch.Repo
+	for _, scope := range scopes {
+		// count 'commitInfo' instead of the head it replaces
+		usage := &pfs.RepoUsage{}
+		seen := map[string]bool{pfsdb.CommitKey(commitInfo.Commit): true}
+		addUsage(usage, commitInfo.Details)
+		if err := d.addReposUsage(txnCtx, usage, append([]*pfs.Repo{repo}, scope.repos...), seen); err != nil {
+			return err
+		}
+		if quotaExceeded(scope.quota, usage) {
+			return errors.EnsureStack(pfsserver.ErrQuotaExceeded{Quota: scope.quota, Usage: usage})
+		}
+	}
+	return nil
+}
+
+// checkRepoQuotaReached returns an ErrQuotaExceeded if 'repo' is already at
+// one of its quotas, so that writes that add data to it can be rejected right
+// away, rather than failing the commit once it's finished.
+func (d *driver) checkRepoQuotaReached(ctx context.Context, repo *pfs.Repo) error {
+	scopes, err := d.repoQuotaScopes(ctx, repo)
+	if err != nil || len(scopes) == 0 {
+		return err
+	}
+	return d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		for _, scope := range scopes {
+			usage := &pfs.RepoUsage{}
+			if err := d.addReposUsage(txnCtx, usage, append([]*pfs.Repo{repo}, scope.repos...), map[string]bool{}); err != nil {
+				return err
+			}
+			if quotaReached(scope.quota, usage) {
+				return errors.EnsureStack(pfsserver.ErrQuotaExceeded{Quota: scope.quota, Usage: usage})
+			}
+		}
+		return nil
+	})
+}
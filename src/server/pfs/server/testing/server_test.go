@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
@@ -30,6 +31,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
@@ -706,6 +708,184 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, 0, len(fis))
 	})
 
+	suite.Run("BranchProtection", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit1, err := env.PachClient.StartCommit(repo, "staging")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "file", strings.NewReader("1")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "staging", commit1.ID))
+		require.NoError(t, env.PachClient.CreateBranch(repo, "master", "staging", "", nil))
+		require.NoError(t, env.PachClient.ProtectBranch(repo, "master", &pfs.BranchProtection{
+			NoDirectCommits:  true,
+			RequirePromotion: true,
+			NoDelete:         true,
+			NoForceMove:      true,
+		}))
+		branchInfo, err := env.PachClient.InspectBranch(repo, "master")
+		require.NoError(t, err)
+		require.True(t, branchInfo.Protection.NoDirectCommits)
+
+		_, err = env.PachClient.StartCommit(repo, "master")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+
+		// Promoting from another branch is allowed
+		commit2, err := env.PachClient.StartCommit(repo, "staging")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "file", strings.NewReader("2")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "staging", commit2.ID))
+		require.NoError(t, env.PachClient.CreateBranch(repo, "master", "staging", "", nil))
+		headInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, commit2.ID, headInfo.Commit.ID)
+
+		// Moving it to a commit that doesn't descend from the head isn't allowed
+		commit3, err := env.PachClient.StartCommit(repo, "unrelated")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit3, "file", strings.NewReader("3")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "unrelated", commit3.ID))
+		err = env.PachClient.CreateBranch(repo, "master", "unrelated", commit3.ID, nil)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+
+		// Moving the head back into its own history is not
+		err = env.PachClient.CreateBranch(repo, "master", "master", commit1.ID, nil)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+
+		err = env.PachClient.DeleteBranch(repo, "master", true)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+
+		// Clearing the protection allows the branch to be deleted
+		require.NoError(t, env.PachClient.ProtectBranch(repo, "master", nil))
+		require.NoError(t, env.PachClient.DeleteBranch(repo, "master", false))
+	})
+
+	suite.Run("RepoQuota", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "quota"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, env.PachClient.SetRepoQuota(repo, 0, 3))
+		quotas, err := env.PachClient.ListRepoQuota()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(quotas))
+		require.Equal(t, int64(3), quotas[0].MaxFiles)
+
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "a", strings.NewReader("a")))
+		require.NoError(t, env.PachClient.PutFile(commit1, "b", strings.NewReader("b")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit1.ID))
+		commitInfo, err := env.PachClient.InspectCommit(repo, "master", commit1.ID)
+		require.NoError(t, err)
+		require.Equal(t, "", commitInfo.Error)
+		require.Equal(t, int64(2), commitInfo.Details.FileCount)
+
+		repoInfos, err := env.PachClient.ListRepoUsage(pfs.UserRepoType)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfos))
+		require.Equal(t, int64(2), repoInfos[0].Details.Usage.FileCount)
+		require.Equal(t, int64(2), repoInfos[0].Details.Usage.LogicalBytes)
+
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "c", strings.NewReader("c")))
+		require.NoError(t, env.PachClient.PutFile(commit2, "d", strings.NewReader("d")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit2.ID))
+		commitInfo, err = env.PachClient.InspectCommit(repo, "master", commit2.ID)
+		require.NoError(t, err)
+		require.True(t, pfsserver.IsQuotaExceededErr(errors.New(commitInfo.Error)))
+
+		// Once the repo is at its quota, writes that add data are rejected
+		// right away, but deletes aren't, so that the repo can be cleaned up
+		commit3, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		err = env.PachClient.PutFile(commit3, "e", strings.NewReader("e"))
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsQuotaExceededErr(err))
+		require.NoError(t, env.PachClient.DeleteFile(commit3, "c"))
+		require.NoError(t, env.PachClient.DeleteFile(commit3, "d"))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit3.ID))
+		commitInfo, err = env.PachClient.InspectCommit(repo, "master", commit3.ID)
+		require.NoError(t, err)
+		require.Equal(t, "", commitInfo.Error)
+		repoInfos, err = env.PachClient.ListRepoUsage(pfs.UserRepoType)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), repoInfos[0].Details.Usage.FileCount)
+
+		// Cleaning up leaves room under the quota for new data
+		commit4, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit4, "e", strings.NewReader("e")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit4.ID))
+		commitInfo, err = env.PachClient.InspectCommit(repo, "master", commit4.ID)
+		require.NoError(t, err)
+		require.Equal(t, "", commitInfo.Error)
+	})
+
+	suite.Run("SpoutCommit", func(t *testing.T) {
+		// not parallel, as WithSpoutCommit reads the pipeline name from the
+		// environment
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "spout"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, os.Setenv(client.PPSPipelineNameEnv, repo))
+		defer os.Unsetenv(client.PPSPipelineNameEnv)
+
+		// The output and the checkpoint are committed together
+		require.NoError(t, env.PachClient.WithSpoutCommit("master", "marker", func(commit *pfs.Commit) (io.Reader, error) {
+			if err := env.PachClient.PutFile(commit, "file", strings.NewReader("foo")); err != nil {
+				return nil, err
+			}
+			return strings.NewReader("1"), nil
+		}))
+		outputInfo, err := env.PachClient.WaitCommit(repo, "master", "")
+		require.NoError(t, err)
+		markerInfo, err := env.PachClient.WaitCommit(repo, ppsconsts.SpoutMarkerBranch, "")
+		require.NoError(t, err)
+		require.Equal(t, outputInfo.Commit.ID, markerInfo.Commit.ID)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(outputInfo.Commit, "file", &buf))
+		require.Equal(t, "foo", buf.String())
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(markerInfo.Commit, "marker", &buf))
+		require.Equal(t, "1", buf.String())
+
+		// If the callback fails, neither its output nor a new checkpoint is
+		// committed
+		require.YesError(t, env.PachClient.WithSpoutCommit("master", "marker", func(commit *pfs.Commit) (io.Reader, error) {
+			if err := env.PachClient.PutFile(commit, "file", strings.NewReader("bar")); err != nil {
+				return nil, err
+			}
+			return nil, errors.New("spout failed")
+		}))
+		outputInfo, err = env.PachClient.WaitCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.NotEqual(t, markerInfo.Commit.ID, outputInfo.Commit.ID)
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(outputInfo.Commit, "file", &buf))
+		require.Equal(t, "foo", buf.String())
+		markerInfo, err = env.PachClient.WaitCommit(repo, ppsconsts.SpoutMarkerBranch, "")
+		require.NoError(t, err)
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(markerInfo.Commit, "marker", &buf))
+		require.Equal(t, "1", buf.String())
+
+		// WithSpoutCommit can only be called from a spout
+		require.NoError(t, os.Unsetenv(client.PPSPipelineNameEnv))
+		require.YesError(t, env.PachClient.WithSpoutCommit("master", "marker", func(*pfs.Commit) (io.Reader, error) {
+			return strings.NewReader("2"), nil
+		}))
+	})
+
 	suite.Run("CreateInvalidBranchName", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// recentChunks calls cb with the chunks referenced by commits which are
+// unfinished, were finished within the cold tier's minimum age, or are the head
+// of a branch. These chunks are kept in the hot tier.
+func (d *driver) recentChunks(ctx context.Context, cb func(chunk.ID) error) error {
+	cutoff := time.Now().Add(-d.coldTierMinAge)
+	var commits []*pfs.Commit
+	commitInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadOnly(ctx).List(commitInfo, col.DefaultOptions(), func(string) error {
+		if commitInfo.Finished != nil {
+			finished, err := types.TimestampFromProto(commitInfo.Finished)
+			if err != nil {
+				return err
+			}
+			if finished.Before(cutoff) {
+				return nil
+			}
+		}
+		commits = append(commits, commitInfo.Commit)
+		return nil
+	}); err != nil {
+		return err
+	}
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadOnly(ctx).List(branchInfo, col.DefaultOptions(), func(string) error {
+		if branchInfo.Head != nil {
+			commits = append(commits, branchInfo.Head)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	var ids []fileset.ID
+	for _, commit := range commits {
+		id, err := d.getFileSet(ctx, commit)
+		if err != nil {
+			if pfsserver.IsCommitNotFoundErr(err) {
+				continue
+			}
+			return err
+		}
+		ids = append(ids, *id)
+	}
+	for _, id := range ids {
+		if err := d.storage.Chunks(ctx, []fileset.ID{id}, cb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pager"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/extended"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -70,6 +71,7 @@ If the job fails, the output commit will not be populated with data.`,
 	}
 	commands = append(commands, cmdutil.CreateDocsAlias(jobDocs, "job", " job$"))
 
+	var jobUsage bool
 	inspectJob := &cobra.Command{
 		Use:   "{{alias}} <pipeline>@<job>",
 		Short: "Return info about a job.",
@@ -79,14 +81,33 @@ If the job fails, the output commit will not be populated with data.`,
 			if err != nil {
 				return err
 			}
+			if raw && jobUsage {
+				return errors.New("cannot set both --raw and --usage")
+			}
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer client.Close()
-			jobInfo, err := client.InspectJob(job.Pipeline.Name, job.ID, true)
+			// use the parsed job, which has the branch of a shadow candidate's job
+			jobInfo, err := client.PpsAPIClient.InspectJob(client.Ctx(), &ppsclient.InspectJobRequest{
+				Job:     job,
+				Details: true,
+			})
 			if err != nil {
-				return errors.Wrap(err, "error from InspectJob")
+				return errors.Wrap(grpcutil.ScrubGRPC(err), "error from InspectJob")
+			}
+			if jobUsage {
+				pretty.PrintJobUsage(os.Stdout, jobInfo)
+				fmt.Println("Datums:")
+				writer := tabwriter.NewWriter(os.Stdout, pretty.DatumUsageHeader)
+				if err := client.ListDatum(job.Pipeline.Name, job.ID, func(di *ppsclient.DatumInfo) error {
+					pretty.PrintDatumUsage(writer, di)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return writer.Flush()
 			}
 			if raw {
 				return cmdutil.Encoder(output, os.Stdout).EncodeProto(jobInfo)
@@ -100,6 +121,7 @@ If the job fails, the output commit will not be populated with data.`,
 			return pretty.PrintDetailedJobInfo(os.Stdout, pji)
 		}),
 	}
+	inspectJob.Flags().BoolVar(&jobUsage, "usage", false, "Return the CPU time, peak memory, and GPU time used by the job and each of its datums.")
 	inspectJob.Flags().AddFlagSet(outputFlags)
 	inspectJob.Flags().AddFlagSet(timestampFlags)
 	shell.RegisterCompletionFunc(inspectJob, shell.JobCompletion)
@@ -681,7 +703,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		Short: "Create a new pipeline.",
 		Long:  "Create a new pipeline from a pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(false, pushImages, registry, username, pipelinePath, false)
+			return pipelineHelper(false, pushImages, registry, username, pipelinePath, false, nil)
 		}),
 	}
 	createPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
@@ -691,11 +713,24 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	commands = append(commands, cmdutil.CreateAlias(createPipeline, "create pipeline"))
 
 	var reprocess bool
+	var canary uint32
+	var shadow string
 	updatePipeline := &cobra.Command{
 		Short: "Update an existing Pachyderm pipeline.",
-		Long:  "Update a Pachyderm pipeline with a new pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
+		Long: `Update a Pachyderm pipeline with a new pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.
+
+With --canary or --shadow, the new specification becomes a candidate version of
+the pipeline, which runs alongside the current version until it's promoted with
+'pachctl promote pipeline'.`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(reprocess, pushImages, registry, username, pipelinePath, true)
+			var candidate *ppsclient.CandidateSpec
+			if canary != 0 || shadow != "" {
+				candidate = &ppsclient.CandidateSpec{
+					CanaryPercent: canary,
+					ShadowBranch:  shadow,
+				}
+			}
+			return pipelineHelper(reprocess, pushImages, registry, username, pipelinePath, true, candidate)
 		}),
 	}
 	updatePipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
@@ -703,6 +738,8 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	updatePipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
 	updatePipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as.")
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
+	updatePipeline.Flags().Uint32Var(&canary, "canary", 0, "If set, run the new version as a canary on this percentage of the pipeline's output commits.")
+	updatePipeline.Flags().StringVar(&shadow, "shadow", "", "If set, run the new version as a shadow that writes to this branch of the pipeline's output repo.")
 	commands = append(commands, cmdutil.CreateAlias(updatePipeline, "update pipeline"))
 
 	runCron := &cobra.Command{
@@ -836,6 +873,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	commands = append(commands, cmdutil.CreateAlias(editPipeline, "edit pipeline"))
 
 	var spec bool
+	var pipelineUsage bool
 	listPipeline := &cobra.Command{
 		Use:   "{{alias}} [<pipeline>]",
 		Short: "Return info about all pipelines.",
@@ -844,6 +882,8 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			// validate flags
 			if raw && spec {
 				return errors.Errorf("cannot set both --raw and --spec")
+			} else if pipelineUsage && (raw || spec) {
+				return errors.Errorf("cannot set --usage with --raw or --spec")
 			} else if !raw && !spec && output != "" {
 				return errors.New("cannot set --output (-o) without --raw or --spec")
 			}
@@ -896,6 +936,25 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 					}
 				}
 				return nil
+			} else if pipelineUsage {
+				// Usage is reported for the jobs of all versions of each pipeline
+				writer := tabwriter.NewWriter(os.Stdout, pretty.PipelineUsageHeader)
+				seen := make(map[string]bool)
+				for _, pipelineInfo := range pipelineInfos {
+					if seen[pipelineInfo.Pipeline.Name] {
+						continue
+					}
+					seen[pipelineInfo.Pipeline.Name] = true
+					u := &pretty.PipelineUsage{Pipeline: pipelineInfo.Pipeline.Name}
+					if err := client.ListJobF(pipelineInfo.Pipeline.Name, nil, -1, false, func(ji *ppsclient.JobInfo) error {
+						u.AddJob(ji)
+						return nil
+					}); err != nil {
+						return err
+					}
+					pretty.PrintPipelineUsage(writer, u)
+				}
+				return writer.Flush()
 			}
 			for _, pi := range pipelineInfos {
 				if ppsutil.ErrorState(pi.State) {
@@ -911,6 +970,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		}),
 	}
 	listPipeline.Flags().BoolVarP(&spec, "spec", "s", false, "Output 'create pipeline' compatibility specs.")
+	listPipeline.Flags().BoolVar(&pipelineUsage, "usage", false, "Report the total CPU time, peak memory, and GPU time used by each pipeline's jobs.")
 	listPipeline.Flags().AddFlagSet(outputFlags)
 	listPipeline.Flags().AddFlagSet(timestampFlags)
 	listPipeline.Flags().StringVar(&history, "history", "none", "Return revision history for pipelines.")
@@ -993,6 +1053,72 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	}
 	commands = append(commands, cmdutil.CreateAlias(stopPipeline, "stop pipeline"))
 
+	promotePipeline := &cobra.Command{
+		Use:   "{{alias}} <pipeline>",
+		Short: "Promote a pipeline's candidate version.",
+		Long:  "Promote a pipeline's canary or shadow candidate version, making it the pipeline's current version.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if err := client.PromotePipeline(args[0]); err != nil {
+				return errors.Wrap(err, "error from PromotePipeline")
+			}
+			return nil
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(promotePipeline, "promote pipeline"))
+
+	var toVersion uint64
+	var reprocessRollback, reuseOutputs bool
+	rollbackPipeline := &cobra.Command{
+		Use:   "{{alias}} <pipeline> --to <version>",
+		Short: "Roll a pipeline back to one of its previous versions.",
+		Long: `Roll a pipeline back to one of its previous versions, which 'pachctl list pipeline <pipeline> --history all' lists.
+
+The pipeline is updated to the spec of that version. As with any update, unless
+--reprocess is set, only new and changed datums are processed, and unchanged
+datums keep their current outputs, even if a later version processed them.
+With --reuse-outputs, unchanged datums only keep their current outputs if they
+were processed with the salt of the version rolled back to, i.e. by that version
+or by updates of it that didn't reprocess.`,
+		Example: `
+# roll back pipeline "foo" to its second version
+$ {{alias}} foo --to 2
+
+# roll back pipeline "foo" to its second version, reprocessing the datums
+# whose outputs weren't processed with its salt
+$ {{alias}} foo --to 2 --reuse-outputs`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			if toVersion == 0 {
+				return errors.New("--to must be set to the version to roll back to")
+			}
+			if reprocessRollback && reuseOutputs {
+				return errors.New("--reprocess and --reuse-outputs can't both be set")
+			}
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if reuseOutputs {
+				err = client.RollbackPipelineReuseOutputs(args[0], toVersion)
+			} else {
+				err = client.RollbackPipeline(args[0], toVersion, reprocessRollback)
+			}
+			if err != nil {
+				return errors.Wrap(err, "error from RollbackPipeline")
+			}
+			return nil
+		}),
+	}
+	rollbackPipeline.Flags().Uint64Var(&toVersion, "to", 0, "The version of the pipeline to roll back to.")
+	rollbackPipeline.Flags().BoolVar(&reprocessRollback, "reprocess", false, "If true, reprocess all datums with the version rolled back to, rather than keeping the current outputs of unchanged datums.")
+	rollbackPipeline.Flags().BoolVar(&reuseOutputs, "reuse-outputs", false, "If true, restore the salt of the version rolled back to, so that unchanged datums only keep their current outputs if they were processed with it.")
+	commands = append(commands, cmdutil.CreateAlias(rollbackPipeline, "rollback pipeline"))
+
 	var file string
 	createSecret := &cobra.Command{
 		Short: "Create a secret on the cluster.",
@@ -1104,6 +1230,121 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	}
 	commands = append(commands, cmdutil.CreateAlias(listSecret, "list secret"))
 
+	notificationDocs := &cobra.Command{
+		Short: "Docs for notifications.",
+		Long: `Notifications send events about pipelines, jobs and datums to a webhook.
+
+Cluster-wide notifications are created with 'create notification'. Notifications can also be set in a pipeline's spec.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(notificationDocs, "notification", " notification$"))
+
+	var updateNotification bool
+	createNotification := &cobra.Command{
+		Short: "Create a cluster-wide notification.",
+		Long:  "Create a cluster-wide notification from a JSON or YAML spec.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			var specBytes []byte
+			if file == "-" {
+				specBytes, err = ioutil.ReadAll(os.Stdin)
+			} else {
+				specBytes, err = ioutil.ReadFile(file)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "could not read notification spec")
+			}
+			notification := &ppsclient.Notification{}
+			if err := serde.Decode(specBytes, notification); err != nil {
+				return errors.Wrapf(err, "could not parse notification spec")
+			}
+			return client.CreateNotification(notification, updateNotification)
+		}),
+	}
+	createNotification.Flags().StringVarP(&file, "file", "f", "-", "The JSON or YAML file containing the notification spec (\"-\" reads from stdin).")
+	createNotification.Flags().BoolVar(&updateNotification, "update", false, "Replace the notification if it already exists.")
+	commands = append(commands, cmdutil.CreateAlias(createNotification, "create notification"))
+
+	deleteNotification := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Delete a cluster-wide notification.",
+		Long:  "Delete a cluster-wide notification, and the events waiting to be sent to it.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.DeleteNotification(args[0])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteNotification, "delete notification"))
+
+	listNotification := &cobra.Command{
+		Short: "List cluster-wide notifications.",
+		Long:  "List cluster-wide notifications, with the number of events waiting to be sent to them and the number of events that could not be sent.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			notificationInfos, err := client.ListNotification()
+			if err != nil {
+				return err
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.NotificationHeader)
+			for _, ni := range notificationInfos {
+				pretty.PrintNotificationInfo(writer, ni)
+			}
+			return writer.Flush()
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(listNotification, "list notification"))
+
+	var cursor string
+	var watchRepos, watchPipelines []string
+	subscribeEvents := &cobra.Command{
+		Short: "Print changes to repos, branches, commits, jobs and pipelines.",
+		Long:  "Print changes to repos, branches, commits, jobs and pipelines as they happen. Each event has a cursor, which can be passed to --cursor to resume after that event.",
+		Example: `
+# print all changes from now on
+$ {{alias}}
+
+# print changes to the repo "images" and the pipeline "edges"
+$ {{alias}} --repo images --pipeline edges
+
+# print changes after the event with cursor XXX
+$ {{alias}} --cursor XXX`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			var repos []*pfs.Repo
+			for _, repo := range watchRepos {
+				repos = append(repos, pachdclient.NewRepo(repo))
+			}
+			var pipelines []*pps.Pipeline
+			for _, pipeline := range watchPipelines {
+				pipelines = append(pipelines, pachdclient.NewPipeline(pipeline))
+			}
+			encoder := cmdutil.Encoder(output, os.Stdout)
+			return client.WatchEvents(cursor, repos, pipelines, func(event *pps.WatchEvent) error {
+				return encoder.EncodeProto(event)
+			})
+		}),
+	}
+	subscribeEvents.Flags().StringVar(&cursor, "cursor", "", "Print the changes after the event with this cursor.")
+	subscribeEvents.Flags().StringSliceVar(&watchRepos, "repo", nil, "Only print changes to this repo (can be repeated).")
+	subscribeEvents.Flags().StringSliceVar(&watchPipelines, "pipeline", nil, "Only print changes to this pipeline and its repos (can be repeated).")
+	subscribeEvents.Flags().StringVarP(&output, "output", "o", "", "Output format: \"json\" or \"yaml\" (default \"json\")")
+	commands = append(commands, cmdutil.CreateAlias(subscribeEvents, "subscribe events"))
+
 	var seed int64
 	runLoadTest := &cobra.Command{
 		Use:   "{{alias}} <spec-file> ",
@@ -1205,7 +1446,7 @@ func readPipelineBytes(pipelinePath string) (pipelineBytes []byte, retErr error)
 	return pipelineBytes, nil
 }
 
-func pipelineHelper(reprocess bool, pushImages bool, registry, username, pipelinePath string, update bool) error {
+func pipelineHelper(reprocess bool, pushImages bool, registry, username, pipelinePath string, update bool, candidate *ppsclient.CandidateSpec) error {
 	pipelineBytes, err := readPipelineBytes(pipelinePath)
 	if err != nil {
 		return err
@@ -1246,6 +1487,9 @@ func pipelineHelper(reprocess bool, pushImages bool, registry, username, pipelin
 		if update {
 			request.Update = true
 			request.Reprocess = reprocess
+			if candidate != nil {
+				request.Candidate = candidate
+			}
 		}
 
 		if pushImages {
@@ -32,6 +32,12 @@ const (
 	DatumHeader = "ID\tFILES\tSTATUS\tTIME\t\n"
 	// SecretHeader is the header for secrets
 	SecretHeader = "NAME\tTYPE\tCREATED\t\n"
+	// NotificationHeader is the header for notifications
+	NotificationHeader = "NAME\tSINK\tPIPELINES\tPENDING\tDEAD LETTERED\t\n"
+	// DatumUsageHeader is the header for the resource usage of datums
+	DatumUsageHeader = "ID\tSTATUS\tCPU SECONDS\tPEAK MEMORY\tGPU SECONDS\t\n"
+	// PipelineUsageHeader is the header for the resource usage of pipelines
+	PipelineUsageHeader = "PIPELINE\tJOBS\tDATUMS\tCPU SECONDS\tPEAK MEMORY\tGPU SECONDS\t\n"
 	// jobReasonLen is the amount of the job reason that we print
 	jobReasonLen = 25
 )
@@ -45,7 +51,11 @@ func safeTrim(s string, l int) string {
 
 // PrintJobInfo pretty-prints job info.
 func PrintJobInfo(w io.Writer, jobInfo *ppsclient.JobInfo, fullTimestamps bool) {
-	fmt.Fprintf(w, "%s\t", jobInfo.Job.Pipeline.Name)
+	if jobInfo.Job.Branch != "" {
+		fmt.Fprintf(w, "%s@%s\t", jobInfo.Job.Pipeline.Name, jobInfo.Job.Branch)
+	} else {
+		fmt.Fprintf(w, "%s\t", jobInfo.Job.Pipeline.Name)
+	}
 	fmt.Fprintf(w, "%s\t", jobInfo.Job.ID)
 	if jobInfo.Started != nil {
 		if fullTimestamps {
@@ -190,7 +200,8 @@ func NewPrintableJobInfo(ji *ppsclient.JobInfo) *PrintableJobInfo {
 func PrintDetailedJobInfo(w io.Writer, jobInfo *PrintableJobInfo) error {
 	template, err := template.New("JobInfo").Funcs(funcMap).Parse(
 		`ID: {{.Job.ID}}
-Pipeline: {{.Job.Pipeline.Name}}{{if .FullTimestamps}}
+Pipeline: {{.Job.Pipeline.Name}}{{if .Job.Branch}}
+Shadow Branch: {{.Job.Branch}}{{end}}{{if .FullTimestamps}}
 Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}} {{end}}{{if .Finished}}
 Duration: {{prettyTimeDifference .Started .Finished}} {{end}}
@@ -262,8 +273,11 @@ Created: {{prettyAgo .Details.CreatedAt}} {{end}}
 State: {{pipelineState .State}}
 Reason: {{.Reason}}
 Workers Available: {{.Details.WorkersAvailable}}/{{.Details.WorkersRequested}}
-Stopped: {{ .Stopped }}
-Parallelism Spec: {{.Details.ParallelismSpec}}
+Stopped: {{ .Stopped }}{{if .Details.Candidate}}
+Candidate: {{prettyCandidate .Details.Candidate}}{{end}}{{if .Details.Priority}}
+Priority: {{.Details.Priority}}{{end}}
+Parallelism Spec: {{.Details.ParallelismSpec}}{{if .Details.AutoscalerStatus}}
+Autoscaler: {{.Details.AutoscalerStatus.TargetWorkers}} workers ({{.Details.AutoscalerStatus.Reason}}){{end}}
 {{ if .Details.ResourceRequests }}ResourceRequests:
   CPU: {{ .Details.ResourceRequests.Cpu }}
   Memory: {{ .Details.ResourceRequests.Memory }} {{end}}
@@ -356,6 +370,13 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 		uploadTime = ul.String()
 	}
 	fmt.Fprintf(w, "Upload Time\t%s\n", uploadTime)
+	fmt.Fprintf(w, "CPU Seconds\t%s\n", seconds(datumInfo.Stats.CpuSeconds))
+	fmt.Fprintf(w, "Peak Memory\t%s\n", pretty.Size(datumInfo.Stats.PeakMemoryBytes))
+	fmt.Fprintf(w, "GPU Seconds\t%s\n", seconds(datumInfo.Stats.GpuSeconds))
+
+	if datumInfo.LogFile != nil {
+		fmt.Fprintf(w, "Log File\t%s:%s\n", datumInfo.LogFile.Commit, datumInfo.LogFile.Path)
+	}
 
 	fmt.Fprintf(w, "PFS State:\n")
 	tw := ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
@@ -369,6 +390,78 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 		PrintFile(tw, d.File)
 	}
 	tw.Flush()
+	if len(datumInfo.Attempts) > 0 {
+		fmt.Fprintf(w, "Attempts:\n")
+		tw = ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
+		fmt.Fprintf(tw, "  STARTED\tDURATION\tEXIT CODE\tRETRYABLE\tERROR\t\n")
+		for _, attempt := range datumInfo.Attempts {
+			var exitCode, retryable string
+			if attempt.Error != "" {
+				exitCode = "-"
+				if attempt.ExitCode != 0 {
+					exitCode = fmt.Sprint(attempt.ExitCode)
+				}
+				retryable = fmt.Sprint(attempt.Retryable)
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t\n", pretty.Ago(attempt.Started), pretty.Duration(attempt.Duration), exitCode, retryable, attempt.Error)
+		}
+		tw.Flush()
+	}
+}
+
+// PrintJobUsage pretty-prints the resources used by a job's user code.
+func PrintJobUsage(w io.Writer, jobInfo *ppsclient.JobInfo) {
+	stats := jobInfo.Stats
+	if stats == nil {
+		stats = &ppsclient.ProcessStats{}
+	}
+	fmt.Fprintf(w, "Job: %s@%s\n", jobInfo.Job.Pipeline.Name, jobInfo.Job.ID)
+	fmt.Fprintf(w, "Datums Processed: %d\n", jobInfo.DataProcessed+jobInfo.DataFailed+jobInfo.DataRecovered)
+	fmt.Fprintf(w, "CPU Seconds: %s\n", seconds(stats.CpuSeconds))
+	fmt.Fprintf(w, "Peak Memory: %s\n", pretty.Size(stats.PeakMemoryBytes))
+	fmt.Fprintf(w, "GPU Seconds: %s\n", seconds(stats.GpuSeconds))
+}
+
+// PrintDatumUsage pretty-prints the resources used by a datum's user code.
+func PrintDatumUsage(w io.Writer, datumInfo *ppsclient.DatumInfo) {
+	stats := datumInfo.Stats
+	if stats == nil {
+		stats = &ppsclient.ProcessStats{}
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", datumInfo.Datum.ID, datumState(datumInfo.State),
+		seconds(stats.CpuSeconds), pretty.Size(stats.PeakMemoryBytes), seconds(stats.GpuSeconds))
+}
+
+// PipelineUsage is the total resource usage of a pipeline's jobs.
+type PipelineUsage struct {
+	Pipeline string
+	Jobs     int64
+	Datums   int64
+	Stats    ppsclient.ProcessStats
+}
+
+// AddJob adds the resources used by a job to u.
+func (u *PipelineUsage) AddJob(jobInfo *ppsclient.JobInfo) {
+	u.Jobs++
+	u.Datums += jobInfo.DataProcessed + jobInfo.DataFailed + jobInfo.DataRecovered
+	if jobInfo.Stats == nil {
+		return
+	}
+	u.Stats.CpuSeconds += jobInfo.Stats.CpuSeconds
+	u.Stats.GpuSeconds += jobInfo.Stats.GpuSeconds
+	if jobInfo.Stats.PeakMemoryBytes > u.Stats.PeakMemoryBytes {
+		u.Stats.PeakMemoryBytes = jobInfo.Stats.PeakMemoryBytes
+	}
+}
+
+// PrintPipelineUsage pretty-prints the resource usage of a pipeline.
+func PrintPipelineUsage(w io.Writer, usage *PipelineUsage) {
+	fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t\n", usage.Pipeline, usage.Jobs, usage.Datums,
+		seconds(usage.Stats.CpuSeconds), pretty.Size(usage.Stats.PeakMemoryBytes), seconds(usage.Stats.GpuSeconds))
+}
+
+func seconds(s float64) string {
+	return fmt.Sprintf("%.2f", s)
 }
 
 // PrintSecretInfo pretty-prints secret info.
@@ -376,6 +469,20 @@ func PrintSecretInfo(w io.Writer, secretInfo *ppsclient.SecretInfo) {
 	fmt.Fprintf(w, "%s\t%s\t%s\t\n", secretInfo.Secret.Name, secretInfo.Type, pretty.Ago(secretInfo.CreationTimestamp))
 }
 
+// PrintNotificationInfo pretty-prints notification info.
+func PrintNotificationInfo(w io.Writer, notificationInfo *ppsclient.NotificationInfo) {
+	n := notificationInfo.Notification
+	sink := "-"
+	if n.Webhook != nil {
+		sink = n.Webhook.URL
+	}
+	pipelines := "*"
+	if len(n.Pipelines) > 0 {
+		pipelines = strings.Join(n.Pipelines, ",")
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t\n", n.Name, sink, pipelines, notificationInfo.Pending, notificationInfo.DeadLettered)
+}
+
 // PrintFileHeader prints the header for a pfs file.
 func PrintFileHeader(w io.Writer) {
 	fmt.Fprintf(w, "  REPO\tCOMMIT\tPATH\t\n")
@@ -486,6 +593,13 @@ func pipelineInput(pipelineInfo *ppsclient.PipelineInfo) string {
 	return string(input) + "\n"
 }
 
+func prettyCandidate(candidate *ppsclient.CandidateSpec) string {
+	if candidate.ShadowBranch != "" {
+		return fmt.Sprintf("shadow, writing to branch %q", candidate.ShadowBranch)
+	}
+	return fmt.Sprintf("canary, on %d%% of output commits", candidate.CanaryPercent)
+}
+
 func prettyTransform(transform *ppsclient.Transform) (string, error) {
 	result, err := json.MarshalIndent(transform, "", "  ")
 	if err != nil {
@@ -543,4 +657,5 @@ var funcMap = template.FuncMap{
 	"prettyDuration":       pretty.Duration,
 	"prettySize":           pretty.Size,
 	"prettyTransform":      prettyTransform,
+	"prettyCandidate":      prettyCandidate,
 }
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -24,6 +23,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -36,6 +36,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/lokiutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
@@ -99,9 +100,12 @@ type apiServer struct {
 	port                  uint16
 	peerPort              uint16
 	gcPercent             int
+	// workerRuntime runs pipeline workers (in kubernetes, or locally)
+	workerRuntime workerRuntime
 	// collections
 	pipelines col.PostgresCollection
 	jobs      col.PostgresCollection
+	eventLog  *col.PostgresEventLog
 }
 
 func merge(from, to map[string]bool) {
@@ -263,79 +267,12 @@ func validateTransform(transform *pps.Transform) error {
 	if transform.Image == "" {
 		return errors.Errorf("pipeline transform must contain an image")
 	}
-	return nil
-}
-
-func (a *apiServer) validateKube() {
-	errors := false
-	kubeClient := a.env.KubeClient
-	_, err := kubeClient.CoreV1().Pods(a.namespace).Watch(metav1.ListOptions{Watch: true})
-	if err != nil {
-		errors = true
-		logrus.Errorf("unable to access kubernetes pods, Pachyderm will continue to work but certain pipeline errors will result in pipelines being stuck indefinitely in \"starting\" state. error: %v", err)
-	}
-	pods, err := a.rcPods("pachd")
-	if err != nil || len(pods) == 0 {
-		errors = true
-		logrus.Errorf("unable to access kubernetes pods, Pachyderm will continue to work but 'pachctl logs' will not work. error: %v", err)
-	} else {
-		// No need to check all pods since we're just checking permissions.
-		pod := pods[0]
-		_, err = kubeClient.CoreV1().Pods(a.namespace).GetLogs(
-			pod.ObjectMeta.Name, &v1.PodLogOptions{
-				Container: "pachd",
-			}).Timeout(10 * time.Second).Do().Raw()
-		if err != nil {
-			errors = true
-			logrus.Errorf("unable to access kubernetes logs, Pachyderm will continue to work but 'pachctl logs' will not work. error: %v", err)
-		}
-	}
-	name := uuid.NewWithoutDashes()
-	labels := map[string]string{"app": name}
-	rc := &v1.ReplicationController{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "ReplicationController",
-			APIVersion: "v1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   name,
-			Labels: labels,
-		},
-		Spec: v1.ReplicationControllerSpec{
-			Selector: labels,
-			Replicas: new(int32),
-			Template: &v1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:   name,
-					Labels: labels,
-				},
-				Spec: v1.PodSpec{
-					Containers: []v1.Container{
-						{
-							Name:    "name",
-							Image:   DefaultUserImage,
-							Command: []string{"true"},
-						},
-					},
-				},
-			},
-		},
-	}
-	if _, err := kubeClient.CoreV1().ReplicationControllers(a.namespace).Create(rc); err != nil {
-		if err != nil {
-			errors = true
-			logrus.Errorf("unable to create kubernetes replication controllers, Pachyderm will not function properly until this is fixed. error: %v", err)
-		}
-	}
-	if err := kubeClient.CoreV1().ReplicationControllers(a.namespace).Delete(name, nil); err != nil {
-		if err != nil {
-			errors = true
-			logrus.Errorf("unable to delete kubernetes replication controllers, Pachyderm function properly but pipeline cleanup will not work. error: %v", err)
+	if transform.Sandbox != nil {
+		if err := driver.ValidateSandbox(transform.Sandbox, transform.User); err != nil {
+			return errors.Wrapf(err, "invalid sandbox")
 		}
 	}
-	if !errors {
-		logrus.Infof("validating kubernetes access returned no errors")
-	}
+	return nil
 }
 
 // authorizing a pipeline operation varies slightly depending on whether the
@@ -450,6 +387,7 @@ func (a *apiServer) UpdateJobStateInTransaction(txnCtx *txncontext.TransactionCo
 		return ppsServer.ErrJobFinished{Job: jobInfo.Job}
 	}
 
+	prevDataFailed := jobInfo.DataFailed
 	jobInfo.Restart = request.Restart
 	jobInfo.DataProcessed = request.DataProcessed
 	jobInfo.DataSkipped = request.DataSkipped
@@ -458,7 +396,16 @@ func (a *apiServer) UpdateJobStateInTransaction(txnCtx *txncontext.TransactionCo
 	jobInfo.DataTotal = request.DataTotal
 	jobInfo.Stats = request.Stats
 
-	return ppsutil.UpdateJobState(a.pipelines.ReadWrite(txnCtx.SqlTx), jobs, jobInfo, request.State, request.Reason)
+	if err := a.updateJobState(txnCtx.SqlTx, jobInfo, request.State, request.Reason); err != nil {
+		return err
+	}
+	if jobInfo.DataFailed > prevDataFailed {
+		return a.notifyJob(txnCtx.SqlTx, jobInfo, &pps.NotificationEvent{
+			Type:     pps.NotificationEventType_DATUM_FAILED,
+			JobState: jobInfo.State,
+		})
+	}
+	return nil
 }
 
 // InspectJob implements the protobuf pps.InspectJob RPC
@@ -520,9 +467,13 @@ func (a *apiServer) InspectJobSet(request *pps.InspectJobSetRequest, server pps.
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 	pachClient := a.env.GetPachClient(server.Context())
 
-	cb := func(pipeline string) error {
-		jobInfo, err := pachClient.InspectJob(pipeline, request.JobSet.ID, request.Details)
+	cb := func(job *pps.Job) error {
+		jobInfo, err := pachClient.PpsAPIClient.InspectJob(pachClient.Ctx(), &pps.InspectJobRequest{
+			Job:     job,
+			Details: request.Details,
+		})
 		if err != nil {
+			err = grpcutil.ScrubGRPC(err)
 			// Not all commits are guaranteed to have an associated job - skip over it
 			if errutil.IsNotFoundError(err) {
 				return nil
@@ -536,22 +487,30 @@ func (a *apiServer) InspectJobSet(request *pps.InspectJobSetRequest, server pps.
 		if ci.Commit.Branch.Repo.Type != pfs.UserRepoType || ci.Origin.Kind == pfs.OriginKind_ALIAS {
 			return nil
 		}
-		return cb(ci.Commit.Branch.Repo.Name)
+		// a commit on a shadow branch belongs to a shadow candidate's job
+		jobInfo := &pps.JobInfo{}
+		if err := getJobOfCommit(a.jobs.ReadOnly(pachClient.Ctx()), ci.Commit, jobInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return cb(jobInfo.Job)
 	}); err != nil {
 		if pfsServer.IsCommitSetNotFoundErr(err) {
 			// There are no commits for this ID, but there may still be jobs, query
 			// the jobs table directly and don't worry about the topological sort
 			// Load all the jobs eagerly to avoid a nested query
-			pipelines := []string{}
+			jobs := []*pps.Job{}
 			jobInfo := &pps.JobInfo{}
 			if err := a.jobs.ReadOnly(pachClient.Ctx()).GetByIndex(ppsdb.JobsJobSetIndex, request.JobSet.ID, jobInfo, col.DefaultOptions(), func(string) error {
-				pipelines = append(pipelines, jobInfo.Job.Pipeline.Name)
+				jobs = append(jobs, proto.Clone(jobInfo.Job).(*pps.Job))
 				return nil
 			}); err != nil {
 				return err
 			}
-			for _, pipeline := range pipelines {
-				if err := cb(pipeline); err != nil {
+			for _, job := range jobs {
+				if err := cb(job); err != nil {
 					return err
 				}
 			}
@@ -988,7 +947,7 @@ func (a *apiServer) stopJob(txnCtx *txncontext.TransactionContext, job *pps.Job,
 	// TODO: We can still not update a job's state if we fail here. This is
 	// probably fine for now since we are likely to have a more comprehensive
 	// solution to this with global ids.
-	if err := ppsutil.UpdateJobState(a.pipelines.ReadWrite(txnCtx.SqlTx), jobs, jobInfo, pps.JobState_JOB_KILLED, reason); err != nil && !ppsServer.IsJobFinishedErr(err) {
+	if err := a.updateJobState(txnCtx.SqlTx, jobInfo, pps.JobState_JOB_KILLED, reason); err != nil && !ppsServer.IsJobFinishedErr(err) {
 		return err
 	}
 	return nil
@@ -1033,6 +992,12 @@ func (a *apiServer) InspectDatum(ctx context.Context, request *pps.InspectDatumR
 	if response == nil {
 		return nil, errors.Errorf("datum %s not found in job %s", request.Datum.ID, request.Datum.Job)
 	}
+	logFile := response.PfsState.Commit.NewFile("/" + path.Join(datum.MetaPrefix, request.Datum.ID, datum.LogFileName))
+	if _, err := a.env.GetPachClient(ctx).InspectFile(logFile.Commit, logFile.Path); err == nil {
+		response.LogFile = logFile
+	} else if !pfsServer.IsFileNotFoundErr(err) {
+		return nil, err
+	}
 	return response, nil
 }
 
@@ -1086,8 +1051,9 @@ func convertDatumMetaToInfo(meta *datum.Meta, sourceJob *pps.Job) *pps.DatumInfo
 			Job: meta.Job,
 			ID:  common.DatumID(meta.Inputs),
 		},
-		State: convertDatumState(meta.State),
-		Stats: meta.Stats,
+		State:    convertDatumState(meta.State),
+		Stats:    meta.Stats,
+		Attempts: meta.Attempts,
 	}
 	for _, input := range meta.Inputs {
 		di.Data = append(di.Data, input.FileInfo)
@@ -1205,12 +1171,15 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		}
 	}
 
-	// Get pods managed by the RC we're scraping (either pipeline or pachd)
-	pods, err := a.rcPods(rcName)
+	// Get workers managed by the RC we're scraping (either pipeline or pachd)
+	workers, err := a.workerRuntime.listWorkers(rcName)
 	if err != nil {
 		return errors.Wrapf(err, "could not get pods in rc \"%s\" containing logs", rcName)
 	}
-	if len(pods) == 0 {
+	if len(workers) == 0 {
+		if persistedLogsAvailable(request) {
+			return a.getLogsFromMeta(apiGetLogsServer.Context(), request, apiGetLogsServer.Send)
+		}
 		return errors.Errorf("no pods belonging to the rc \"%s\" were found", rcName)
 	}
 	// Convert request.From to a usable timestamp.
@@ -1222,14 +1191,14 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 
 	// Spawn one goroutine per pod. Each goro writes its pod's logs to a channel
 	// and channels are read into the output server in a stable order.
-	// (sort the pods to make sure that the order of log lines is stable)
-	sort.Sort(podSlice(pods))
+	// (listWorkers sorts the pods to make sure that the order of log lines is
+	// stable)
 	logCh := make(chan *pps.LogMessage)
 	var eg errgroup.Group
 	var mu sync.Mutex
 	eg.Go(func() error {
-		for _, pod := range pods {
-			pod := pod
+		for _, worker := range workers {
+			worker := worker
 			if !request.Follow {
 				mu.Lock()
 			}
@@ -1237,18 +1206,12 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 				if !request.Follow {
 					defer mu.Unlock()
 				}
-				tailLines := &request.Tail
-				if *tailLines <= 0 {
-					tailLines = nil
-				}
 				// Get full set of logs from pod i
-				stream, err := a.env.KubeClient.CoreV1().Pods(a.namespace).GetLogs(
-					pod.ObjectMeta.Name, &v1.PodLogOptions{
-						Container:    containerName,
-						Follow:       request.Follow,
-						TailLines:    tailLines,
-						SinceSeconds: &sinceSeconds,
-					}).Timeout(10 * time.Second).Stream()
+				stream, err := a.workerRuntime.workerLogs(worker, containerName, &workerLogOptions{
+					follow:       request.Follow,
+					tail:         request.Tail,
+					sinceSeconds: sinceSeconds,
+				})
 				if err != nil {
 					return err
 				}
@@ -1307,10 +1270,15 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		close(logCh)
 	}()
 
+	var sent int
 	for msg := range logCh {
 		if err := apiGetLogsServer.Send(msg); err != nil {
 			return err
 		}
+		sent++
+	}
+	if egErr == nil && sent == 0 && persistedLogsAvailable(request) {
+		return a.getLogsFromMeta(apiGetLogsServer.Context(), request, apiGetLogsServer.Send)
 	}
 	return egErr
 }
@@ -1385,7 +1353,8 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 	for _, filter := range request.DataFilters {
 		query += contains(filter)
 	}
-	return lokiutil.QueryRange(apiGetLogsServer.Context(), loki, query, time.Now().Add(-since), time.Now(), request.Follow, func(t time.Time, line string) error {
+	var sent int
+	if err := lokiutil.QueryRange(apiGetLogsServer.Context(), loki, query, time.Now().Add(-since), time.Now(), request.Follow, func(t time.Time, line string) error {
 		msg := &pps.LogMessage{}
 		// These filters are almost always unnecessary because we apply
 		// them in the Loki request, but many of them are just done with
@@ -1412,24 +1381,67 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 			return nil
 		}
 		msg.Message = strings.TrimSuffix(msg.Message, "\n")
+		sent++
 		return apiGetLogsServer.Send(msg)
-	})
+	}); err != nil {
+		return err
+	}
+	if sent == 0 && persistedLogsAvailable(request) {
+		return a.getLogsFromMeta(apiGetLogsServer.Context(), request, apiGetLogsServer.Send)
+	}
+	return nil
 }
 
-func contains(s string) string {
-	return fmt.Sprintf(" |= %q", s)
+// persistedLogsAvailable returns true if the logs requested by 'request' may
+// have been persisted to a job's meta commit by the workers. Only user code
+// logs for a specific job are persisted.
+func persistedLogsAvailable(request *pps.GetLogsRequest) bool {
+	return request.Job != nil && !request.Master && !request.Follow
 }
 
-type podSlice []v1.Pod
-
-func (s podSlice) Len() int {
-	return len(s)
-}
-func (s podSlice) Swap(i, j int) {
-	s[i], s[j] = s[j], s[i]
+// getLogsFromMeta sends the datum logs that were persisted to the meta commit
+// of request.Job. It's used once the job's logs are no longer available from
+// Kubernetes or Loki, so request.Since and request.Tail are not applied.
+func (a *apiServer) getLogsFromMeta(ctx context.Context, request *pps.GetLogsRequest, send func(*pps.LogMessage) error) error {
+	jobInfo := &pps.JobInfo{}
+	if err := a.jobs.ReadOnly(ctx).Get(ppsdb.JobKey(request.Job), jobInfo); err != nil {
+		return errors.Wrapf(err, "could not get job information for \"%s\"", request.Job.ID)
+	}
+	if !pps.IsTerminal(jobInfo.State) {
+		// The meta commit isn't readable until the job is done
+		return nil
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	metaCommit := ppsutil.MetaCommit(jobInfo.OutputCommit)
+	datumID := "*"
+	if request.Datum != nil {
+		datumID = request.Datum.ID
+	}
+	return pachClient.GlobFile(metaCommit, "/"+path.Join(datum.MetaPrefix, datumID, datum.LogFileName), func(fi *pfs.FileInfo) error {
+		buf := &bytes.Buffer{}
+		if err := pachClient.GetFile(metaCommit, fi.File.Path, buf); err != nil {
+			return err
+		}
+		scanner := bufio.NewScanner(buf)
+		for scanner.Scan() {
+			msg := &pps.LogMessage{}
+			if err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), msg); err != nil {
+				continue
+			}
+			if !common.MatchDatum(request.DataFilters, msg.Data) {
+				continue
+			}
+			msg.Message = strings.TrimSuffix(msg.Message, "\n")
+			if err := send(msg); err != nil {
+				return err
+			}
+		}
+		return errors.EnsureStack(scanner.Err())
+	})
 }
-func (s podSlice) Less(i, j int) bool {
-	return s[i].ObjectMeta.Name < s[j].ObjectMeta.Name
+
+func contains(s string) string {
+	return fmt.Sprintf(" |= %q", s)
 }
 
 func now() *types.Timestamp {
@@ -1560,6 +1572,30 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 			return err
 		}
 	}
+	if pipelineInfo.Details.RetryPolicy != nil {
+		if _, err := common.NewRetryClassifier(pipelineInfo.Details.RetryPolicy); err != nil {
+			return errors.Wrapf(err, "invalid retry policy")
+		}
+		if _, err := common.NewRetryBackOff(pipelineInfo.Details.RetryPolicy); err != nil {
+			return errors.Wrapf(err, "invalid retry policy")
+		}
+	}
+	if pipelineInfo.Details.AutoscalingSpec != nil {
+		if !pipelineInfo.Details.Autoscaling {
+			return errors.New("autoscaling_spec requires autoscaling to be set")
+		}
+		if pipelineInfo.Details.Service != nil {
+			return errors.New("autoscaling_spec can't be used with services")
+		}
+		if err := validateAutoscalingSpec(pipelineInfo.Details.AutoscalingSpec); err != nil {
+			return errors.Wrapf(err, "invalid autoscaling spec")
+		}
+	}
+	for _, n := range pipelineInfo.Details.Notifications {
+		if err := validateNotification(n, false); err != nil {
+			return errors.Wrapf(err, "invalid notification")
+		}
+	}
 	if pipelineInfo.Details.PodSpec != "" && !json.Valid([]byte(pipelineInfo.Details.PodSpec)) {
 		return errors.Errorf("malformed PodSpec")
 	}
@@ -1577,10 +1613,21 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 			return errors.Errorf("the following service type %s is not allowed", pipelineInfo.Details.Service.Type)
 		}
 	}
+	if err := validateServiceRollout(pipelineInfo.Details); err != nil {
+		return errors.Wrapf(err, "invalid service")
+	}
 	if pipelineInfo.Details.Spout != nil {
 		if pipelineInfo.Details.Spout.Service == nil && pipelineInfo.Details.Input != nil {
 			return errors.Errorf("spout pipelines (without a service) must not have an input")
 		}
+		if marker := pipelineInfo.Details.Spout.Marker; marker != "" {
+			if err := ppsutil.ValidateSpoutMarker(marker); err != nil {
+				return err
+			}
+			if pipelineInfo.Details.OutputBranch == ppsconsts.SpoutMarkerBranch {
+				return errors.Errorf("spouts with a marker cannot use %q as their output branch", ppsconsts.SpoutMarkerBranch)
+			}
+		}
 	}
 	return nil
 }
@@ -1798,6 +1845,11 @@ func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, o
 			Metadata:              request.Metadata,
 			ReprocessSpec:         request.ReprocessSpec,
 			Autoscaling:           request.Autoscaling,
+			Priority:              request.Priority,
+			ConcurrentJobs:        request.ConcurrentJobs,
+			RetryPolicy:           request.RetryPolicy,
+			Notifications:         request.Notifications,
+			AutoscalingSpec:       request.AutoscalingSpec,
 		},
 	}
 
@@ -1899,6 +1951,16 @@ func (a *apiServer) CreatePipelineInTransaction(
 	newPipelineInfo.State = pps.PipelineState_PIPELINE_STARTING
 	newPipelineInfo.Type = pipelineTypeFromInfo(newPipelineInfo)
 
+	if update {
+		// An update replaces the pipeline's candidate, if it has one
+		if err := a.discardCandidateInTransaction(txnCtx, pipelineName, oldPipelineInfo); err != nil {
+			return errors.Wrapf(err, "could not discard candidate of %q", pipelineName)
+		}
+	}
+	if request.Candidate != nil {
+		return a.createCandidateInTransaction(txnCtx, request, oldPipelineInfo, newPipelineInfo)
+	}
+
 	if !update {
 		// Create output and spec repos
 		if err := a.env.PFSServer.CreateRepoInTransaction(txnCtx,
@@ -1984,7 +2046,10 @@ func (a *apiServer) CreatePipelineInTransaction(
 			return err
 		}
 
-		if newPipelineInfo.AuthToken != "" {
+		// The old version of a service with a rollout may keep serving while
+		// the new one rolls out, so the PPS master revokes its auth token once
+		// it stops (see revokeOldAuthTokens)
+		if newPipelineInfo.AuthToken != "" && !hasRollout(newPipelineInfo) {
 			// delete old auth token
 			// refetch because inspect clears it
 			var oldWithAuth pps.PipelineInfo
@@ -2080,13 +2145,20 @@ func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) error {
 		if pipelineInfo.Details.Service.Type == "" {
 			pipelineInfo.Details.Service.Type = string(v1.ServiceTypeNodePort)
 		}
+		setServiceDefaults(pipelineInfo.Details.Service)
 	}
-	if pipelineInfo.Details.Spout != nil && pipelineInfo.Details.Spout.Service != nil && pipelineInfo.Details.Spout.Service.Type == "" {
-		pipelineInfo.Details.Spout.Service.Type = string(v1.ServiceTypeNodePort)
+	if pipelineInfo.Details.Spout != nil && pipelineInfo.Details.Spout.Service != nil {
+		if pipelineInfo.Details.Spout.Service.Type == "" {
+			pipelineInfo.Details.Spout.Service.Type = string(v1.ServiceTypeNodePort)
+		}
+		setServiceDefaults(pipelineInfo.Details.Spout.Service)
 	}
 	if pipelineInfo.Details.ReprocessSpec == "" {
 		pipelineInfo.Details.ReprocessSpec = client.ReprocessSpecUntilSuccess
 	}
+	if pipelineInfo.Details.AutoscalingSpec != nil {
+		setAutoscalingDefaults(pipelineInfo.Details.AutoscalingSpec, pipelineInfo.Details.ParallelismSpec)
+	}
 	return nil
 }
 
@@ -2180,17 +2252,12 @@ func (a *apiServer) inspectPipeline(ctx context.Context, name string, details bo
 	if !details {
 		info.Details = nil // preserve old behavior
 	} else {
-		kubeClient := a.env.KubeClient
 		if info.Details.Service != nil {
-			rcName := ppsutil.PipelineRcName(info.Pipeline.Name, info.Version)
-			service, err := kubeClient.CoreV1().Services(a.namespace).Get(fmt.Sprintf("%s-user", rcName), metav1.GetOptions{})
+			ip, err := a.workerRuntime.serviceIP(userServiceName(info))
 			if err != nil {
-				if !errutil.IsNotFoundError(err) {
-					return nil, err
-				}
-			} else {
-				info.Details.Service.IP = service.Spec.ClusterIP
+				return nil, err
 			}
+			info.Details.Service.IP = ip
 		}
 
 		workerPoolID := ppsutil.PipelineRcName(info.Pipeline.Name, info.Version)
@@ -2201,11 +2268,7 @@ func (a *apiServer) inspectPipeline(ctx context.Context, name string, details bo
 			info.Details.WorkersAvailable = int64(len(workerStatus))
 			info.Details.WorkersRequested = int64(info.Parallelism)
 		}
-		tasks, claims, err := work.NewWorker(
-			a.env.EtcdClient,
-			a.etcdPrefix,
-			driver.WorkNamespace(info),
-		).TaskCount(ctx)
+		tasks, claims, err := a.newWorker(info).TaskCount(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -2215,6 +2278,15 @@ func (a *apiServer) inspectPipeline(ctx context.Context, name string, details bo
 	return info, nil
 }
 
+// newWorker returns a work.Worker for the pipeline's task namespace, which is
+// used to inspect the pipeline's task queue.
+func (a *apiServer) newWorker(pipelineInfo *pps.PipelineInfo) *work.Worker {
+	if a.env.Config.WorkBackend == work.PostgresBackend {
+		return work.NewPostgresWorker(a.env.DB, a.env.Listener, driver.WorkNamespace(pipelineInfo))
+	}
+	return work.NewWorker(a.env.EtcdClient, a.etcdPrefix, driver.WorkNamespace(pipelineInfo))
+}
+
 func (a *apiServer) InspectPipelineInTransaction(txnCtx *txncontext.TransactionContext, name string) (*pps.PipelineInfo, error) {
 	name, ancestors, err := ancestry.Parse(name)
 	if err != nil {
@@ -2342,6 +2414,14 @@ func (a *apiServer) listPipelineInfo(ctx context.Context,
 		// Erase any AuthToken - this shouldn't be returned to anyone (the workers
 		// won't use this function to get their auth token)
 		p.AuthToken = ""
+		// A candidate isn't the pipeline's current version, so it's only part of
+		// the pipeline's history
+		if p.Details.Candidate != nil {
+			if history == 0 {
+				return nil
+			}
+			return f(p)
+		}
 		// TODO: this is kind of silly - callers should just make a version range for each pipeline?
 		if last, ok := versionMap[p.Pipeline.Name]; ok {
 			if p.Version < last {
@@ -2481,6 +2561,12 @@ func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionCo
 		missingRepo = true
 	}
 
+	// Discard the pipeline's candidate, which also revokes its auth token and
+	// removes it from the ACLs of the inputs that only the candidate reads
+	if err := a.discardCandidateInTransaction(txnCtx, pipelineName, pipelineInfo); err != nil {
+		return errors.Wrapf(err, "could not discard candidate of %q", pipelineName)
+	}
+
 	// If necessary, revoke the pipeline's auth token and remove it from its inputs' ACLs
 	// If auth is deactivated, don't bother doing either
 	if _, err := txnCtx.WhoAmI(); err == nil && pipelineInfo.AuthToken != "" {
@@ -2641,6 +2727,11 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 				return err
 			}
 
+			// A stopped pipeline can't have a candidate
+			if err := a.discardCandidateInTransaction(txnCtx, pipelineInfo.Pipeline.Name, pipelineInfo); err != nil {
+				return err
+			}
+
 			// Remove branch provenance to prevent new output and meta commits from being created
 			if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
 				Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
@@ -2754,8 +2845,25 @@ func (a *apiServer) propagateJobs(txnCtx *txncontext.TransactionContext) error {
 			continue
 		}
 
-		// Check if there is an existing job for the output commit
+		// Commits on a shadow candidate's shadow branch get jobs of their own,
+		// and a canary takes the jobs of some commits on the output branch
 		job := client.NewJob(pipelineInfo.Pipeline.Name, txnCtx.CommitSetID)
+		candidate, err := a.inspectCandidateInTransaction(txnCtx, pipelineInfo.Pipeline.Name)
+		if err != nil {
+			return err
+		}
+		if spec := candidate.GetDetails().GetCandidate(); spec != nil {
+			branch := commitInfo.Commit.Branch.Name
+			if spec.ShadowBranch != "" && branch == spec.ShadowBranch {
+				job.Branch = branch
+				pipelineInfo = candidate
+			} else if spec.CanaryPercent != 0 && branch == pipelineInfo.Details.OutputBranch &&
+				inCanary(txnCtx.CommitSetID, spec.CanaryPercent) {
+				pipelineInfo = candidate
+			}
+		}
+
+		// Check if there is an existing job for the output commit
 		jobInfo := &pps.JobInfo{}
 		if err := a.jobs.ReadWrite(txnCtx.SqlTx).Get(ppsdb.JobKey(job), jobInfo); err == nil {
 			continue // Job already exists, skip it
@@ -2763,8 +2871,6 @@ func (a *apiServer) propagateJobs(txnCtx *txncontext.TransactionContext) error {
 			return err
 		}
 
-		pipelines := a.pipelines.ReadWrite(txnCtx.SqlTx)
-		jobs := a.jobs.ReadWrite(txnCtx.SqlTx)
 		jobPtr := &pps.JobInfo{
 			Job:             job,
 			PipelineVersion: pipelineInfo.Version,
@@ -2772,7 +2878,7 @@ func (a *apiServer) propagateJobs(txnCtx *txncontext.TransactionContext) error {
 			Stats:           &pps.ProcessStats{},
 			Created:         types.TimestampNow(),
 		}
-		if err := ppsutil.UpdateJobState(pipelines, jobs, jobPtr, pps.JobState_JOB_CREATED, ""); err != nil {
+		if err := a.updateJobState(txnCtx.SqlTx, jobPtr, pps.JobState_JOB_CREATED, ""); err != nil {
 			return err
 		}
 	}
@@ -2802,7 +2908,11 @@ func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretR
 	labels["secret-source"] = "pachyderm-user"
 	s.SetLabels(labels)
 
-	if _, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Create(&s); err != nil {
+	secrets, err := a.secrets()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := secrets.Create(&s); err != nil {
 		return nil, errors.Wrapf(err, "failed to create secret")
 	}
 	return &types.Empty{}, nil
@@ -2815,7 +2925,11 @@ func (a *apiServer) DeleteSecret(ctx context.Context, request *pps.DeleteSecretR
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "DeleteSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
-	if err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Delete(request.Secret.Name, &metav1.DeleteOptions{}); err != nil {
+	secrets, err := a.secrets()
+	if err != nil {
+		return nil, err
+	}
+	if err := secrets.Delete(request.Secret.Name, &metav1.DeleteOptions{}); err != nil {
 		return nil, errors.Wrapf(err, "failed to delete secret")
 	}
 	return &types.Empty{}, nil
@@ -2828,7 +2942,11 @@ func (a *apiServer) InspectSecret(ctx context.Context, request *pps.InspectSecre
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "InspectSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
-	secret, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Get(request.Secret.Name, metav1.GetOptions{})
+	secrets, err := a.secrets()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := secrets.Get(request.Secret.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get secret")
 	}
@@ -2855,14 +2973,18 @@ func (a *apiServer) ListSecret(ctx context.Context, in *types.Empty) (response *
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "ListSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
-	secrets, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).List(metav1.ListOptions{
+	secrets, err := a.secrets()
+	if err != nil {
+		return nil, err
+	}
+	secretList, err := secrets.List(metav1.ListOptions{
 		LabelSelector: "secret-source=pachyderm-user",
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to list secrets")
 	}
 	secretInfos := []*pps.SecretInfo{}
-	for _, s := range secrets.Items {
+	for _, s := range secretList.Items {
 		creationTimestamp, err := ptypes.TimestampProto(s.GetCreationTimestamp().Time)
 		if err != nil {
 			return nil, errors.Errorf("failed to parse creation timestamp")
@@ -2893,6 +3015,9 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 		return nil, err
 	}
 
+	if a.env.KubeClient == nil {
+		return &types.Empty{}, nil // no secrets to delete
+	}
 	if err := a.env.KubeClient.CoreV1().Secrets(a.namespace).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{
 		LabelSelector: "secret-source=pachyderm-user",
 	}); err != nil {
@@ -2901,6 +3026,16 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 	return &types.Empty{}, nil
 }
 
+// secrets returns a client for the kubernetes secrets in pachd's namespace.
+// Secrets are stored in kubernetes, so they're unavailable when pachd is using
+// the local worker runtime.
+func (a *apiServer) secrets() (corev1.SecretInterface, error) {
+	if a.env.KubeClient == nil {
+		return nil, errors.Errorf("secrets require kubernetes, but pachd is using the %q worker runtime", a.env.Config.PPSWorkerRuntime)
+	}
+	return a.env.KubeClient.CoreV1().Secrets(a.namespace), nil
+}
+
 // ActivateAuth implements the protobuf pps.ActivateAuth RPC
 func (a *apiServer) ActivateAuth(ctx context.Context, req *pps.ActivateAuthRequest) (resp *pps.ActivateAuthResponse, retErr error) {
 	func() { a.Log(req, nil, nil, 0) }()
@@ -3075,20 +3210,6 @@ func RepoNameToEnvString(repoName string) string {
 	return strings.ToUpper(repoName)
 }
 
-func (a *apiServer) rcPods(rcName string) ([]v1.Pod, error) {
-	podList, err := a.env.KubeClient.CoreV1().Pods(a.namespace).List(metav1.ListOptions{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "ListOptions",
-			APIVersion: "v1",
-		},
-		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{"app": rcName})),
-	})
-	if err != nil {
-		return nil, err
-	}
-	return podList.Items, nil
-}
-
 func (a *apiServer) resolveCommit(ctx context.Context, commit *pfs.Commit) (*pfs.CommitInfo, error) {
 	pachClient := a.env.GetPachClient(ctx)
 	ci, err := pachClient.PfsAPIClient.InspectCommit(
@@ -0,0 +1,283 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	defaultScaleUpCooldown   = scaleUpInterval
+	defaultScaleDownCooldown = 5 * time.Minute
+	// autoscalerInterval is how often the autoscaler of a pipeline with an
+	// AutoscalingSpec reevaluates the pipeline's number of workers
+	autoscalerInterval = 10 * time.Second
+	// autoscalerJobHistory is the number of recent jobs that the autoscaler
+	// looks through for observed datum processing times
+	autoscalerJobHistory = 5
+)
+
+// setAutoscalingDefaults fills in the unset fields of spec
+func setAutoscalingDefaults(spec *pps.AutoscalingSpec, parallelismSpec *pps.ParallelismSpec) {
+	if spec.MinWorkers == 0 {
+		spec.MinWorkers = 1
+	}
+	if spec.MaxWorkers == 0 {
+		spec.MaxWorkers = 1
+		if parallelismSpec != nil && parallelismSpec.Constant > 1 {
+			spec.MaxWorkers = int64(parallelismSpec.Constant)
+		}
+		if spec.MaxWorkers < spec.MinWorkers {
+			spec.MaxWorkers = spec.MinWorkers
+		}
+	}
+	if spec.ScaleUpCooldown == nil {
+		spec.ScaleUpCooldown = types.DurationProto(defaultScaleUpCooldown)
+	}
+	if spec.ScaleDownCooldown == nil {
+		spec.ScaleDownCooldown = types.DurationProto(defaultScaleDownCooldown)
+	}
+}
+
+func validateAutoscalingSpec(spec *pps.AutoscalingSpec) error {
+	if spec.MinWorkers < 1 {
+		return errors.Errorf("min_workers must be at least 1, but is %d", spec.MinWorkers)
+	}
+	if spec.MaxWorkers < spec.MinWorkers {
+		return errors.Errorf("max_workers (%d) must be at least min_workers (%d)", spec.MaxWorkers, spec.MinWorkers)
+	}
+	for name, d := range map[string]*types.Duration{
+		"target_job_duration": spec.TargetJobDuration,
+		"scale_up_cooldown":   spec.ScaleUpCooldown,
+		"scale_down_cooldown": spec.ScaleDownCooldown,
+	} {
+		if d == nil {
+			continue
+		}
+		duration, err := types.DurationFromProto(d)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s", name)
+		}
+		if duration < 0 {
+			return errors.Errorf("%s must not be negative", name)
+		}
+	}
+	return nil
+}
+
+// autoscalerState is what the autoscaler observes about a pipeline when it
+// decides how many workers the pipeline should run
+type autoscalerState struct {
+	workers int64
+	// tasks and claims are the number of tasks in the pipeline's queue, and the
+	// number of those tasks that workers are processing
+	tasks, claims   int64
+	remainingDatums int64
+	// datumTime is the average worker time spent on each datum in the
+	// pipeline's recent jobs, or zero if it hasn't been observed yet
+	datumTime time.Duration
+	// lastScale is the time of the autoscaler's last change to the number of
+	// workers
+	lastScale time.Time
+}
+
+// autoscale returns the number of workers that a pipeline with 'spec' should
+// run, given what the autoscaler has observed, and the reason for it. It
+// returns state.workers if the number of workers shouldn't change.
+//
+// The pipeline should run enough workers to process its remaining datums in
+// spec.TargetJobDuration, but no more workers than it has tasks, as the extra
+// workers would be idle. The pipeline never scales down below the number of
+// workers that are processing tasks, as that would interrupt their work.
+func autoscale(spec *pps.AutoscalingSpec, state *autoscalerState, now time.Time) (int64, string) {
+	if state.tasks == 0 {
+		return state.workers, "no tasks are queued"
+	}
+	var target int64
+	var reason string
+	targetDuration, _ := types.DurationFromProto(spec.TargetJobDuration)
+	if state.datumTime > 0 && targetDuration > 0 && state.remainingDatums > 0 {
+		work := time.Duration(state.remainingDatums) * state.datumTime
+		target = int64((work + targetDuration - 1) / targetDuration)
+		reason = fmt.Sprintf("%d remaining datums at %v per datum need %d workers to finish in %v",
+			state.remainingDatums, state.datumTime, target, targetDuration)
+		if target > state.tasks {
+			target = state.tasks
+			reason += fmt.Sprintf(", but only %d tasks are queued", state.tasks)
+		}
+	} else {
+		target = state.tasks
+		reason = fmt.Sprintf("%d tasks are queued", state.tasks)
+	}
+	if target < spec.MinWorkers {
+		target = spec.MinWorkers
+		reason += fmt.Sprintf(", limited by min_workers (%d)", spec.MinWorkers)
+	}
+	if target > spec.MaxWorkers {
+		target = spec.MaxWorkers
+		reason += fmt.Sprintf(", limited by max_workers (%d)", spec.MaxWorkers)
+	}
+	if target < state.claims && target < state.workers {
+		target = state.claims
+		if target > state.workers {
+			target = state.workers
+		}
+		reason += fmt.Sprintf(", but %d workers are busy", state.claims)
+	}
+
+	cooldownSpec := spec.ScaleUpCooldown
+	if target < state.workers {
+		cooldownSpec = spec.ScaleDownCooldown
+	}
+	if target != state.workers {
+		cooldown, _ := types.DurationFromProto(cooldownSpec)
+		if wait := state.lastScale.Add(cooldown).Sub(now); wait > 0 {
+			return state.workers, fmt.Sprintf("%s; scaling from %d to %d workers in %v, after the cooldown",
+				reason, state.workers, target, wait.Round(time.Second))
+		}
+	}
+	return target, reason
+}
+
+// observeJobs returns the number of datums the pipeline's running job has left
+// to process, and the average time its recent jobs have spent on each datum
+func (a *apiServer) observeJobs(pachClient *client.APIClient, pipeline string) (int64, time.Duration, error) {
+	var remaining int64
+	var datumTime time.Duration
+	var n int
+	if err := pachClient.ListJobF(pipeline, nil, 0, false, func(ji *pps.JobInfo) error {
+		n++
+		if ji.State == pps.JobState_JOB_RUNNING && remaining == 0 {
+			remaining = ji.DataTotal - ji.DataProcessed - ji.DataSkipped - ji.DataFailed - ji.DataRecovered
+		}
+		if datumTime == 0 && ji.Stats != nil && ji.DataProcessed > 0 {
+			var total time.Duration
+			for _, d := range []*types.Duration{ji.Stats.DownloadTime, ji.Stats.ProcessTime, ji.Stats.UploadTime} {
+				if d != nil {
+					duration, err := types.DurationFromProto(d)
+					if err != nil {
+						return err
+					}
+					total += duration
+				}
+			}
+			datumTime = total / time.Duration(ji.DataProcessed)
+		}
+		if (remaining > 0 && datumTime > 0) || n >= autoscalerJobHistory {
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil {
+		return 0, 0, err
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, datumTime, nil
+}
+
+// sameAutoscalerDecision returns true if two autoscaler statuses describe the
+// same scaling decision, even if what the autoscaler observed has changed.
+func sameAutoscalerDecision(a, b *pps.AutoscalerStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.CurrentWorkers == b.CurrentWorkers && a.TargetWorkers == b.TargetWorkers
+}
+
+// setAutoscalerStatus records the autoscaler's latest decision in the
+// pipeline's PipelineInfo. The PipelineInfo isn't written if it already has
+// the same decision, as every write wakes up the pipeline's controller.
+func (a *apiServer) setAutoscalerStatus(ctx context.Context, specCommit *pfs.Commit, status *pps.AutoscalerStatus) error {
+	return dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		pipelines := a.pipelines.ReadWrite(sqlTx)
+		pipelineInfo := &pps.PipelineInfo{}
+		if err := pipelines.Get(specCommit, pipelineInfo); err != nil {
+			return err
+		}
+		if pipelineInfo.Details == nil {
+			return errors.Errorf("pipeline %q has no details", specCommit.Branch.Repo.Name)
+		}
+		if sameAutoscalerDecision(pipelineInfo.Details.AutoscalerStatus, status) {
+			return nil
+		}
+		pipelineInfo.Details.AutoscalerStatus = status
+		return pipelines.Put(specCommit, pipelineInfo)
+	})
+}
+
+// runAutoscaler scales the workers of a pipeline with an AutoscalingSpec until
+// ctx is cancelled, reporting each change to its scaling decision in the
+// pipeline's PipelineInfo.
+func (m *ppsMaster) runAutoscaler(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
+	spec := pipelineInfo.Details.AutoscalingSpec
+	pachClient := m.a.env.GetPachClient(ctx)
+	worker := m.a.newWorker(pipelineInfo)
+	var lastScale time.Time
+	lastStatus := pipelineInfo.Details.AutoscalerStatus
+	for {
+		state := &autoscalerState{lastScale: lastScale}
+		var err error
+		state.tasks, state.claims, err = worker.TaskCount(ctx)
+		if err != nil {
+			return err
+		}
+		rcs, err := m.a.workerRuntime.listWorkerSets(pipelineInfo.Pipeline.Name)
+		if err != nil {
+			return err
+		}
+		var rc *workerSet
+		for _, ws := range rcs {
+			if ws.name == pipelineInfo.Details.WorkerRc {
+				rc = ws
+			}
+		}
+		// Workers that are scaled down to zero are in standby, which
+		// monitorPipeline manages
+		if rc != nil && rc.replicas > 0 && state.tasks > 0 {
+			state.workers = int64(rc.replicas)
+			state.remainingDatums, state.datumTime, err = m.a.observeJobs(pachClient, pipelineInfo.Pipeline.Name)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			target, reason := autoscale(spec, state, now)
+			if target != state.workers {
+				if err := m.a.workerRuntime.scaleWorkers(rc.name, int32(target)); err != nil {
+					return err
+				}
+				lastScale = now
+			}
+			status := &pps.AutoscalerStatus{
+				CurrentWorkers:   state.workers,
+				TargetWorkers:    target,
+				PendingTasks:     state.tasks,
+				RemainingDatums:  state.remainingDatums,
+				DatumProcessTime: types.DurationProto(state.datumTime),
+				Time:             types.TimestampNow(),
+				Reason:           reason,
+			}
+			if !sameAutoscalerDecision(lastStatus, status) {
+				if err := m.a.setAutoscalerStatus(ctx, pipelineInfo.SpecCommit, status); err != nil {
+					return err
+				}
+				lastStatus = status
+			}
+		}
+		select {
+		case <-time.After(autoscalerInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestAutoscale(t *testing.T) {
+	spec := &pps.AutoscalingSpec{
+		MaxWorkers:        10,
+		TargetJobDuration: types.DurationProto(time.Minute),
+	}
+	setAutoscalingDefaults(spec, nil)
+	require.Equal(t, int64(1), spec.MinWorkers)
+	require.Equal(t, int64(10), spec.MaxWorkers)
+	now := time.Now()
+
+	// Without observed datum times, run a worker for each task.
+	target, _ := autoscale(spec, &autoscalerState{workers: 1, tasks: 4}, now)
+	require.Equal(t, int64(4), target)
+
+	// 300 datums at 1s each need 5 workers to finish in a minute.
+	state := &autoscalerState{workers: 1, tasks: 20, remainingDatums: 300, datumTime: time.Second}
+	target, reason := autoscale(spec, state, now)
+	require.Equal(t, int64(5), target)
+	require.True(t, len(reason) > 0)
+
+	// More workers than tasks would be idle.
+	state.tasks = 3
+	target, _ = autoscale(spec, state, now)
+	require.Equal(t, int64(3), target)
+
+	// Targets are limited by max_workers.
+	state.tasks, state.remainingDatums = 100, 6000
+	target, _ = autoscale(spec, state, now)
+	require.Equal(t, int64(10), target)
+
+	// Nothing changes without tasks.
+	target, _ = autoscale(spec, &autoscalerState{workers: 3}, now)
+	require.Equal(t, int64(3), target)
+}
+
+func TestAutoscaleCooldown(t *testing.T) {
+	spec := &pps.AutoscalingSpec{
+		MinWorkers:        2,
+		MaxWorkers:        10,
+		TargetJobDuration: types.DurationProto(time.Minute),
+	}
+	setAutoscalingDefaults(spec, nil)
+	now := time.Now()
+
+	// Scaling up waits for scale_up_cooldown after the last scaling decision.
+	state := &autoscalerState{workers: 2, tasks: 20, remainingDatums: 600, datumTime: time.Second,
+		lastScale: now.Add(-10 * time.Second)}
+	target, _ := autoscale(spec, state, now)
+	require.Equal(t, int64(2), target)
+	state.lastScale = now.Add(-defaultScaleUpCooldown)
+	target, _ = autoscale(spec, state, now)
+	require.Equal(t, int64(10), target)
+
+	// Scaling down waits for the longer scale_down_cooldown, and doesn't go
+	// below min_workers.
+	state = &autoscalerState{workers: 8, tasks: 1, remainingDatums: 1, datumTime: time.Second,
+		lastScale: now.Add(-defaultScaleUpCooldown)}
+	target, _ = autoscale(spec, state, now)
+	require.Equal(t, int64(8), target)
+	state.lastScale = now.Add(-defaultScaleDownCooldown)
+	target, _ = autoscale(spec, state, now)
+	require.Equal(t, int64(2), target)
+
+	// Busy workers aren't scaled down.
+	state.claims = 5
+	target, _ = autoscale(spec, state, now)
+	require.Equal(t, int64(5), target)
+}
+
+func TestValidateAutoscalingSpec(t *testing.T) {
+	spec := &pps.AutoscalingSpec{MinWorkers: 3}
+	setAutoscalingDefaults(spec, &pps.ParallelismSpec{Constant: 2})
+	require.Equal(t, int64(3), spec.MaxWorkers)
+	require.NoError(t, validateAutoscalingSpec(spec))
+
+	spec.MaxWorkers = 2
+	require.YesError(t, validateAutoscalingSpec(spec))
+	spec.MaxWorkers = 4
+	spec.TargetJobDuration = types.DurationProto(-time.Second)
+	require.YesError(t, validateAutoscalingSpec(spec))
+}
+
+func TestSameAutoscalerDecision(t *testing.T) {
+	status := &pps.AutoscalerStatus{CurrentWorkers: 2, TargetWorkers: 4, PendingTasks: 10, Reason: "10 tasks are queued"}
+	require.True(t, sameAutoscalerDecision(nil, nil))
+	require.False(t, sameAutoscalerDecision(nil, status))
+	// Changes to what the autoscaler observed aren't a new decision
+	require.True(t, sameAutoscalerDecision(status, &pps.AutoscalerStatus{CurrentWorkers: 2, TargetWorkers: 4, PendingTasks: 8, Reason: "8 tasks are queued"}))
+	require.False(t, sameAutoscalerDecision(status, &pps.AutoscalerStatus{CurrentWorkers: 4, TargetWorkers: 4}))
+}
@@ -0,0 +1,371 @@
+package server
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// PromotePipeline implements the protobuf pps.PromotePipeline RPC
+func (a *apiServer) PromotePipeline(ctx context.Context, request *pps.PromotePipelineRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if request.Pipeline == nil {
+		return nil, errors.New("request.Pipeline cannot be nil")
+	}
+
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		candidate, err := a.inspectCandidateInTransaction(txnCtx, request.Pipeline.Name)
+		if err != nil {
+			return err
+		}
+		if candidate == nil {
+			return errors.Errorf("pipeline %q has no candidate to promote", request.Pipeline.Name)
+		}
+		// Promoting the candidate is an update to its spec, which discards the
+		// candidate and replaces the current version
+		update := ppsutil.PipelineReqFromInfo(candidate)
+		update.Update = true
+		return a.CreatePipelineInTransaction(txnCtx, update)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// validateCandidate validates the candidate spec of 'request', which would
+// update 'oldPipelineInfo' to 'newPipelineInfo'
+func validateCandidate(request *pps.CreatePipelineRequest, oldPipelineInfo, newPipelineInfo *pps.PipelineInfo) error {
+	candidate := request.Candidate
+	switch {
+	case !request.Update || oldPipelineInfo == nil:
+		return errors.New("a candidate can only be created by updating an existing pipeline")
+	case request.SpecCommit != nil:
+		return errors.New("a candidate can't be created from a spec commit")
+	case oldPipelineInfo.Type != pps.PipelineInfo_PIPELINE_TYPE_TRANSFORM ||
+		newPipelineInfo.Type != pps.PipelineInfo_PIPELINE_TYPE_TRANSFORM:
+		return errors.New("only transform pipelines can have a candidate")
+	case oldPipelineInfo.Stopped:
+		return errors.Errorf("pipeline %q is stopped, so it can't have a candidate", oldPipelineInfo.Pipeline.Name)
+	case newPipelineInfo.Details.OutputBranch != oldPipelineInfo.Details.OutputBranch:
+		return errors.New("a candidate can't change the output branch of a pipeline")
+	case (candidate.CanaryPercent == 0) == (candidate.ShadowBranch == ""):
+		return errors.New("exactly one of canary_percent and shadow_branch must be set")
+	}
+	if candidate.CanaryPercent != 0 {
+		if candidate.CanaryPercent > 99 {
+			return errors.Errorf("canary_percent %d must be between 1 and 99", candidate.CanaryPercent)
+		}
+		// canary jobs write to the output commits of the current version's
+		// output branch, whose provenance is the current version's input
+		if !proto.Equal(newPipelineInfo.Details.Input, oldPipelineInfo.Details.Input) {
+			return errors.New("a canary must have the same input as the current version")
+		}
+		return nil
+	}
+	if err := ancestry.ValidateName(candidate.ShadowBranch); err != nil {
+		return errors.Wrapf(err, "invalid shadow_branch")
+	}
+	if candidate.ShadowBranch == oldPipelineInfo.Details.OutputBranch {
+		return errors.Errorf("shadow_branch can't be the pipeline's output branch %q", candidate.ShadowBranch)
+	}
+	return nil
+}
+
+// inCanary returns whether a canary that runs on 'percent' percent of its
+// pipeline's output commits runs on the output commit of 'commitSetID'. It's
+// deterministic, so that a commitset's job always goes to the same version.
+func inCanary(commitSetID string, percent uint32) bool {
+	h := fnv.New32a()
+	h.Write([]byte(commitSetID))
+	return h.Sum32()%100 < percent
+}
+
+// inspectCandidateInTransaction returns the candidate version of 'pipeline',
+// or nil if it doesn't have one. Unlike InspectPipelineInTransaction, the
+// result includes the candidate's auth token.
+func (a *apiServer) inspectCandidateInTransaction(txnCtx *txncontext.TransactionContext, pipeline string) (*pps.PipelineInfo, error) {
+	specCommit, err := ppsutil.FindCandidateSpecCommitInTransaction(txnCtx, a.env.PFSServer, pipeline)
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	candidate := &pps.PipelineInfo{}
+	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Get(specCommit, candidate); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return candidate, nil
+}
+
+// inspectCandidate is like inspectCandidateInTransaction, for callers outside
+// of a transaction
+func (a *apiServer) inspectCandidate(ctx context.Context, pipeline string) (*pps.PipelineInfo, error) {
+	var candidate *pps.PipelineInfo
+	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		candidate, err = a.inspectCandidateInTransaction(txnCtx, pipeline)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return candidate, nil
+}
+
+// createCandidateInTransaction stores 'newPipelineInfo', an update of
+// 'oldPipelineInfo', as the candidate version of its pipeline. Unlike other
+// updates, it leaves the current version, its jobs, and its output branch
+// alone: the candidate's spec commit is on the spec repo's candidate branch,
+// rather than on master, and only a shadow candidate gets new output commits,
+// on its shadow branch.
+func (a *apiServer) createCandidateInTransaction(txnCtx *txncontext.TransactionContext, request *pps.CreatePipelineRequest, oldPipelineInfo, newPipelineInfo *pps.PipelineInfo) error {
+	if err := validateCandidate(request, oldPipelineInfo, newPipelineInfo); err != nil {
+		return errors.Wrapf(err, "invalid candidate")
+	}
+	pipelineName := newPipelineInfo.Pipeline.Name
+	newPipelineInfo.Details.Candidate = request.Candidate
+
+	specBranch := client.NewSystemRepo(pipelineName, pfs.SpecRepoType).NewBranch(ppsconsts.CandidateSpecBranch)
+	var err error
+	newPipelineInfo.SpecCommit, err = a.env.PFSServer.StartCommitInTransaction(txnCtx, &pfs.StartCommitRequest{
+		Branch: specBranch,
+		// continue the history of the version that this is a candidate for
+		Parent: oldPipelineInfo.SpecCommit,
+	})
+	if err != nil {
+		return err
+	}
+	if err := a.env.PFSServer.FinishCommitInTransaction(txnCtx, &pfs.FinishCommitRequest{
+		Commit: newPipelineInfo.SpecCommit,
+	}); err != nil {
+		return err
+	}
+
+	// The candidate gets its own auth token, since the current version keeps
+	// running with the old one
+	token, err := a.env.AuthServer.GetPipelineAuthTokenInTransaction(txnCtx, pipelineName)
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return err
+	}
+	newPipelineInfo.AuthToken = token
+	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Create(newPipelineInfo.SpecCommit, newPipelineInfo); err != nil {
+		return err
+	}
+	if newPipelineInfo.AuthToken != "" {
+		// Only add the candidate's inputs, as the current version still reads
+		// its own
+		if err := a.fixPipelineInputRepoACLsInTransaction(txnCtx, newPipelineInfo, nil); err != nil {
+			return err
+		}
+	}
+
+	shadowBranch := request.Candidate.ShadowBranch
+	if shadowBranch == "" {
+		return nil
+	}
+	provenance := append(branchProvenance(newPipelineInfo.Details.Input), specBranch)
+	for _, branch := range []*pfs.Branch{
+		client.NewBranch(pipelineName, shadowBranch),
+		client.NewSystemRepo(pipelineName, pfs.MetaRepoType).NewBranch(shadowBranch),
+	} {
+		if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+			Branch:     branch,
+			Provenance: provenance,
+		}); err != nil {
+			return errors.Wrapf(err, "could not create shadow branch")
+		}
+	}
+	return nil
+}
+
+// discardCandidateInTransaction stops the jobs of the candidate version of
+// 'pipeline' and deletes it, along with its shadow branches, and removes the
+// pipeline from the ACLs of the inputs that only the candidate reads (rather
+// than 'current', the pipeline's current version, if it's known). It's a no-op
+// if the pipeline has no candidate. The PPS master deletes the candidate's
+// workers once it's gone.
+func (a *apiServer) discardCandidateInTransaction(txnCtx *txncontext.TransactionContext, pipeline string, current *pps.PipelineInfo) error {
+	candidate, err := a.inspectCandidateInTransaction(txnCtx, pipeline)
+	if err != nil || candidate == nil {
+		return err
+	}
+	var jobs []*pps.Job
+	jobInfo := &pps.JobInfo{}
+	if err := a.jobs.ReadWrite(txnCtx.SqlTx).GetByIndex(ppsdb.JobsTerminalIndex,
+		ppsdb.JobTerminalKey(candidate.Pipeline, false), jobInfo, col.DefaultOptions(), func(string) error {
+			if jobInfo.PipelineVersion == candidate.Version {
+				jobs = append(jobs, proto.Clone(jobInfo.Job).(*pps.Job))
+			}
+			return nil
+		}); err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		if err := a.stopJob(txnCtx, job, "candidate discarded"); err != nil {
+			return err
+		}
+	}
+
+	branches := []*pfs.Branch{client.NewSystemRepo(pipeline, pfs.SpecRepoType).NewBranch(ppsconsts.CandidateSpecBranch)}
+	if shadowBranch := candidate.Details.Candidate.ShadowBranch; shadowBranch != "" {
+		// delete the shadow branches first, as they're subvenant on the spec branch
+		branches = append([]*pfs.Branch{
+			client.NewBranch(pipeline, shadowBranch),
+			client.NewSystemRepo(pipeline, pfs.MetaRepoType).NewBranch(shadowBranch),
+		}, branches...)
+	}
+	for _, branch := range branches {
+		if err := a.env.PFSServer.DeleteBranchInTransaction(txnCtx, &pfs.DeleteBranchRequest{
+			Branch: branch,
+		}); err != nil && !errutil.IsNotFoundError(err) {
+			return errors.Wrapf(err, "could not delete branch %s", branch)
+		}
+	}
+	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Delete(candidate.SpecCommit); err != nil {
+		return err
+	}
+	if candidate.AuthToken != "" {
+		currentRepos := inputRepos(current.GetDetails().GetInput())
+		for repo := range inputRepos(candidate.Details.Input) {
+			if _, ok := currentRepos[repo]; ok || repo == pipeline {
+				continue
+			}
+			if err := a.env.AuthServer.RemovePipelineReaderFromRepoInTransaction(txnCtx, repo, pipeline); err != nil &&
+				!auth.IsErrNoRoleBinding(err) && !auth.IsErrNotActivated(err) {
+				return err
+			}
+		}
+		if _, err := a.env.AuthServer.RevokeAuthTokenInTransaction(txnCtx,
+			&auth.RevokeAuthTokenRequest{Token: candidate.AuthToken}); err != nil && !auth.IsErrNotActivated(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// inputRepos returns the repos that 'input' reads
+func inputRepos(input *pps.Input) map[string]struct{} {
+	repos := make(map[string]struct{})
+	pps.VisitInput(input, func(input *pps.Input) error {
+		switch {
+		case input.Pfs != nil:
+			repos[input.Pfs.Repo] = struct{}{}
+		case input.Cron != nil:
+			repos[input.Cron.Repo] = struct{}{}
+		}
+		return nil
+	})
+	return repos
+}
+
+// getJobOfCommit reads the job whose output commit is 'commit' into jobInfo.
+// That's the job of a shadow candidate if 'commit' is on its shadow branch, or
+// else the job of the pipeline's current version (or canary).
+func getJobOfCommit(jobs interface {
+	Get(key interface{}, val proto.Message) error
+}, commit *pfs.Commit, jobInfo *pps.JobInfo) error {
+	job := client.NewJob(commit.Branch.Repo.Name, commit.ID)
+	job.Branch = commit.Branch.Name
+	if err := jobs.Get(ppsdb.JobKey(job), jobInfo); !col.IsErrNotFound(err) {
+		return err
+	}
+	job.Branch = ""
+	return jobs.Get(ppsdb.JobKey(job), jobInfo)
+}
+
+// candidateRcName returns the name of the workers of op's candidate, or "" if
+// op's pipeline has no candidate
+func (op *pipelineOp) candidateRcName() string {
+	if op.candidate == nil {
+		return ""
+	}
+	return ppsutil.PipelineRcName(op.candidate.Pipeline.Name, op.candidate.Version)
+}
+
+// stepCandidate runs the workers of op's candidate alongside the workers of
+// op's pipeline, and deletes the workers of candidates that were discarded
+// (whose versions are newer than op's, as updates reuse their versions).
+func (op *pipelineOp) stepCandidate() error {
+	runtime := op.m.a.workerRuntime
+	sets, err := runtime.listWorkerSets(op.pipelineInfo.Pipeline.Name)
+	if err != nil {
+		return newRetriableError(err, "error listing workers")
+	}
+	var candidateSet *workerSet
+	for _, ws := range sets {
+		if ws.name == op.candidateRcName() && ws.authTokenHash == hashAuthToken(op.candidate.AuthToken) {
+			candidateSet = ws
+			continue
+		}
+		if version, err := strconv.ParseUint(ws.pipelineVersion, 10, 64); err != nil || version <= op.pipelineInfo.Version {
+			continue // getRC takes care of the workers of old versions
+		}
+		log.Infof("PPS master: deleting workers of discarded candidate %q", ws.name)
+		if err := runtime.deleteWorkerSet(ws.name); err != nil {
+			return newRetriableError(err, "error deleting workers of discarded candidate")
+		}
+	}
+	if op.candidate == nil || op.pipelineInfo.State == pps.PipelineState_PIPELINE_FAILURE {
+		return nil
+	}
+	if candidateSet == nil {
+		log.Infof("PPS master: creating workers of candidate version %d of %q",
+			op.candidate.Version, op.candidate.Pipeline.Name)
+		if err := runtime.createWorkers(op.ctx, op.candidate); err != nil {
+			return newRetriableError(err, "error creating workers of candidate")
+		}
+		candidateSet = &workerSet{name: op.candidateRcName()}
+	}
+	replicas := int32(op.candidate.Parallelism)
+	if op.pipelineInfo.Stopped {
+		replicas = 0
+	}
+	if candidateSet.replicas != replicas {
+		if err := runtime.scaleWorkers(candidateSet.name, replicas); err != nil {
+			return newRetriableError(err, "error scaling workers of candidate")
+		}
+	}
+	if op.candidate.State == pps.PipelineState_PIPELINE_STARTING {
+		if err := op.m.a.setPipelineState(op.ctx, op.candidate.SpecCommit, pps.PipelineState_PIPELINE_RUNNING, ""); err != nil {
+			return newRetriableError(err, "error setting state of candidate")
+		}
+	}
+	return nil
+}
+
+// withoutCandidate returns the worker sets in 'sets' other than those of op's
+// candidate
+func (op *pipelineOp) withoutCandidate(sets []*workerSet) []*workerSet {
+	if op.candidate == nil {
+		return sets
+	}
+	var result []*workerSet
+	for _, ws := range sets {
+		if ws.name != op.candidateRcName() {
+			result = append(result, ws)
+		}
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestInCanary(t *testing.T) {
+	var n int
+	for i := 0; i < 10000; i++ {
+		id := fmt.Sprintf("%032x", i)
+		if inCanary(id, 20) {
+			n++
+			// the same commitset always goes to the canary
+			require.True(t, inCanary(id, 20))
+		}
+	}
+	require.True(t, n > 1500 && n < 2500, "%d of 10000 commits were in a 20%% canary", n)
+}
+
+func TestValidateCandidate(t *testing.T) {
+	old := &pps.PipelineInfo{
+		Pipeline: client.NewPipeline("edges"),
+		Type:     pps.PipelineInfo_PIPELINE_TYPE_TRANSFORM,
+		Details: &pps.PipelineInfo_Details{
+			OutputBranch: "master",
+			Input:        client.NewPFSInput("images", "/*"),
+		},
+	}
+	update := proto.Clone(old).(*pps.PipelineInfo)
+	request := &pps.CreatePipelineRequest{
+		Update:    true,
+		Candidate: &pps.CandidateSpec{CanaryPercent: 10},
+	}
+	require.NoError(t, validateCandidate(request, old, update))
+	request.Candidate = &pps.CandidateSpec{ShadowBranch: "shadow"}
+	require.NoError(t, validateCandidate(request, old, update))
+
+	// exactly one of canary_percent and shadow_branch must be set
+	request.Candidate = &pps.CandidateSpec{}
+	require.YesError(t, validateCandidate(request, old, update))
+	request.Candidate = &pps.CandidateSpec{CanaryPercent: 10, ShadowBranch: "shadow"}
+	require.YesError(t, validateCandidate(request, old, update))
+	request.Candidate = &pps.CandidateSpec{CanaryPercent: 100}
+	require.YesError(t, validateCandidate(request, old, update))
+	// a shadow can't write to the output branch
+	request.Candidate = &pps.CandidateSpec{ShadowBranch: "master"}
+	require.YesError(t, validateCandidate(request, old, update))
+
+	// a canary can't change the pipeline's input, but a shadow can
+	update.Details.Input = client.NewPFSInput("images", "/")
+	request.Candidate = &pps.CandidateSpec{CanaryPercent: 10}
+	require.YesError(t, validateCandidate(request, old, update))
+	request.Candidate = &pps.CandidateSpec{ShadowBranch: "shadow"}
+	require.NoError(t, validateCandidate(request, old, update))
+
+	// candidates are only for updates of transform pipelines
+	require.YesError(t, validateCandidate(request, nil, update))
+	update.Type = pps.PipelineInfo_PIPELINE_TYPE_SERVICE
+	require.YesError(t, validateCandidate(request, old, update))
+}
+
+func TestInputRepos(t *testing.T) {
+	input := client.NewCrossInput(
+		client.NewPFSInput("images", "/*"),
+		client.NewUnionInput(
+			client.NewPFSInput("labels", "/*"),
+			client.NewPFSInput("images", "/"),
+		),
+	)
+	require.Equal(t, map[string]struct{}{"images": {}, "labels": {}}, inputRepos(input))
+	require.Equal(t, 0, len(inputRepos(nil)))
+}
+
+func TestStepCandidate(t *testing.T) {
+	current := testPipelineInfo()
+	current.SpecCommit.ID = uuid.NewWithoutDashes()
+	current.State = pps.PipelineState_PIPELINE_RUNNING
+	current.AuthToken = "token-1"
+	candidate := proto.Clone(current).(*pps.PipelineInfo)
+	candidate.Version = 2
+	candidate.SpecCommit.ID = uuid.NewWithoutDashes()
+	candidate.State = pps.PipelineState_PIPELINE_STARTING
+	candidate.AuthToken = "token-2"
+	candidate.Parallelism = 2
+	runtime := newFakeWorkerRuntime()
+	op := newTestPipelineOp(t, runtime, current)
+	putPipelineInfo(t, op.m.a, candidate)
+	op.candidate = candidate
+	currentName, candidateName := ppsutil.PipelineRcName("edges", 1), ppsutil.PipelineRcName("edges", 2)
+	runtime.addWorkerSet(current, 1)
+	// Candidates that were discarded, including one whose version was reused
+	// by this candidate
+	discarded := proto.Clone(candidate).(*pps.PipelineInfo)
+	discarded.AuthToken = "token-discarded"
+	runtime.addWorkerSet(discarded, 2)
+	discarded.Version = 3
+	runtime.addWorkerSet(discarded, 2)
+
+	// The candidate's workers run alongside the pipeline's, and the workers
+	// of discarded candidates are deleted.
+	require.NoError(t, op.stepCandidate())
+	require.Equal(t, 2, len(runtime.sets))
+	require.Equal(t, int32(1), runtime.sets[currentName].replicas)
+	require.Equal(t, int32(2), runtime.sets[candidateName].replicas)
+	require.Equal(t, hashAuthToken("token-2"), runtime.sets[candidateName].authTokenHash)
+	require.Equal(t, pps.PipelineState_PIPELINE_RUNNING, pipelineState(t, op.m.a, candidate.SpecCommit))
+	candidate.State = pps.PipelineState_PIPELINE_RUNNING
+	require.NoError(t, op.stepCandidate())
+	require.Equal(t, 2, len(runtime.sets))
+	require.Equal(t, int32(2), runtime.sets[candidateName].replicas)
+
+	// Stopping the pipeline stops its candidate too
+	current.Stopped = true
+	require.NoError(t, op.stepCandidate())
+	require.Equal(t, int32(0), runtime.sets[candidateName].replicas)
+	current.Stopped = false
+	require.NoError(t, op.stepCandidate())
+	require.Equal(t, int32(2), runtime.sets[candidateName].replicas)
+
+	// Once the candidate is discarded, its workers are deleted, but not the
+	// pipeline's
+	op.candidate = nil
+	require.NoError(t, op.stepCandidate())
+	require.Equal(t, 1, len(runtime.sets))
+	require.Equal(t, int32(1), runtime.sets[currentName].replicas)
+}
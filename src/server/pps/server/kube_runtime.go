@@ -0,0 +1,480 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	kube_err "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_watch "k8s.io/apimachinery/pkg/watch"
+)
+
+// kubeRuntime is the workerRuntime that runs each pipeline's workers as the
+// pods of a kubernetes RC
+type kubeRuntime struct {
+	a *apiServer
+}
+
+func (k *kubeRuntime) validate() {
+	errors := false
+	kubeClient := k.a.env.KubeClient
+	_, err := kubeClient.CoreV1().Pods(k.a.namespace).Watch(metav1.ListOptions{Watch: true})
+	if err != nil {
+		errors = true
+		log.Errorf("unable to access kubernetes pods, Pachyderm will continue to work but certain pipeline errors will result in pipelines being stuck indefinitely in \"starting\" state. error: %v", err)
+	}
+	pods, err := k.rcPods("pachd")
+	if err != nil || len(pods) == 0 {
+		errors = true
+		log.Errorf("unable to access kubernetes pods, Pachyderm will continue to work but 'pachctl logs' will not work. error: %v", err)
+	} else {
+		// No need to check all pods since we're just checking permissions.
+		pod := pods[0]
+		_, err = kubeClient.CoreV1().Pods(k.a.namespace).GetLogs(
+			pod.ObjectMeta.Name, &v1.PodLogOptions{
+				Container: "pachd",
+			}).Timeout(10 * time.Second).Do().Raw()
+		if err != nil {
+			errors = true
+			log.Errorf("unable to access kubernetes logs, Pachyderm will continue to work but 'pachctl logs' will not work. error: %v", err)
+		}
+	}
+	name := uuid.NewWithoutDashes()
+	labels := map[string]string{"app": name}
+	rc := &v1.ReplicationController{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ReplicationController",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Spec: v1.ReplicationControllerSpec{
+			Selector: labels,
+			Replicas: new(int32),
+			Template: &v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: labels,
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:    "name",
+							Image:   DefaultUserImage,
+							Command: []string{"true"},
+						},
+					},
+				},
+			},
+		},
+	}
+	if _, err := kubeClient.CoreV1().ReplicationControllers(k.a.namespace).Create(rc); err != nil {
+		if err != nil {
+			errors = true
+			log.Errorf("unable to create kubernetes replication controllers, Pachyderm will not function properly until this is fixed. error: %v", err)
+		}
+	}
+	if err := kubeClient.CoreV1().ReplicationControllers(k.a.namespace).Delete(name, nil); err != nil {
+		if err != nil {
+			errors = true
+			log.Errorf("unable to delete kubernetes replication controllers, Pachyderm function properly but pipeline cleanup will not work. error: %v", err)
+		}
+	}
+	if !errors {
+		log.Infof("validating kubernetes access returned no errors")
+	}
+}
+
+func (k *kubeRuntime) createWorkers(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
+	return k.a.createWorkerSvcAndRc(ctx, pipelineInfo)
+}
+
+func (k *kubeRuntime) listWorkerSets(pipeline string) ([]*workerSet, error) {
+	selector := "suite=pachyderm," + pipelineNameLabel
+	if pipeline != "" {
+		selector = fmt.Sprintf("%s=%s", pipelineNameLabel, pipeline)
+	}
+	rcs, err := k.a.env.KubeClient.CoreV1().ReplicationControllers(k.a.namespace).List(
+		metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var result []*workerSet
+	for _, rc := range rcs.Items {
+		pipeline, ok := rc.Labels[pipelineNameLabel]
+		if !ok {
+			return nil, errors.Errorf("%q label missing from rc %s", pipelineNameLabel, rc.Name)
+		}
+		ws := &workerSet{
+			name:            rc.ObjectMeta.Name,
+			pipeline:        pipeline,
+			pipelineVersion: rc.ObjectMeta.Annotations[pipelineVersionAnnotation],
+			pachVersion:     rc.ObjectMeta.Annotations[pachVersionAnnotation],
+			authTokenHash:   rc.ObjectMeta.Annotations[hashedAuthTokenAnnotation],
+			created:         rc.ObjectMeta.CreationTimestamp.Time,
+			rolloutFailed:   rc.ObjectMeta.Annotations[rolloutFailedAnnotation] == "true",
+		}
+		if rc.Spec.Replicas != nil {
+			ws.replicas = *rc.Spec.Replicas
+		}
+		result = append(result, ws)
+	}
+	return result, nil
+}
+
+func (k *kubeRuntime) scaleWorkers(name string, replicas int32) error {
+	rc := k.a.env.KubeClient.CoreV1().ReplicationControllers(k.a.namespace)
+	scale, err := rc.GetScale(name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "could not get scale of RC %q", name)
+	}
+	if scale.Spec.Replicas == replicas {
+		return nil
+	}
+	scale.Spec.Replicas = replicas
+	if _, err := rc.UpdateScale(name, scale); err != nil {
+		return errors.Wrapf(err, "could not scale RC %q", name)
+	}
+	return nil
+}
+
+func (k *kubeRuntime) deleteWorkers(pipeline string) error {
+	kubeClient := k.a.env.KubeClient
+	namespace := k.a.namespace
+
+	// Delete any services associated with op.pipeline
+	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, pipeline)
+	opts := &metav1.DeleteOptions{
+		OrphanDependents: &falseVal,
+	}
+	services, err := kubeClient.CoreV1().Services(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list services")
+	}
+	for _, service := range services.Items {
+		if err := kubeClient.CoreV1().Services(namespace).Delete(service.Name, opts); err != nil {
+			if !errutil.IsNotFoundError(err) {
+				return errors.Wrapf(err, "could not delete service %q", service.Name)
+			}
+		}
+	}
+
+	// Delete any secrets associated with op.pipeline
+	secrets, err := kubeClient.CoreV1().Secrets(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list secrets")
+	}
+	for _, secret := range secrets.Items {
+		if err := kubeClient.CoreV1().Secrets(namespace).Delete(secret.Name, opts); err != nil {
+			if !errutil.IsNotFoundError(err) {
+				return errors.Wrapf(err, "could not delete secret %q", secret.Name)
+			}
+		}
+	}
+
+	// Finally, delete op.pipeline's RC, which will cause pollPipelines to stop
+	// polling it.
+	rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list RCs")
+	}
+	for _, rc := range rcs.Items {
+		if err := kubeClient.CoreV1().ReplicationControllers(namespace).Delete(rc.Name, opts); err != nil {
+			if !errutil.IsNotFoundError(err) {
+				return errors.Wrapf(err, "could not delete RC %q", rc.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (k *kubeRuntime) pendingHigherPriority(pipelineInfo *pps.PipelineInfo) (string, bool, error) {
+	pods, err := k.a.env.KubeClient.CoreV1().Pods(k.a.namespace).List(metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
+			map[string]string{
+				"component": "worker",
+			})),
+		FieldSelector: "status.phase=Pending",
+	})
+	if err != nil {
+		return "", false, errors.Wrapf(err, "could not list pending worker pods")
+	}
+	pipeline, ok := higherPriorityPending(pods.Items, pipelineInfo, time.Now())
+	return pipeline, ok, nil
+}
+
+// pendingPriorityTimeout is how long a higher priority pipeline's workers can
+// be unschedulable before lower priority pipelines stop waiting for them. If
+// they haven't been scheduled by then, holding back other workers isn't
+// helping.
+const pendingPriorityTimeout = 10 * time.Minute
+
+// higherPriorityPending returns the name of a pipeline with a higher priority
+// than pipelineInfo's that has worker pods which can't be scheduled, if there
+// is one. Only pods which are unschedulable because the cluster is short of
+// resources, and which became unschedulable within pendingPriorityTimeout of
+// 'now', are considered, as those are the ones that holding back lower
+// priority workers can help.
+func higherPriorityPending(pods []v1.Pod, pipelineInfo *pps.PipelineInfo, now time.Time) (string, bool) {
+	for _, pod := range pods {
+		pipeline := pod.ObjectMeta.Annotations[pipelineNameLabel]
+		if pipeline == pipelineInfo.Pipeline.Name {
+			continue
+		}
+		// Pods created before priorities were added have the default priority.
+		var priority int64
+		if p, ok := pod.ObjectMeta.Annotations[pipelinePriorityAnnotation]; ok {
+			var err error
+			if priority, err = strconv.ParseInt(p, 10, 64); err != nil {
+				continue
+			}
+		}
+		if priority <= pipelineInfo.Details.Priority {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type != v1.PodScheduled || cond.Status != v1.ConditionFalse || cond.Reason != v1.PodReasonUnschedulable {
+				continue
+			}
+			// e.g. "0/3 nodes are available: 3 Insufficient cpu."
+			if !strings.Contains(cond.Message, "Insufficient") {
+				continue
+			}
+			if now.Sub(cond.LastTransitionTime.Time) > pendingPriorityTimeout {
+				continue
+			}
+			return pipeline, true
+		}
+	}
+	return "", false
+}
+
+// watchWorkers creates a kubernetes watch, and for each event:
+//  1. Checks if the event concerns a Pod
+//  2. Checks if the Pod belongs to a pipeline (pipelineName annotation is set)
+//  3. Checks if the Pod is failing
+//
+// If all three conditions are met, then it calls 'crash' for the pipeline in
+// 'pipelineName'
+func (k *kubeRuntime) watchWorkers(ctx context.Context, crash func(pipeline string, pipelineVersion int, reason string) error) error {
+	kubePipelineWatch, err := k.a.env.KubeClient.CoreV1().Pods(k.a.namespace).Watch(
+		metav1.ListOptions{
+			LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
+				map[string]string{
+					"component": "worker",
+				})),
+			Watch: true,
+		})
+	if err != nil {
+		return errors.Wrap(err, "failed to watch kubernetes pods")
+	}
+	defer kubePipelineWatch.Stop()
+WatchLoop:
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-kubePipelineWatch.ResultChan():
+			// if we get an error we restart the watch
+			if event.Type == kube_watch.Error {
+				return errors.Wrap(kube_err.FromObject(event.Object), "error while watching kubernetes pods")
+			} else if event.Type == "" {
+				// k8s watches seem to sometimes get stuck in a loop returning events
+				// with Type = "". We treat these as errors as otherwise we get an
+				// endless stream of them and can't do anything.
+				return errors.New("error while watching kubernetes pods: empty event type")
+			}
+			pod, ok := event.Object.(*v1.Pod)
+			if !ok {
+				continue // irrelevant event
+			}
+			if pod.Status.Phase == v1.PodFailed {
+				log.Errorf("pod failed because: %s", pod.Status.Message)
+			}
+			crashPipeline := func(reason string) error {
+				pipelineName := pod.ObjectMeta.Annotations[pipelineNameLabel]
+				pipelineVersion, err := strconv.Atoi(pod.ObjectMeta.Annotations[pipelineVersionAnnotation])
+				if err != nil {
+					return errors.Wrapf(err, "couldn't find pipeline rc version")
+				}
+				return crash(pipelineName, pipelineVersion, reason)
+			}
+			for _, status := range pod.Status.ContainerStatuses {
+				if status.State.Waiting != nil && failures[status.State.Waiting.Reason] {
+					if err := crashPipeline(status.State.Waiting.Message); err != nil {
+						return errors.Wrap(err, "error moving pipeline to CRASHING")
+					}
+					continue WatchLoop
+				}
+			}
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == v1.PodScheduled &&
+					condition.Status != v1.ConditionTrue && failures[condition.Reason] {
+					if err := crashPipeline(condition.Message); err != nil {
+						return errors.Wrap(err, "error moving pipeline to CRASHING")
+					}
+					continue WatchLoop
+				}
+			}
+		}
+	}
+}
+
+func (k *kubeRuntime) listWorkers(name string) ([]string, error) {
+	pods, err := k.rcPods(name)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, pod := range pods {
+		result = append(result, pod.ObjectMeta.Name)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (k *kubeRuntime) rcPods(rcName string) ([]v1.Pod, error) {
+	podList, err := k.a.env.KubeClient.CoreV1().Pods(k.a.namespace).List(metav1.ListOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ListOptions",
+			APIVersion: "v1",
+		},
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{"app": rcName})),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+func (k *kubeRuntime) workerLogs(worker, container string, opts *workerLogOptions) (io.ReadCloser, error) {
+	var tailLines *int64
+	if opts.tail > 0 {
+		tailLines = &opts.tail
+	}
+	return k.a.env.KubeClient.CoreV1().Pods(k.a.namespace).GetLogs(
+		worker, &v1.PodLogOptions{
+			Container:    container,
+			Follow:       opts.follow,
+			TailLines:    tailLines,
+			SinceSeconds: &opts.sinceSeconds,
+		}).Timeout(10 * time.Second).Stream()
+}
+
+func (k *kubeRuntime) serviceIP(name string) (string, error) {
+	service, err := k.a.env.KubeClient.CoreV1().Services(k.a.namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return service.Spec.ClusterIP, nil
+}
+
+func (k *kubeRuntime) servingWorkers(pipelineInfo *pps.PipelineInfo) (string, error) {
+	service, err := k.a.env.KubeClient.CoreV1().Services(k.a.namespace).Get(userServiceName(pipelineInfo), metav1.GetOptions{})
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "could not get user service of %q", pipelineInfo.Pipeline.Name)
+	}
+	return service.Spec.Selector["app"], nil
+}
+
+func (k *kubeRuntime) routeService(pipelineInfo *pps.PipelineInfo) error {
+	services := k.a.env.KubeClient.CoreV1().Services(k.a.namespace)
+	name := userServiceName(pipelineInfo)
+	service, err := services.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "could not get service %q", name)
+	}
+	pipeline := pipelineInfo.Pipeline.Name
+	service.Spec.Selector = userServiceSelector(ppsutil.PipelineRcName(pipeline, pipelineInfo.Version), pipeline)
+	if _, err := services.Update(service); err != nil {
+		return errors.Wrapf(err, "could not update service %q", name)
+	}
+	return nil
+}
+
+func (k *kubeRuntime) workersReady(name string) (bool, error) {
+	pods, err := k.rcPods(name)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not list pods of RC %q", name)
+	}
+	return anyPodReady(pods), nil
+}
+
+// anyPodReady returns whether any of pods is ready, i.e. has passed its
+// containers' readiness probes
+func anyPodReady(pods []v1.Pod) bool {
+	for _, pod := range pods {
+		if pod.ObjectMeta.DeletionTimestamp != nil {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == v1.PodReady && cond.Status == v1.ConditionTrue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (k *kubeRuntime) abortRollout(name string) error {
+	rcs := k.a.env.KubeClient.CoreV1().ReplicationControllers(k.a.namespace)
+	rc, err := rcs.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "could not get RC %q", name)
+	}
+	if rc.ObjectMeta.Annotations == nil {
+		rc.ObjectMeta.Annotations = map[string]string{}
+	}
+	rc.ObjectMeta.Annotations[rolloutFailedAnnotation] = "true"
+	rc.Spec.Replicas = new(int32)
+	if _, err := rcs.Update(rc); err != nil {
+		return errors.Wrapf(err, "could not update RC %q", name)
+	}
+	return nil
+}
+
+func (k *kubeRuntime) deleteWorkerSet(name string) error {
+	kubeClient := k.a.env.KubeClient
+	opts := &metav1.DeleteOptions{
+		OrphanDependents: &falseVal,
+	}
+	// The worker service shares the RC's name, and a user service that isn't
+	// shared by the pipeline's versions is named after it too
+	for _, service := range []string{name, name + "-user"} {
+		if err := kubeClient.CoreV1().Services(k.a.namespace).Delete(service, opts); err != nil {
+			if !errutil.IsNotFoundError(err) {
+				return errors.Wrapf(err, "could not delete service %q", service)
+			}
+		}
+	}
+	if err := kubeClient.CoreV1().ReplicationControllers(k.a.namespace).Delete(name, opts); err != nil {
+		if !errutil.IsNotFoundError(err) {
+			return errors.Wrapf(err, "could not delete RC %q", name)
+		}
+	}
+	return nil
+}
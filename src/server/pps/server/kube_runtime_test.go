@@ -0,0 +1,93 @@
+package server
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func workerPod(pipeline string, priority int64, scheduled bool) v1.Pod {
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				pipelineNameLabel:          pipeline,
+				pipelinePriorityAnnotation: strconv.FormatInt(priority, 10),
+			},
+		},
+	}
+	if !scheduled {
+		pod.Status.Conditions = []v1.PodCondition{{
+			Type:               v1.PodScheduled,
+			Status:             v1.ConditionFalse,
+			Reason:             v1.PodReasonUnschedulable,
+			Message:            "0/3 nodes are available: 3 Insufficient cpu.",
+			LastTransitionTime: metav1.Time{Time: time.Now()},
+		}}
+	}
+	return pod
+}
+
+func TestHigherPriorityPending(t *testing.T) {
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline: &pps.Pipeline{Name: "routine"},
+		Details:  &pps.PipelineInfo_Details{Priority: 1},
+	}
+	// Unschedulable workers of higher priority pipelines defer scaling up.
+	pipeline, ok := higherPriorityPending([]v1.Pod{
+		workerPod("other", 1, false),
+		workerPod("backfill", 2, false),
+	}, pipelineInfo, time.Now())
+	require.True(t, ok)
+	require.Equal(t, "backfill", pipeline)
+	// Workers of the pipeline itself, of pipelines with the same or a lower
+	// priority, and workers that are just waiting to start, don't.
+	_, ok = higherPriorityPending([]v1.Pod{
+		workerPod("routine", 2, false),
+		workerPod("other", 1, false),
+		workerPod("lower", 0, false),
+		workerPod("backfill", 2, true),
+	}, pipelineInfo, time.Now())
+	require.False(t, ok)
+	// Workers created before priorities were added have the default priority.
+	pod := workerPod("old", 0, false)
+	delete(pod.ObjectMeta.Annotations, pipelinePriorityAnnotation)
+	pipelineInfo.Details.Priority = -1
+	_, ok = higherPriorityPending([]v1.Pod{pod}, pipelineInfo, time.Now())
+	require.True(t, ok)
+	// Workers that are unschedulable for reasons other than a lack of
+	// resources, which holding back other workers can't fix, don't.
+	pod = workerPod("backfill", 2, false)
+	pod.Status.Conditions[0].Message = "0/3 nodes are available: 3 node(s) didn't match node selector."
+	_, ok = higherPriorityPending([]v1.Pod{pod}, pipelineInfo, time.Now())
+	require.False(t, ok)
+	// Nor do workers that have been unschedulable for longer than
+	// pendingPriorityTimeout.
+	pod = workerPod("backfill", 2, false)
+	now := pod.Status.Conditions[0].LastTransitionTime.Time
+	_, ok = higherPriorityPending([]v1.Pod{pod}, pipelineInfo, now.Add(pendingPriorityTimeout-time.Second))
+	require.True(t, ok)
+	_, ok = higherPriorityPending([]v1.Pod{pod}, pipelineInfo, now.Add(pendingPriorityTimeout+time.Second))
+	require.False(t, ok)
+}
+
+func TestAnyPodReady(t *testing.T) {
+	pod := func(ready v1.ConditionStatus) v1.Pod {
+		return v1.Pod{Status: v1.PodStatus{Conditions: []v1.PodCondition{
+			{Type: v1.PodScheduled, Status: v1.ConditionTrue},
+			{Type: v1.PodReady, Status: ready},
+		}}}
+	}
+	require.False(t, anyPodReady(nil))
+	require.False(t, anyPodReady([]v1.Pod{pod(v1.ConditionFalse)}))
+	require.True(t, anyPodReady([]v1.Pod{pod(v1.ConditionFalse), pod(v1.ConditionTrue)}))
+	// Pods that are being deleted don't count
+	terminating := pod(v1.ConditionTrue)
+	terminating.ObjectMeta.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	require.False(t, anyPodReady([]v1.Pod{terminating}))
+}
@@ -0,0 +1,547 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/deploy/assets"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// maxLocalWorkers is the number of loopback addresses (127.0.0.2 through
+	// 127.0.0.254) that the local runtime gives to workers
+	maxLocalWorkers = 253
+	// localWorkerMaxBackoff is the longest that the local runtime waits before
+	// restarting a worker that exited
+	localWorkerMaxBackoff = 30 * time.Second
+	// localLogPollInterval is how often followed worker logs are checked for
+	// new lines
+	localLogPollInterval = 100 * time.Millisecond
+)
+
+var errLocalServices = errors.New("the local worker runtime doesn't support services")
+
+// localRuntime is the workerRuntime that runs workers as subprocesses of pachd,
+// so that pipelines can run (e.g. in testpachd) without a kubernetes cluster.
+// Each worker is an instance of the worker binary, with its own working
+// directory and loopback address (so that all workers can listen on the
+// worker GRPC port), and is restarted if it exits.
+//
+// Workers share pachd's host, so the local runtime doesn't support anything
+// that needs a pod: secrets, services, S3 inputs and outputs (which are served
+// by the worker sidecar), or resource requests and limits (which are ignored).
+type localRuntime struct {
+	a      *apiServer
+	binary string
+	root   string
+	// environ returns the environment that workers inherit
+	environ func() []string
+
+	mu      sync.Mutex
+	sets    map[string]*localWorkerSet // by name
+	ips     [maxLocalWorkers]bool      // in-use loopback addresses
+	crashCh chan localCrash
+}
+
+// localWorkerSet is a workerSet run by the local runtime
+type localWorkerSet struct {
+	workerSet
+	dir     string   // working directories and logs of the set's workers
+	env     []string // environment of the set's workers
+	workers []*localWorker
+}
+
+// localWorker is one supervised worker process
+type localWorker struct {
+	name   string
+	ip     int // index into localRuntime.ips
+	dir    string
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// localCrash is a worker that the local runtime couldn't run
+type localCrash struct {
+	pipeline        string
+	pipelineVersion string
+	reason          string
+}
+
+func newLocalRuntime(a *apiServer) *localRuntime {
+	root := a.env.Config.PPSLocalWorkerRoot
+	if root == "" {
+		root = filepath.Join(os.TempDir(), "pachyderm-workers")
+	}
+	return &localRuntime{
+		a:       a,
+		binary:  a.env.Config.PPSLocalWorkerBinary,
+		root:    root,
+		environ: os.Environ,
+		sets:    make(map[string]*localWorkerSet),
+		crashCh: make(chan localCrash, 100),
+	}
+}
+
+func (l *localRuntime) validate() {
+	errors := false
+	if _, err := exec.LookPath(l.binary); err != nil {
+		errors = true
+		log.Errorf("unable to find the worker binary %q, pipelines will crash until this is fixed. error: %v", l.binary, err)
+	}
+	if err := os.MkdirAll(l.root, 0755); err != nil {
+		errors = true
+		log.Errorf("unable to create the local worker directory %q, pipelines will not start until this is fixed. error: %v", l.root, err)
+	}
+	if !errors {
+		log.Infof("validating local worker runtime returned no errors")
+	}
+}
+
+func (l *localRuntime) createWorkers(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
+	log.Infof("PPS master: upserting local workers for %q", pipelineInfo.Pipeline.Name)
+	if err := localRuntimeSupports(pipelineInfo); err != nil {
+		return noValidOptionsErr{err}
+	}
+	options, err := l.a.getWorkerOptions(pipelineInfo)
+	if err != nil {
+		return noValidOptionsErr{err}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.sets[options.rcName]; ok {
+		return nil
+	}
+	dir := filepath.Join(l.root, options.rcName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrapf(err, "could not create directory for workers of %q", pipelineInfo.Pipeline.Name)
+	}
+	l.sets[options.rcName] = &localWorkerSet{
+		workerSet: workerSet{
+			name:            options.rcName,
+			pipeline:        pipelineInfo.Pipeline.Name,
+			pipelineVersion: options.annotations[pipelineVersionAnnotation],
+			pachVersion:     options.annotations[pachVersionAnnotation],
+			authTokenHash:   options.annotations[hashedAuthTokenAnnotation],
+			created:         time.Now(),
+		},
+		dir: dir,
+		env: l.workerEnv(options, pipelineInfo),
+	}
+	return nil
+}
+
+// localRuntimeSupports returns an error if pipelineInfo needs any features
+// that the local runtime doesn't have
+func localRuntimeSupports(pipelineInfo *pps.PipelineInfo) error {
+	switch {
+	case len(pipelineInfo.Details.Transform.Secrets) > 0:
+		return errors.New("the local worker runtime doesn't support secrets")
+	case pipelineInfo.Details.Service != nil ||
+		pipelineInfo.Details.Spout != nil && pipelineInfo.Details.Spout.Service != nil:
+		return errLocalServices
+	case ppsutil.ContainsS3Inputs(pipelineInfo.Details.Input) || pipelineInfo.Details.S3Out:
+		return errors.New("the local worker runtime doesn't support S3 inputs or outputs")
+	}
+	return nil
+}
+
+// workerEnv returns the environment of the workers described by 'options'.
+// It's the same as the environment of the user container in kubernetes, except
+// that the values kubernetes would set are copied from pachd's config.
+func (l *localRuntime) workerEnv(options *workerOptions, pipelineInfo *pps.PipelineInfo) []string {
+	config := l.a.env.Config
+	env := append(l.environ(),
+		"ETCD_SERVICE_HOST="+config.EtcdHost,
+		"ETCD_SERVICE_PORT="+config.EtcdPort,
+		"PACH_ROOT="+l.a.storageRoot,
+		"PACH_NAMESPACE="+l.a.namespace,
+		"STORAGE_BACKEND="+l.a.storageBackend,
+		"POSTGRES_USER="+config.PostgresUser,
+		"POSTGRES_PASSWORD="+config.PostgresPassword,
+		"POSTGRES_DATABASE="+config.PostgresDBName,
+		"POSTGRES_HOST=",
+		"POSTGRES_PORT=",
+		"PG_BOUNCER_HOST="+config.PGBouncerHost,
+		"PG_BOUNCER_PORT="+strconv.Itoa(config.PGBouncerPort),
+		client.PeerPortEnv+"="+strconv.FormatUint(uint64(l.a.peerPort), 10),
+		client.PPSSpecCommitEnv+"="+options.specCommit,
+		client.PPSPipelineNameEnv+"="+pipelineInfo.Pipeline.Name,
+		client.PPSEtcdPrefixEnv+"="+l.a.etcdPrefix,
+		client.PPSWorkerPortEnv+"="+strconv.FormatUint(uint64(l.a.workerGrpcPort), 10),
+		"PACH_IN_WORKER=true",
+		assets.WorkBackendEnvVar+"="+config.WorkBackend,
+	)
+	if config.DisableCommitProgressCounter {
+		env = append(env, "DISABLE_COMMIT_PROGRESS_COUNTER=true")
+	}
+	if config.LokiLogging {
+		env = append(env, "LOKI_LOGGING=true")
+	}
+	// localRuntimeSupports has rejected secrets, so every variable has a value
+	for _, v := range options.workerEnv {
+		env = append(env, v.Name+"="+v.Value)
+	}
+	return env
+}
+
+func (l *localRuntime) listWorkerSets(pipeline string) ([]*workerSet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var result []*workerSet
+	for _, set := range l.sets {
+		if pipeline == "" || set.pipeline == pipeline {
+			ws := set.workerSet
+			result = append(result, &ws)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].name < result[j].name })
+	return result, nil
+}
+
+func (l *localRuntime) scaleWorkers(name string, replicas int32) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	set, ok := l.sets[name]
+	if !ok {
+		return errors.Errorf("no local workers named %q", name)
+	}
+	for len(set.workers) < int(replicas) {
+		w, err := l.startWorker(set, len(set.workers))
+		if err != nil {
+			return err
+		}
+		set.workers = append(set.workers, w)
+	}
+	for len(set.workers) > int(replicas) {
+		l.stopWorker(set.workers[len(set.workers)-1])
+		set.workers = set.workers[:len(set.workers)-1]
+	}
+	set.replicas = replicas
+	return nil
+}
+
+// startWorker starts the i'th worker of 'set'. l.mu must be held.
+func (l *localRuntime) startWorker(set *localWorkerSet, i int) (*localWorker, error) {
+	ip := -1
+	for j, used := range l.ips {
+		if !used {
+			ip = j
+			break
+		}
+	}
+	if ip < 0 {
+		return nil, errors.Errorf("the local worker runtime can't run more than %d workers", maxLocalWorkers)
+	}
+	name := fmt.Sprintf("%s-%d", set.name, i)
+	dir := filepath.Join(set.dir, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "could not create directory for worker %q", name)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &localWorker{
+		name:   name,
+		ip:     ip,
+		dir:    dir,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	l.ips[ip] = true
+	go l.superviseWorker(ctx, set, w)
+	return w, nil
+}
+
+// stopWorker stops 'w' and waits for it to exit. l.mu must be held.
+func (l *localRuntime) stopWorker(w *localWorker) {
+	w.cancel()
+	<-w.done
+	l.ips[w.ip] = false
+}
+
+// superviseWorker runs 'w' until ctx is cancelled, restarting it with backoff
+// whenever it exits
+func (l *localRuntime) superviseWorker(ctx context.Context, set *localWorkerSet, w *localWorker) {
+	defer close(w.done)
+	ip := fmt.Sprintf("127.0.0.%d", w.ip+2)
+	env := append(append([]string{}, set.env...),
+		client.PPSWorkerIPEnv+"="+ip,
+		"PPS_WORKER_BIND_IP="+ip,
+		client.PPSPodNameEnv+"="+w.name,
+		"PPS_WORKER_ROOT_PATH="+w.dir,
+	)
+	b := backoff.NewExponentialBackOff()
+	b.MaxInterval = localWorkerMaxBackoff
+	b.MaxElapsedTime = 0
+	for {
+		start := time.Now()
+		if err := l.runWorker(ctx, set, w, env); err != nil {
+			log.Errorf("local worker %q exited: %v", w.name, err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(start) > localWorkerMaxBackoff {
+			b.Reset() // the worker ran for a while, so restart it promptly
+		}
+		select {
+		case <-time.After(b.NextBackOff()):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runWorker runs one instance of 'w', appending its output to its log file
+func (l *localRuntime) runWorker(ctx context.Context, set *localWorkerSet, w *localWorker, env []string) (retErr error) {
+	logFile, err := os.OpenFile(l.logPath(set, w.name), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "could not open log file")
+	}
+	defer func() {
+		if err := logFile.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	cmd := exec.CommandContext(ctx, l.binary)
+	cmd.Dir = w.dir
+	cmd.Env = env
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		// The worker will never run, so move its pipeline to CRASHING
+		select {
+		case l.crashCh <- localCrash{
+			pipeline:        set.pipeline,
+			pipelineVersion: set.pipelineVersion,
+			reason:          fmt.Sprintf("could not start worker: %v", err),
+		}:
+		default:
+		}
+		return errors.Wrapf(err, "could not start worker")
+	}
+	return cmd.Wait()
+}
+
+func (l *localRuntime) logPath(set *localWorkerSet, worker string) string {
+	return filepath.Join(set.dir, worker+".log")
+}
+
+func (l *localRuntime) deleteWorkers(pipeline string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for name, set := range l.sets {
+		if set.pipeline != pipeline {
+			continue
+		}
+		if err := l.deleteSet(name, set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *localRuntime) deleteWorkerSet(name string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	set, ok := l.sets[name]
+	if !ok {
+		return nil
+	}
+	return l.deleteSet(name, set)
+}
+
+// deleteSet stops the workers of 'set' and deletes it. l.mu must be held.
+func (l *localRuntime) deleteSet(name string, set *localWorkerSet) error {
+	for _, w := range set.workers {
+		l.stopWorker(w)
+	}
+	delete(l.sets, name)
+	if err := os.RemoveAll(set.dir); err != nil {
+		return errors.Wrapf(err, "could not delete directory of workers %q", name)
+	}
+	return nil
+}
+
+// pendingHigherPriority always returns false, as local workers start
+// immediately
+func (l *localRuntime) pendingHigherPriority(pipelineInfo *pps.PipelineInfo) (string, bool, error) {
+	return "", false, nil
+}
+
+func (l *localRuntime) watchWorkers(ctx context.Context, crash func(pipeline string, pipelineVersion int, reason string) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case c := <-l.crashCh:
+			pipelineVersion, err := strconv.Atoi(c.pipelineVersion)
+			if err != nil {
+				return errors.Wrapf(err, "couldn't find pipeline rc version")
+			}
+			if err := crash(c.pipeline, pipelineVersion, c.reason); err != nil {
+				return errors.Wrap(err, "error moving pipeline to CRASHING")
+			}
+		}
+	}
+}
+
+func (l *localRuntime) listWorkers(name string) ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	set, ok := l.sets[name]
+	if !ok {
+		return nil, nil
+	}
+	var result []string
+	for _, w := range set.workers {
+		result = append(result, w.name)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// workerLogs returns the output of 'worker' (local workers only have one
+// container). opts.sinceSeconds applies to the log lines that have a
+// timestamp, which are the ones written by the worker itself. If opts.follow
+// is set, the logs are followed until the worker is deleted or the returned
+// reader is closed.
+func (l *localRuntime) workerLogs(worker, container string, opts *workerLogOptions) (io.ReadCloser, error) {
+	l.mu.Lock()
+	var path string
+	var done chan struct{}
+	for _, set := range l.sets {
+		for _, w := range set.workers {
+			if w.name == worker {
+				path, done = l.logPath(set, worker), w.done
+			}
+		}
+	}
+	l.mu.Unlock()
+	if path == "" {
+		return nil, errors.Errorf("no local worker named %q", worker)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	logs, err := ioutil.ReadAll(f)
+	if err != nil {
+		f.Close()
+		return nil, errors.EnsureStack(err)
+	}
+	var since time.Time
+	if opts.sinceSeconds > 0 {
+		since = time.Now().Add(-time.Duration(opts.sinceSeconds) * time.Second)
+	}
+	logs = filterLogLines(logs, opts.tail, since)
+	if !opts.follow {
+		if err := f.Close(); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return ioutil.NopCloser(bytes.NewReader(logs)), nil
+	}
+	// Lines written after the logs were read are followed from the file's
+	// current offset.
+	if len(logs) > 0 {
+		logs = append(logs, '\n')
+	}
+	fr := &followReader{f: f, done: done, closed: make(chan struct{})}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(logs), fr), fr}, nil
+}
+
+// filterLogLines returns the last 'tail' lines of 'logs' (or all of them if
+// tail <= 0), skipping lines with a timestamp before 'since', if it's set.
+func filterLogLines(logs []byte, tail int64, since time.Time) []byte {
+	lines := bytes.SplitAfter(bytes.TrimSuffix(logs, []byte("\n")), []byte("\n"))
+	if !since.IsZero() {
+		var kept [][]byte
+		for _, line := range lines {
+			var msg struct {
+				Ts time.Time `json:"ts"`
+			}
+			if err := json.Unmarshal(line, &msg); err == nil && !msg.Ts.IsZero() && msg.Ts.Before(since) {
+				continue
+			}
+			kept = append(kept, line)
+		}
+		lines = kept
+	}
+	if tail > 0 && int64(len(lines)) > tail {
+		lines = lines[int64(len(lines))-tail:]
+	}
+	return bytes.TrimSuffix(bytes.Join(lines, nil), []byte("\n"))
+}
+
+// followReader reads a log file as it's written, until the worker writing it
+// is done or the reader is closed.
+type followReader struct {
+	f         *os.File
+	done      <-chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (r *followReader) Read(p []byte) (int, error) {
+	for {
+		n, err := r.f.Read(p)
+		if n > 0 || err != io.EOF {
+			return n, err
+		}
+		select {
+		case <-r.done:
+			// Read anything the worker wrote before it exited.
+			return r.f.Read(p)
+		case <-r.closed:
+			return 0, io.EOF
+		case <-time.After(localLogPollInterval):
+		}
+	}
+}
+
+func (r *followReader) Close() error {
+	r.closeOnce.Do(func() { close(r.closed) })
+	return errors.EnsureStack(r.f.Close())
+}
+
+// serviceIP always returns "", as the local runtime doesn't support services
+func (l *localRuntime) serviceIP(name string) (string, error) {
+	return "", nil
+}
+
+// servingWorkers always returns "", as local workers can't be services
+func (l *localRuntime) servingWorkers(pipelineInfo *pps.PipelineInfo) (string, error) {
+	return "", nil
+}
+
+func (l *localRuntime) routeService(pipelineInfo *pps.PipelineInfo) error {
+	return errLocalServices
+}
+
+func (l *localRuntime) workersReady(name string) (bool, error) {
+	return false, errLocalServices
+}
+
+func (l *localRuntime) abortRollout(name string) error {
+	return errLocalServices
+}
@@ -0,0 +1,245 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// newTestLocalRuntime returns a localRuntime that runs 'script' as its worker
+// binary
+func newTestLocalRuntime(t *testing.T, script string) *localRuntime {
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "worker")
+	require.NoError(t, ioutil.WriteFile(binary, []byte("#!/bin/sh\n"+script), 0755))
+	a := &apiServer{env: Env{Config: *serviceenv.ConfigFromOptions(func(config *serviceenv.Configuration) {
+		config.PPSLocalWorkerBinary = binary
+		config.PPSLocalWorkerRoot = filepath.Join(dir, "workers")
+	})}}
+	l := newLocalRuntime(a)
+	l.environ = func() []string { return []string{"PATH=" + os.Getenv("PATH")} }
+	t.Cleanup(func() {
+		require.NoError(t, l.deleteWorkers("edges"))
+	})
+	return l
+}
+
+func testPipelineInfo() *pps.PipelineInfo {
+	return &pps.PipelineInfo{
+		Pipeline:   client.NewPipeline("edges"),
+		Version:    1,
+		SpecCommit: client.NewCommit("edges", "spec", "abc"),
+		Details: &pps.PipelineInfo_Details{
+			Transform: &pps.Transform{
+				Image: "ubuntu",
+				Cmd:   []string{"true"},
+				Env:   map[string]string{"GREETING": "hello"},
+			},
+		},
+	}
+}
+
+// waitForLogs waits until the logs of 'worker' contain 'expected'
+func waitForLogs(t *testing.T, l *localRuntime, worker, expected string) {
+	require.NoError(t, backoff.Retry(func() error {
+		r, err := l.workerLogs(worker, client.PPSWorkerUserContainerName, &workerLogOptions{})
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		logs, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(string(logs), expected) {
+			return errors.Errorf("logs of %q don't contain %q yet: %q", worker, expected, logs)
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+}
+
+func TestLocalRuntime(t *testing.T) {
+	l := newTestLocalRuntime(t, `echo "$GREETING from $PPS_POD_NAME at $PPS_WORKER_IP"
+exec sleep 600
+`)
+	pipelineInfo := testPipelineInfo()
+	require.NoError(t, l.createWorkers(context.Background(), pipelineInfo))
+	// Creating workers again is a no-op
+	require.NoError(t, l.createWorkers(context.Background(), pipelineInfo))
+	sets, err := l.listWorkerSets("edges")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(sets))
+	require.Equal(t, "pipeline-edges-v1", sets[0].name)
+	require.Equal(t, "1", sets[0].pipelineVersion)
+	require.Equal(t, hashAuthToken(""), sets[0].authTokenHash)
+	require.Equal(t, int32(0), sets[0].replicas)
+
+	// Each worker gets its own name and address
+	require.NoError(t, l.scaleWorkers("pipeline-edges-v1", 2))
+	workers, err := l.listWorkers("pipeline-edges-v1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"pipeline-edges-v1-0", "pipeline-edges-v1-1"}, workers)
+	waitForLogs(t, l, "pipeline-edges-v1-0", "hello from pipeline-edges-v1-0 at 127.0.0.2")
+	waitForLogs(t, l, "pipeline-edges-v1-1", "hello from pipeline-edges-v1-1 at 127.0.0.3")
+
+	// Scaling down stops the last workers
+	require.NoError(t, l.scaleWorkers("pipeline-edges-v1", 1))
+	workers, err = l.listWorkers("pipeline-edges-v1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"pipeline-edges-v1-0"}, workers)
+	sets, err = l.listWorkerSets("")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), sets[0].replicas)
+
+	// Deleting the pipeline's workers removes their files
+	require.NoError(t, l.deleteWorkers("edges"))
+	sets, err = l.listWorkerSets("")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(sets))
+	_, err = os.Stat(filepath.Join(l.root, "pipeline-edges-v1"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestLocalRuntimeLogTail(t *testing.T) {
+	l := newTestLocalRuntime(t, `printf 'one\ntwo\nthree\n'
+exec sleep 600
+`)
+	require.NoError(t, l.createWorkers(context.Background(), testPipelineInfo()))
+	require.NoError(t, l.scaleWorkers("pipeline-edges-v1", 1))
+	waitForLogs(t, l, "pipeline-edges-v1-0", "three")
+	r, err := l.workerLogs("pipeline-edges-v1-0", client.PPSWorkerUserContainerName, &workerLogOptions{tail: 2})
+	require.NoError(t, err)
+	logs, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "two\nthree", string(logs))
+}
+
+func TestLocalRuntimeLogSince(t *testing.T) {
+	l := newTestLocalRuntime(t, `printf '{"ts":"2000-01-01T00:00:00Z","message":"old"}\n{"ts":"%s","message":"new"}\nplain\n' "$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+exec sleep 600
+`)
+	require.NoError(t, l.createWorkers(context.Background(), testPipelineInfo()))
+	require.NoError(t, l.scaleWorkers("pipeline-edges-v1", 1))
+	waitForLogs(t, l, "pipeline-edges-v1-0", "plain")
+	// Lines without a timestamp are always returned
+	r, err := l.workerLogs("pipeline-edges-v1-0", client.PPSWorkerUserContainerName, &workerLogOptions{sinceSeconds: 60})
+	require.NoError(t, err)
+	logs, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.False(t, strings.Contains(string(logs), "old"), string(logs))
+	require.True(t, strings.Contains(string(logs), "new"), string(logs))
+	require.True(t, strings.HasSuffix(string(logs), "plain"), string(logs))
+}
+
+func TestLocalRuntimeLogFollow(t *testing.T) {
+	dir := t.TempDir()
+	l := newTestLocalRuntime(t, fmt.Sprintf(`echo one
+while [ ! -e %s/continue ]; do sleep 0.1; done
+echo two
+exec sleep 600
+`, dir))
+	require.NoError(t, l.createWorkers(context.Background(), testPipelineInfo()))
+	require.NoError(t, l.scaleWorkers("pipeline-edges-v1", 1))
+	waitForLogs(t, l, "pipeline-edges-v1-0", "one")
+	r, err := l.workerLogs("pipeline-edges-v1-0", client.PPSWorkerUserContainerName, &workerLogOptions{follow: true})
+	require.NoError(t, err)
+	scanner := bufio.NewScanner(r)
+	require.True(t, scanner.Scan())
+	require.Equal(t, "one", scanner.Text())
+	// Lines written after the logs were requested are returned too
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "continue"), nil, 0644))
+	require.True(t, scanner.Scan())
+	require.Equal(t, "two", scanner.Text())
+	require.NoError(t, r.Close())
+}
+
+// TestLocalRuntimePipeline runs a pipeline in a real env with PPS, which runs
+// its workers with the local runtime.
+func TestLocalRuntimePipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	binary := filepath.Join(t.TempDir(), "worker")
+	out, err := exec.Command("go", "build", "-o", binary, "github.com/pachyderm/pachyderm/v2/src/server/cmd/worker").CombinedOutput()
+	require.NoError(t, err, string(out))
+	env := testpachd.NewRealEnvWithPPS(t, NewAPIServerFromServiceEnv, dockertestenv.NewTestDBConfig(t),
+		func(config *serviceenv.Configuration) {
+			config.PPSLocalWorkerBinary = binary
+		})
+	c := env.PachClient
+	require.NoError(t, c.CreateRepo("in"))
+	require.NoError(t, c.PutFile(client.NewCommit("in", "master", ""), "file", strings.NewReader("foo")))
+	require.NoError(t, c.CreatePipeline(
+		"edges",
+		"",
+		[]string{"bash"},
+		[]string{"cp pfs/in/* pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput("in", "/*"),
+		"",
+		false,
+	))
+	commitInfo, err := c.WaitCommit("edges", "master", "")
+	require.NoError(t, err)
+	require.Equal(t, "", commitInfo.Error)
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "file", &buf))
+	require.Equal(t, "foo", buf.String())
+	require.NoError(t, c.DeletePipeline("edges", false))
+}
+
+func TestLocalRuntimeRestartsWorkers(t *testing.T) {
+	l := newTestLocalRuntime(t, `echo started
+exit 1
+`)
+	require.NoError(t, l.createWorkers(context.Background(), testPipelineInfo()))
+	require.NoError(t, l.scaleWorkers("pipeline-edges-v1", 1))
+	waitForLogs(t, l, "pipeline-edges-v1-0", "started\nstarted\n")
+}
+
+func TestLocalRuntimeCrash(t *testing.T) {
+	l := newTestLocalRuntime(t, "")
+	l.binary = filepath.Join(t.TempDir(), "missing")
+	require.NoError(t, l.createWorkers(context.Background(), testPipelineInfo()))
+	require.NoError(t, l.scaleWorkers("pipeline-edges-v1", 1))
+
+	// A worker that can't be started moves its pipeline to CRASHING
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	errCrashed := errors.New("crashed")
+	err := l.watchWorkers(ctx, func(pipeline string, pipelineVersion int, reason string) error {
+		require.Equal(t, "edges", pipeline)
+		require.Equal(t, 1, pipelineVersion)
+		require.True(t, strings.Contains(reason, "could not start worker"), reason)
+		return errCrashed
+	})
+	require.True(t, errors.Is(err, errCrashed))
+}
+
+func TestLocalRuntimeUnsupported(t *testing.T) {
+	l := newTestLocalRuntime(t, "")
+	pipelineInfo := testPipelineInfo()
+	pipelineInfo.Details.Transform.Secrets = []*pps.SecretMount{{Name: "secret", EnvVar: "SECRET", Key: "key"}}
+	err := l.createWorkers(context.Background(), pipelineInfo)
+	require.YesError(t, err)
+	require.True(t, errors.As(err, &noValidOptionsErr{}))
+}
@@ -30,8 +30,7 @@ var (
 		"Unschedulable":    true,
 	}
 
-	zero     int32 // used to turn down RCs in scaleDownWorkersForPipeline
-	falseVal bool  // used to delete RCs in deletePipelineResources and restartPipeline()
+	falseVal bool // used to delete RCs in deletePipelineResources and restartPipeline()
 )
 
 type eventType int
@@ -143,6 +142,10 @@ func (m *ppsMaster) run() {
 	defer m.cancelPipelinePodsPoller()
 	m.startPipelineWatcher()
 	defer m.cancelPipelineWatcher()
+	cancelNotifier := m.startMonitorThread("deliverNotifications", m.deliverNotifications)
+	defer cancelNotifier()
+	cancelSweeper := m.startMonitorThread("sweepEventLog", m.sweepEventLog)
+	defer cancelSweeper()
 
 eventLoop:
 	for {
@@ -176,7 +179,7 @@ func (a *apiServer) setPipelineState(ctx context.Context, specCommit *pfs.Commit
 		tracing.FinishAnySpan(span)
 	}()
 	return ppsutil.SetPipelineState(ctx, a.env.DB, a.pipelines,
-		specCommit, nil, state, reason)
+		specCommit, nil, state, reason, a.notifyPipelineState)
 }
 
 // transitionPipelineState is similar to setPipelineState, except that it sets
@@ -190,5 +193,5 @@ func (a *apiServer) transitionPipelineState(ctx context.Context, specCommit *pfs
 		tracing.FinishAnySpan(span)
 	}()
 	return ppsutil.SetPipelineState(ctx, a.env.DB, a.pipelines,
-		specCommit, from, to, reason)
+		specCommit, from, to, reason, a.notifyPipelineState)
 }
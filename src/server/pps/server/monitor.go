@@ -27,7 +27,6 @@ import (
 	"github.com/robfig/cron"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
@@ -35,10 +34,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/extended"
-	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
-	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
 )
 
@@ -332,36 +329,37 @@ func (m *ppsMaster) monitorPipeline(ctx context.Context, pipelineInfo *pps.Pipel
 			}, backoff.NewInfiniteBackOff(),
 				backoff.NotifyCtx(ctx, "monitorPipeline for "+pipeline))
 		})
-		if pipelineInfo.Details.ParallelismSpec != nil && pipelineInfo.Details.ParallelismSpec.Constant > 1 && pipelineInfo.Details.Autoscaling {
+		if pipelineInfo.Details.AutoscalingSpec != nil {
+			eg.Go(func() error {
+				return backoff.RetryUntilCancel(ctx, func() error {
+					return m.runAutoscaler(ctx, pipelineInfo)
+				}, backoff.NewInfiniteBackOff(),
+					backoff.NotifyCtx(ctx, "autoscaler for "+pipeline))
+			})
+		} else if pipelineInfo.Details.ParallelismSpec != nil && pipelineInfo.Details.ParallelismSpec.Constant > 1 && pipelineInfo.Details.Autoscaling {
 			eg.Go(func() error {
 				pachClient := m.a.env.GetPachClient(ctx)
 				return backoff.RetryUntilCancel(ctx, func() error {
-					worker := work.NewWorker(
-						m.a.env.EtcdClient,
-						m.a.etcdPrefix,
-						driver.WorkNamespace(pipelineInfo),
-					)
+					worker := m.a.newWorker(pipelineInfo)
 					for {
 						nTasks, nClaims, err := worker.TaskCount(pachClient.Ctx())
 						if err != nil {
 							return err
 						}
 						if nClaims < nTasks {
-							kubeClient := m.a.env.KubeClient
-							namespace := m.a.namespace
-							rc := kubeClient.CoreV1().ReplicationControllers(namespace)
-							scale, err := rc.GetScale(pipelineInfo.Details.WorkerRc, metav1.GetOptions{})
+							rcs, err := m.a.workerRuntime.listWorkerSets(pipelineInfo.Pipeline.Name)
+							if err != nil {
+								return err
+							}
 							n := nTasks
 							if n > int64(pipelineInfo.Details.ParallelismSpec.Constant) {
 								n = int64(pipelineInfo.Details.ParallelismSpec.Constant)
 							}
-							if err != nil {
-								return err
-							}
-							if int64(scale.Spec.Replicas) < n {
-								scale.Spec.Replicas = int32(n)
-								if _, err := rc.UpdateScale(pipelineInfo.Details.WorkerRc, scale); err != nil {
-									return err
+							for _, rc := range rcs {
+								if rc.name == pipelineInfo.Details.WorkerRc && int64(rc.replicas) < n {
+									if err := m.a.workerRuntime.scaleWorkers(rc.name, int32(n)); err != nil {
+										return err
+									}
 								}
 							}
 							// We've already attained max scale, no reason to keep polling.
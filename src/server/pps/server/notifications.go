@@ -0,0 +1,274 @@
+package server
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/notify"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// notificationPollInterval is how often the PPS master checks for
+	// notification events that are due to be sent.
+	notificationPollInterval = 5 * time.Second
+	notificationBatchSize    = 100
+	notificationSendTimeout  = 10 * time.Second
+	// maxNotificationAttempts is the number of attempts at sending an event
+	// before it is dead-lettered.
+	maxNotificationAttempts = 10
+	maxNotificationBackoff  = time.Hour
+)
+
+// CreateNotification implements the protobuf pps.CreateNotification RPC
+func (a *apiServer) CreateNotification(ctx context.Context, request *pps.CreateNotificationRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := validateNotification(request.Notification, true); err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		return ppsdb.PutNotification(ctx, sqlTx, request.Notification, request.Update)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// DeleteNotification implements the protobuf pps.DeleteNotification RPC
+func (a *apiServer) DeleteNotification(ctx context.Context, request *pps.DeleteNotificationRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		return ppsdb.DeleteNotification(ctx, sqlTx, request.Name)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ListNotification implements the protobuf pps.ListNotification RPC
+func (a *apiServer) ListNotification(ctx context.Context, request *pps.ListNotificationRequest) (response *pps.NotificationInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	notifications, err := ppsdb.ListNotifications(ctx, a.env.DB)
+	if err != nil {
+		return nil, err
+	}
+	response = &pps.NotificationInfos{}
+	for _, n := range notifications {
+		pending, deadLettered, err := ppsdb.NotificationEventCounts(ctx, a.env.DB, n.Name)
+		if err != nil {
+			return nil, err
+		}
+		response.NotificationInfo = append(response.NotificationInfo, &pps.NotificationInfo{
+			Notification: n,
+			Pending:      pending,
+			DeadLettered: deadLettered,
+		})
+	}
+	return response, nil
+}
+
+// validateNotification validates a notification. clusterWide is true for
+// notifications created with CreateNotification, and false for notifications
+// in a pipeline's spec.
+func validateNotification(n *pps.Notification, clusterWide bool) error {
+	if n == nil {
+		return errors.New("notification cannot be nil")
+	}
+	if n.Name == "" {
+		return errors.New("notification name cannot be empty")
+	}
+	if len(n.Pipelines) > 0 && !clusterWide {
+		return errors.Errorf("notification %q: pipelines can only be set for cluster-wide notifications", n.Name)
+	}
+	if n.Webhook == nil {
+		return errors.Errorf("notification %q: webhook must be set", n.Name)
+	}
+	u, err := url.Parse(n.Webhook.URL)
+	if err != nil {
+		return errors.Wrapf(err, "notification %q: invalid webhook url", n.Name)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("notification %q: webhook url must be http or https", n.Name)
+	}
+	if n.Webhook.Secret != "" && n.Webhook.SecretKey == "" {
+		return errors.Errorf("notification %q: webhook secret_key must be set with secret", n.Name)
+	}
+	return nil
+}
+
+// notify adds an event to the outbox of each notification that it matches,
+// in the same transaction as the change that caused it. The events are sent
+// by the PPS master in deliverNotifications.
+func (a *apiServer) notify(sqlTx *sqlx.Tx, pipeline *pps.Pipeline, specNotifications []*pps.Notification, event *pps.NotificationEvent) error {
+	ctx := a.env.BackgroundContext
+	event.ID = uuid.NewWithoutDashes()
+	event.Created = types.TimestampNow()
+	event.Pipeline = pipeline
+	notifications, err := ppsdb.ListNotifications(ctx, sqlTx)
+	if err != nil {
+		return err
+	}
+	for _, n := range notifications {
+		if notify.Matches(n, event) {
+			if err := a.addNotificationEvent(ctx, sqlTx, "", n, event); err != nil {
+				return err
+			}
+		}
+	}
+	for _, n := range specNotifications {
+		if notify.Matches(n, event) {
+			if err := a.addNotificationEvent(ctx, sqlTx, pipeline.Name, n, event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *apiServer) addNotificationEvent(ctx context.Context, sqlTx *sqlx.Tx, pipeline string, n *pps.Notification, event *pps.NotificationEvent) error {
+	event.Notification = n.Name
+	return ppsdb.AddNotificationEvent(ctx, sqlTx, pipeline, n, event)
+}
+
+// notifyJob sends an event about a job to the notifications of the job's
+// pipeline.
+func (a *apiServer) notifyJob(sqlTx *sqlx.Tx, jobInfo *pps.JobInfo, event *pps.NotificationEvent) error {
+	var specNotifications []*pps.Notification
+	pipelineInfo := &pps.PipelineInfo{}
+	if err := a.pipelines.ReadWrite(sqlTx).GetUniqueByIndex(
+		ppsdb.PipelinesVersionIndex,
+		ppsdb.VersionKey(jobInfo.Job.Pipeline.Name, jobInfo.PipelineVersion),
+		pipelineInfo,
+	); err != nil {
+		// The pipeline may have been deleted, in which case the event is
+		// still sent to cluster-wide notifications.
+		if !col.IsErrNotFound(err) {
+			return err
+		}
+	} else {
+		specNotifications = pipelineInfo.Details.Notifications
+	}
+	event.Job = jobInfo.Job
+	event.DataFailed = jobInfo.DataFailed
+	return a.notify(sqlTx, jobInfo.Job.Pipeline, specNotifications, event)
+}
+
+// updateJobState wraps ppsutil.UpdateJobState, and notifies of the job's
+// state change.
+func (a *apiServer) updateJobState(sqlTx *sqlx.Tx, jobInfo *pps.JobInfo, state pps.JobState, reason string) error {
+	prevState := jobInfo.State
+	if err := ppsutil.UpdateJobState(a.pipelines.ReadWrite(sqlTx), a.jobs.ReadWrite(sqlTx), jobInfo, state, reason); err != nil {
+		return err
+	}
+	if prevState == state {
+		return nil
+	}
+	return a.notifyJob(sqlTx, jobInfo, &pps.NotificationEvent{
+		Type:     pps.NotificationEventType_JOB_STATE_CHANGED,
+		JobState: state,
+		Reason:   reason,
+	})
+}
+
+// notifyPipelineState is passed to ppsutil.SetPipelineState by the PPS master
+// to notify of pipeline state changes.
+func (a *apiServer) notifyPipelineState(sqlTx *sqlx.Tx, pipelineInfo *pps.PipelineInfo, _ pps.PipelineState) error {
+	return a.notify(sqlTx, pipelineInfo.Pipeline, pipelineInfo.Details.Notifications, &pps.NotificationEvent{
+		Type:          pps.NotificationEventType_PIPELINE_STATE_CHANGED,
+		PipelineState: pipelineInfo.State,
+		Reason:        pipelineInfo.Reason,
+	})
+}
+
+// deliverNotifications sends the notification events in the outbox. Events
+// that fail to send are retried with exponential backoff, and dead-lettered
+// after maxNotificationAttempts.
+func (m *ppsMaster) deliverNotifications(ctx context.Context) {
+	ticker := time.NewTicker(notificationPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := m.a.deliverNotificationBatch(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("PPS master: error sending notifications: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (a *apiServer) deliverNotificationBatch(ctx context.Context) error {
+	pnes, err := ppsdb.PendingNotificationEvents(ctx, a.env.DB, notificationBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, pne := range pnes {
+		if err := a.sendNotificationEvent(ctx, pne); err != nil {
+			attempts := pne.Attempts + 1
+			deadLetter := attempts >= maxNotificationAttempts
+			backoff := notificationBackoff(attempts)
+			log.Errorf("PPS master: error sending event %v to notification %q (attempt %d): %v", pne.Event.ID, pne.Notification.Name, attempts, err)
+			if err := ppsdb.FailNotificationEvent(ctx, a.env.DB, pne.ID, err, backoff, deadLetter); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := ppsdb.CompleteNotificationEvent(ctx, a.env.DB, pne.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notificationBackoff returns how long to wait before retrying an event that
+// has failed to send 'attempts' times: 2^attempts seconds, up to
+// maxNotificationBackoff.
+func notificationBackoff(attempts int) time.Duration {
+	return time.Duration(math.Min(math.Pow(2, float64(attempts)), maxNotificationBackoff.Seconds())) * time.Second
+}
+
+func (a *apiServer) sendNotificationEvent(ctx context.Context, pne *ppsdb.PendingNotificationEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, notificationSendTimeout)
+	defer cancel()
+	sink, err := notify.NewSink(ctx, pne.Notification, a.getSecret)
+	if err != nil {
+		return err
+	}
+	return sink.Send(ctx, pne.Event)
+}
+
+func (a *apiServer) getSecret(_ context.Context, name, key string) ([]byte, error) {
+	secrets, err := a.secrets()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := secrets.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get secret %q", name)
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		return nil, errors.Errorf("secret %q has no key %q", name, key)
+	}
+	return value, nil
+}
@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/notify"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// newTestAPIServer returns an apiServer backed by a test database, without
+// auth or PFS.
+func newTestAPIServer(t *testing.T) *apiServer {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	require.NoError(t, migrations.ApplyMigrations(ctx, db, migrations.Env{}, clusterstate.DesiredClusterState))
+	require.NoError(t, migrations.BlockUntil(ctx, db, clusterstate.DesiredClusterState))
+	txnEnv := &txnenv.TransactionEnv{}
+	txnEnv.Initialize(&serviceenv.TestServiceEnv{DBClient: db}, nil)
+	return &apiServer{
+		env:       Env{DB: db, BackgroundContext: ctx},
+		txnEnv:    txnEnv,
+		pipelines: ppsdb.Pipelines(db, nil),
+		jobs:      ppsdb.Jobs(db, nil),
+	}
+}
+
+// newTestNotificationServer returns an apiServer backed by a test database,
+// and a webhook that records the IDs of the events it receives. The webhook
+// fails while 'fail' is nonzero.
+func newTestNotificationServer(t *testing.T) (*apiServer, *pps.Notification, chan string, *int32) {
+	a := newTestAPIServer(t)
+	// The handler only passes event IDs to the test goroutine, as require
+	// can't be called from other goroutines.
+	received := make(chan string, 10)
+	fail := new(int32)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(fail) != 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		received <- r.Header.Get(notify.EventIDHeader)
+	}))
+	t.Cleanup(srv.Close)
+	n := &pps.Notification{Name: "failures", Webhook: &pps.Webhook{URL: srv.URL}}
+	return a, n, received, fail
+}
+
+func TestNotificationOutbox(t *testing.T) {
+	ctx := context.Background()
+	a, n, _, _ := newTestNotificationServer(t)
+	require.NoError(t, dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		return ppsdb.PutNotification(ctx, sqlTx, n, false)
+	}))
+	specNotification := &pps.Notification{Name: "spec", Webhook: n.Webhook}
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline:   client.NewPipeline("edges"),
+		Version:    1,
+		SpecCommit: client.NewCommit("edges", "spec", uuid.NewWithoutDashes()),
+		State:      pps.PipelineState_PIPELINE_CRASHING,
+		Details: &pps.PipelineInfo_Details{
+			Notifications: []*pps.Notification{specNotification},
+		},
+	}
+	jobInfo := &pps.JobInfo{
+		Job:             client.NewJob("edges", uuid.NewWithoutDashes()),
+		PipelineVersion: 1,
+	}
+	require.NoError(t, dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+		if err := a.pipelines.ReadWrite(sqlTx).Put(pipelineInfo.SpecCommit, pipelineInfo); err != nil {
+			return err
+		}
+		// Job state changes that aren't failures aren't matched, and
+		// failures are sent to both the cluster-wide and the pipeline's
+		// notifications.
+		if err := a.updateJobState(sqlTx, jobInfo, pps.JobState_JOB_RUNNING, ""); err != nil {
+			return err
+		}
+		if err := a.updateJobState(sqlTx, jobInfo, pps.JobState_JOB_FAILURE, "boom"); err != nil {
+			return err
+		}
+		return a.notifyPipelineState(sqlTx, pipelineInfo, pps.PipelineState_PIPELINE_RUNNING)
+	}))
+	pnes, err := ppsdb.PendingNotificationEvents(ctx, a.env.DB, notificationBatchSize)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(pnes))
+	for i, expected := range []struct {
+		notification string
+		eventType    pps.NotificationEventType
+	}{
+		{"failures", pps.NotificationEventType_JOB_STATE_CHANGED},
+		{"spec", pps.NotificationEventType_JOB_STATE_CHANGED},
+		{"failures", pps.NotificationEventType_PIPELINE_STATE_CHANGED},
+		{"spec", pps.NotificationEventType_PIPELINE_STATE_CHANGED},
+	} {
+		require.Equal(t, expected.notification, pnes[i].Notification.Name)
+		require.Equal(t, expected.notification, pnes[i].Event.Notification)
+		require.Equal(t, expected.eventType, pnes[i].Event.Type)
+		require.Equal(t, "edges", pnes[i].Event.Pipeline.Name)
+	}
+	require.Equal(t, pps.JobState_JOB_FAILURE, pnes[0].Event.JobState)
+	require.Equal(t, "boom", pnes[0].Event.Reason)
+	require.Equal(t, pps.PipelineState_PIPELINE_CRASHING, pnes[2].Event.PipelineState)
+	// Only the cluster-wide notification's events are counted in
+	// ListNotification.
+	pending, deadLettered, err := ppsdb.NotificationEventCounts(ctx, a.env.DB, "failures")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), pending)
+	require.Equal(t, int64(0), deadLettered)
+}
+
+func TestDeliverNotifications(t *testing.T) {
+	ctx := context.Background()
+	a, n, received, fail := newTestNotificationServer(t)
+	addEvent := func() string {
+		event := &pps.NotificationEvent{
+			ID:           uuid.NewWithoutDashes(),
+			Notification: n.Name,
+			Type:         pps.NotificationEventType_JOB_STATE_CHANGED,
+			Pipeline:     client.NewPipeline("edges"),
+			JobState:     pps.JobState_JOB_FAILURE,
+		}
+		require.NoError(t, dbutil.WithTx(ctx, a.env.DB, func(sqlTx *sqlx.Tx) error {
+			return ppsdb.AddNotificationEvent(ctx, sqlTx, "", n, event)
+		}))
+		return event.ID
+	}
+	// Sent events are removed from the outbox.
+	id := addEvent()
+	require.NoError(t, a.deliverNotificationBatch(ctx))
+	require.Equal(t, id, <-received)
+	requireOutbox(t, a, 0, 0)
+
+	// Events that fail to send are retried after a backoff.
+	atomic.StoreInt32(fail, 1)
+	addEvent()
+	require.NoError(t, a.deliverNotificationBatch(ctx))
+	requireOutbox(t, a, 1, 0)
+	var attempts int
+	var nextAttempt time.Time
+	require.NoError(t, a.env.DB.QueryRow(`SELECT attempts, next_attempt FROM pps.notification_events`).Scan(&attempts, &nextAttempt))
+	require.Equal(t, 1, attempts)
+	require.True(t, nextAttempt.After(time.Now()))
+	// The event isn't due yet, so it isn't sent again.
+	atomic.StoreInt32(fail, 0)
+	require.NoError(t, a.deliverNotificationBatch(ctx))
+	requireOutbox(t, a, 1, 0)
+
+	// Events that fail maxNotificationAttempts times are dead-lettered, and
+	// aren't retried.
+	atomic.StoreInt32(fail, 1)
+	_, err := a.env.DB.Exec(`UPDATE pps.notification_events SET attempts = $1, next_attempt = CURRENT_TIMESTAMP`, maxNotificationAttempts-1)
+	require.NoError(t, err)
+	require.NoError(t, a.deliverNotificationBatch(ctx))
+	requireOutbox(t, a, 0, 1)
+	atomic.StoreInt32(fail, 0)
+	_, err = a.env.DB.Exec(`UPDATE pps.notification_events SET next_attempt = CURRENT_TIMESTAMP`)
+	require.NoError(t, err)
+	require.NoError(t, a.deliverNotificationBatch(ctx))
+	requireOutbox(t, a, 0, 1)
+	select {
+	case id := <-received:
+		t.Fatalf("dead-lettered event %v was sent", id)
+	default:
+	}
+}
+
+// requireOutbox checks the number of pending and dead-lettered events of the
+// cluster-wide notification "failures".
+func requireOutbox(t *testing.T, a *apiServer, pending, deadLettered int64) {
+	actualPending, actualDeadLettered, err := ppsdb.NotificationEventCounts(context.Background(), a.env.DB, "failures")
+	require.NoError(t, err)
+	require.Equal(t, pending, actualPending)
+	require.Equal(t, deadLettered, actualDeadLettered)
+}
+
+func TestNotificationBackoff(t *testing.T) {
+	require.Equal(t, 2*time.Second, notificationBackoff(1))
+	require.Equal(t, 512*time.Second, notificationBackoff(9))
+	require.Equal(t, maxNotificationBackoff, notificationBackoff(20))
+}
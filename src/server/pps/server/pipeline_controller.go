@@ -22,8 +22,6 @@ import (
 
 	opentracing "github.com/opentracing/opentracing-go"
 	log "github.com/sirupsen/logrus"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type rcExpectation byte
@@ -55,7 +53,9 @@ type pipelineOp struct {
 	// master's context, and cancelled at the end of step())
 	ctx          context.Context
 	pipelineInfo *pps.PipelineInfo
-	rc           *v1.ReplicationController
+	rc           *workerSet
+	// the candidate version of op's pipeline, if it has one
+	candidate *pps.PipelineInfo
 }
 
 var (
@@ -82,6 +82,9 @@ func (m *ppsMaster) newPipelineOp(ctx context.Context, pipeline string) (*pipeli
 	tracing.TagAnySpan(ctx,
 		"current-state", op.pipelineInfo.State.String(),
 		"spec-commit", pretty.CompactPrintCommitSafe(op.pipelineInfo.SpecCommit))
+	if op.candidate, err = m.a.inspectCandidate(ctx, pipeline); err != nil {
+		return nil, errors.Wrapf(err, "could not retrieve candidate for %q", pipeline)
+	}
 
 	// add pipeline auth
 	// the provided context is authorized as pps master, but we want to switch to the pipeline itself
@@ -174,6 +177,27 @@ func (m *ppsMaster) step(pipeline string, timestamp time.Time) (retErr error) {
 			failPipeline: true,
 		}
 	}
+	// Services with a rollout keep serving from their old version's workers
+	// until the new version's are ready, which would otherwise look like a
+	// stale RC to getRC
+	if op.rollsOut() {
+		if done, err := op.rollout(); err != nil || !done {
+			return err
+		}
+	}
+	// Once no old version of a service with a rollout is serving (because op's
+	// version rolled out, or because the pipeline was stopped or failed), its
+	// old versions' auth tokens can be revoked
+	if hasRollout(op.pipelineInfo) {
+		if err := op.revokeOldAuthTokens(""); err != nil {
+			return err
+		}
+	}
+	// A candidate's workers run alongside the current version's, so step them
+	// separately
+	if err := op.stepCandidate(); err != nil {
+		return err
+	}
 	// set op.rc
 	// TODO(msteffen) should this fail the pipeline? (currently getRC will restart
 	// the pipeline indefinitely)
@@ -313,32 +337,28 @@ func (op *pipelineOp) getRC(expectation rcExpectation) (retErr error) {
 		tracing.FinishAnySpan(span)
 	}(span)
 
-	kubeClient := op.m.a.env.KubeClient
-	namespace := op.m.a.namespace
-	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, op.pipelineInfo.Pipeline.Name)
-
 	// count error types separately, so that this only errors if the pipeline is
 	// stuck and not changing
 	var notFoundErrCount, unexpectedErrCount, staleErrCount, tooManyErrCount,
 		otherErrCount int
 	return backoff.RetryNotify(func() error {
 		// List all RCs, so stale RCs from old pipelines are noticed and deleted
-		rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(
-			metav1.ListOptions{LabelSelector: selector})
-		if err != nil && !errutil.IsNotFoundError(err) {
+		rcs, err := op.m.a.workerRuntime.listWorkerSets(op.pipelineInfo.Pipeline.Name)
+		if err != nil {
 			return err
 		}
-		if len(rcs.Items) == 0 {
+		rcs = op.withoutCandidate(rcs)
+		if len(rcs) == 0 {
 			op.rc = nil
 			return errRCNotFound
 		}
 
-		op.rc = &rcs.Items[0]
+		op.rc = rcs[0]
 		switch {
-		case len(rcs.Items) > 1:
+		case len(rcs) > 1:
 			// select stale RC if possible, so that we delete it in restartPipeline
-			for i := range rcs.Items {
-				op.rc = &rcs.Items[i]
+			for _, rc := range rcs {
+				op.rc = rc
 				if !op.rcIsFresh() {
 					break
 				}
@@ -396,10 +416,10 @@ func (op *pipelineOp) rcIsFresh() bool {
 	}
 
 	// establish current RC properties
-	rcName := op.rc.ObjectMeta.Name
-	rcPachVersion := op.rc.ObjectMeta.Annotations[pachVersionAnnotation]
-	rcAuthTokenHash := op.rc.ObjectMeta.Annotations[hashedAuthTokenAnnotation]
-	rcPipelineVersion := op.rc.ObjectMeta.Annotations[pipelineVersionAnnotation]
+	rcName := op.rc.name
+	rcPachVersion := op.rc.pachVersion
+	rcAuthTokenHash := op.rc.authTokenHash
+	rcPipelineVersion := op.rc.pipelineVersion
 	switch {
 	case rcAuthTokenHash != hashAuthToken(op.pipelineInfo.AuthToken):
 		log.Errorf("PPS master: auth token in %q is stale %s != %s",
@@ -438,7 +458,7 @@ func (op *pipelineOp) setPipelineState(state pps.PipelineState, reason string) e
 // createPipelineResources creates the RC and any services for op's pipeline.
 func (op *pipelineOp) createPipelineResources() error {
 	log.Infof("PPS master: creating resources for pipeline %q", op.pipelineInfo.Pipeline.Name)
-	if err := op.m.a.createWorkerSvcAndRc(op.ctx, op.pipelineInfo); err != nil {
+	if err := op.m.a.workerRuntime.createWorkers(op.ctx, op.pipelineInfo); err != nil {
 		if errors.As(err, &noValidOptionsErr{}) {
 			// these errors indicate invalid pipelineInfo, don't retry
 			return stepError{
@@ -457,7 +477,7 @@ func (op *pipelineOp) createPipelineResources() error {
 // Note: this is called by every run through step(), so must be idempotent
 func (op *pipelineOp) startPipelineMonitor() {
 	op.m.startMonitor(op.pipelineInfo)
-	op.pipelineInfo.Details.WorkerRc = op.rc.ObjectMeta.Name
+	op.pipelineInfo.Details.WorkerRc = op.rc.name
 }
 
 func (op *pipelineOp) startCrashingPipelineMonitor() {
@@ -518,27 +538,6 @@ func (op *pipelineOp) deletePipelineResources() error {
 	return nil
 }
 
-// updateRC is a helper for {scaleUp,scaleDown}Pipeline. It includes all of the
-// logic for writing an updated RC spec to kubernetes, and updating/retrying if
-// k8s rejects the write. It presents a strange API, since the the RC being
-// updated is already available to the caller in op.rc, but update() may be
-// called muliple times if the k8s write fails. It may be helpful to think of
-// the rc passed to update() as mutable, while op.rc is immutable.
-func (op *pipelineOp) updateRC(update func(rc *v1.ReplicationController)) error {
-	kubeClient := op.m.a.env.KubeClient
-	namespace := op.m.a.namespace
-	rc := kubeClient.CoreV1().ReplicationControllers(namespace)
-
-	newRC := *op.rc
-	// Apply op's update to rc
-	update(&newRC)
-	// write updated RC to k8s
-	if _, err := rc.Update(&newRC); err != nil {
-		return newRetriableError(err, "error updating RC")
-	}
-	return nil
-}
-
 // scaleUpPipeline edits the RC associated with op's pipeline & spins up the
 // configured number of workers.
 func (op *pipelineOp) scaleUpPipeline() (retErr error) {
@@ -559,18 +558,32 @@ func (op *pipelineOp) scaleUpPipeline() (retErr error) {
 		parallelism = op.pipelineInfo.Details.ParallelismSpec.Constant
 	}
 
+	// When the cluster is out of capacity, hold off on starting workers until
+	// the workers of higher priority pipelines have been scheduled. The
+	// pipeline poller will call scaleUpPipeline again.
+	if op.rc.replicas > 0 {
+		return nil // prior attempt succeeded
+	}
+	if pipeline, ok, err := op.m.a.workerRuntime.pendingHigherPriority(op.pipelineInfo); err != nil {
+		// Don't block scaling up on the priority check.
+		log.Errorf("PPS master: error checking for pending workers: %v", err)
+	} else if ok {
+		log.Infof("PPS master: deferring scaling up %q until workers of higher priority pipeline %q are scheduled",
+			op.pipelineInfo.Pipeline.Name, pipeline)
+		return nil
+	}
+
 	// update pipeline RC
-	return op.updateRC(func(rc *v1.ReplicationController) {
-		if rc.Spec.Replicas != nil && *op.rc.Spec.Replicas > 0 {
-			return // prior attempt succeeded
-		}
-		rc.Spec.Replicas = new(int32)
-		if op.pipelineInfo.Details.Autoscaling {
-			*rc.Spec.Replicas = 1
-		} else {
-			*rc.Spec.Replicas = int32(parallelism)
-		}
-	})
+	replicas := int32(parallelism)
+	if spec := op.pipelineInfo.Details.AutoscalingSpec; spec != nil {
+		replicas = int32(spec.MinWorkers)
+	} else if op.pipelineInfo.Details.Autoscaling {
+		replicas = 1
+	}
+	if err := op.m.a.workerRuntime.scaleWorkers(op.rc.name, replicas); err != nil {
+		return newRetriableError(err, "error updating RC")
+	}
+	return nil
 }
 
 // scaleDownPipeline edits the RC associated with op's pipeline & spins down the
@@ -587,12 +600,13 @@ func (op *pipelineOp) scaleDownPipeline() (retErr error) {
 		tracing.FinishAnySpan(span)
 	}()
 
-	return op.updateRC(func(rc *v1.ReplicationController) {
-		if rc.Spec.Replicas != nil && *op.rc.Spec.Replicas == 0 {
-			return // prior attempt succeeded
-		}
-		rc.Spec.Replicas = &zero
-	})
+	if op.rc.replicas == 0 {
+		return nil // prior attempt succeeded
+	}
+	if err := op.m.a.workerRuntime.scaleWorkers(op.rc.name, 0); err != nil {
+		return newRetriableError(err, "error updating RC")
+	}
+	return nil
 }
 
 // restartPipeline updates the RC/service associated with op's pipeline, and
@@ -641,52 +655,7 @@ func (m *ppsMaster) deletePipelineResources(pipelineName string) (retErr error)
 	// Same for cancelCrashingMonitor
 	m.cancelCrashingMonitor(pipelineName)
 
-	kubeClient := m.a.env.KubeClient
-	namespace := m.a.namespace
-
-	// Delete any services associated with op.pipeline
-	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, pipelineName)
-	opts := &metav1.DeleteOptions{
-		OrphanDependents: &falseVal,
-	}
-	services, err := kubeClient.CoreV1().Services(namespace).List(metav1.ListOptions{LabelSelector: selector})
-	if err != nil {
-		return errors.Wrapf(err, "could not list services")
-	}
-	for _, service := range services.Items {
-		if err := kubeClient.CoreV1().Services(namespace).Delete(service.Name, opts); err != nil {
-			if !errutil.IsNotFoundError(err) {
-				return errors.Wrapf(err, "could not delete service %q", service.Name)
-			}
-		}
-	}
-
-	// Delete any secrets associated with op.pipeline
-	secrets, err := kubeClient.CoreV1().Secrets(namespace).List(metav1.ListOptions{LabelSelector: selector})
-	if err != nil {
-		return errors.Wrapf(err, "could not list secrets")
-	}
-	for _, secret := range secrets.Items {
-		if err := kubeClient.CoreV1().Secrets(namespace).Delete(secret.Name, opts); err != nil {
-			if !errutil.IsNotFoundError(err) {
-				return errors.Wrapf(err, "could not delete secret %q", secret.Name)
-			}
-		}
-	}
-
-	// Finally, delete op.pipeline's RC, which will cause pollPipelines to stop
+	// Delete the pipeline's workers, which will cause pollPipelines to stop
 	// polling it.
-	rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(metav1.ListOptions{LabelSelector: selector})
-	if err != nil {
-		return errors.Wrapf(err, "could not list RCs")
-	}
-	for _, rc := range rcs.Items {
-		if err := kubeClient.CoreV1().ReplicationControllers(namespace).Delete(rc.Name, opts); err != nil {
-			if !errutil.IsNotFoundError(err) {
-				return errors.Wrapf(err, "could not delete RC %q", rc.Name)
-			}
-		}
-	}
-
-	return nil
+	return m.a.workerRuntime.deleteWorkers(pipelineName)
 }
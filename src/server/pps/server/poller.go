@@ -2,14 +2,9 @@ package server
 
 import (
 	"context"
-	"strconv"
 	"time"
 
 	log "github.com/sirupsen/logrus"
-	v1 "k8s.io/api/core/v1"
-	kube_err "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kube_watch "k8s.io/apimachinery/pkg/watch"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -91,10 +86,7 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 			// database and querying k8s, then we might delete the RC for brand-new
 			// pipeline 'foo'). Even if we do delete a live pipeline's RC, it'll be
 			// fixed in the next cycle)
-			kc := m.a.env.KubeClient.CoreV1().ReplicationControllers(m.a.env.Config.Namespace)
-			rcs, err := kc.List(metav1.ListOptions{
-				LabelSelector: "suite=pachyderm,pipelineName",
-			})
+			rcs, err := m.a.workerRuntime.listWorkerSets("")
 			if err != nil {
 				// No sensible error recovery here (e.g .if we can't reach k8s). We'll
 				// keep going, and just won't delete any RCs this round.
@@ -115,15 +107,9 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 			}
 
 			// 3. Generate a delete event for orphaned RCs
-			if rcs != nil {
-				for _, rc := range rcs.Items {
-					pipeline, ok := rc.Labels["pipelineName"]
-					if !ok {
-						return errors.New("'pipelineName' label missing from rc " + rc.Name)
-					}
-					if !dbPipelines[pipeline] {
-						m.eventCh <- &pipelineEvent{eventType: deleteEv, pipeline: pipeline}
-					}
+			for _, rc := range rcs {
+				if !dbPipelines[rc.pipeline] {
+					m.eventCh <- &pipelineEvent{eventType: deleteEv, pipeline: rc.pipeline}
 				}
 			}
 
@@ -166,82 +152,21 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 	}
 }
 
-// pollPipelinePods creates a kubernetes watch, and for each event:
-//   1) Checks if the event concerns a Pod
-//   2) Checks if the Pod belongs to a pipeline (pipelineName annotation is set)
-//   3) Checks if the Pod is failing
-// If all three conditions are met, then the pipline (in 'pipelineName') is set
-// to CRASHING
+// pollPipelinePods watches the workers of all pipelines, and sets the
+// pipeline of any worker that can't run (e.g. because its image can't be
+// pulled) to CRASHING
 func (m *ppsMaster) pollPipelinePods(ctx context.Context) {
 	if err := backoff.RetryUntilCancel(ctx, backoff.MustLoop(func() error {
-		kubePipelineWatch, err := m.a.env.KubeClient.CoreV1().Pods(m.a.namespace).Watch(
-			metav1.ListOptions{
-				LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
-					map[string]string{
-						"component": "worker",
-					})),
-				Watch: true,
-			})
-		if err != nil {
-			return errors.Wrap(err, "failed to watch kubernetes pods")
-		}
-		defer kubePipelineWatch.Stop()
-	WatchLoop:
-		for {
-			select {
-			case <-ctx.Done():
-				return nil
-			case event := <-kubePipelineWatch.ResultChan():
-				// if we get an error we restart the watch
-				if event.Type == kube_watch.Error {
-					return errors.Wrap(kube_err.FromObject(event.Object), "error while watching kubernetes pods")
-				} else if event.Type == "" {
-					// k8s watches seem to sometimes get stuck in a loop returning events
-					// with Type = "". We treat these as errors as otherwise we get an
-					// endless stream of them and can't do anything.
-					return errors.New("error while watching kubernetes pods: empty event type")
-				}
-				pod, ok := event.Object.(*v1.Pod)
-				if !ok {
-					continue // irrelevant event
-				}
-				if pod.Status.Phase == v1.PodFailed {
-					log.Errorf("pod failed because: %s", pod.Status.Message)
-				}
-				crashPipeline := func(reason string) error {
-					pipelineName := pod.ObjectMeta.Annotations["pipelineName"]
-					pipelineVersion, versionErr := strconv.Atoi(pod.ObjectMeta.Annotations["pipelineVersion"])
-					if versionErr != nil {
-						return errors.Wrapf(err, "couldn't find pipeline rc version")
-					}
-					var pipelineInfo pps.PipelineInfo
-					if err := m.a.pipelines.ReadOnly(ctx).GetUniqueByIndex(
-						ppsdb.PipelinesVersionIndex,
-						ppsdb.VersionKey(pipelineName, uint64(pipelineVersion)),
-						&pipelineInfo); err != nil {
-						return errors.Wrapf(err, "couldn't retrieve pipeline information")
-					}
-					return m.a.setPipelineCrashing(ctx, pipelineInfo.SpecCommit, reason)
-				}
-				for _, status := range pod.Status.ContainerStatuses {
-					if status.State.Waiting != nil && failures[status.State.Waiting.Reason] {
-						if err := crashPipeline(status.State.Waiting.Message); err != nil {
-							return errors.Wrap(err, "error moving pipeline to CRASHING")
-						}
-						continue WatchLoop
-					}
-				}
-				for _, condition := range pod.Status.Conditions {
-					if condition.Type == v1.PodScheduled &&
-						condition.Status != v1.ConditionTrue && failures[condition.Reason] {
-						if err := crashPipeline(condition.Message); err != nil {
-							return errors.Wrap(err, "error moving pipeline to CRASHING")
-						}
-						continue WatchLoop
-					}
-				}
+		return m.a.workerRuntime.watchWorkers(ctx, func(pipelineName string, pipelineVersion int, reason string) error {
+			var pipelineInfo pps.PipelineInfo
+			if err := m.a.pipelines.ReadOnly(ctx).GetUniqueByIndex(
+				ppsdb.PipelinesVersionIndex,
+				ppsdb.VersionKey(pipelineName, uint64(pipelineVersion)),
+				&pipelineInfo); err != nil {
+				return errors.Wrapf(err, "couldn't retrieve pipeline information")
 			}
-		}
+			return m.a.setPipelineCrashing(ctx, pipelineInfo.SpecCommit, reason)
+		})
 	}), backoff.NewInfiniteBackOff(), backoff.NotifyContinue("pollPipelinePods"),
 	); err != nil && ctx.Err() == nil {
 		log.Fatalf("pollPipelinePods is exiting prematurely which should not happen (error: %v); restarting container...", err)
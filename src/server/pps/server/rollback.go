@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// RollbackPipeline implements the protobuf pps.RollbackPipeline RPC
+func (a *apiServer) RollbackPipeline(ctx context.Context, request *pps.RollbackPipelineRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "RollbackPipeline")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	if request.Pipeline == nil {
+		return nil, errors.New("request.Pipeline cannot be nil")
+	}
+	if (request.Version == 0) == (request.SpecCommit == nil) {
+		return nil, errors.New("exactly one of version and spec_commit must be set")
+	}
+	if request.Reprocess && request.ReuseOutputs {
+		return nil, errors.New("reprocess and reuse_outputs can't both be set")
+	}
+
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.rollbackPipelineInTransaction(txnCtx, request)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) rollbackPipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.RollbackPipelineRequest) error {
+	pipelineName := request.Pipeline.Name
+	current, err := a.InspectPipelineInTransaction(txnCtx, pipelineName)
+	if err != nil {
+		return err
+	}
+	target, err := a.inspectPipelineVersionInTransaction(txnCtx, request)
+	if err != nil {
+		return err
+	}
+	switch {
+	case target.Details.Candidate != nil:
+		return errors.Errorf("version %d of %q is a candidate, use PromotePipeline to make it the current version",
+			target.Version, pipelineName)
+	case target.Version == current.Version:
+		return errors.Errorf("version %d is already the current version of %q", target.Version, pipelineName)
+	}
+
+	// Rolling back is an update to the old version's spec, which becomes the
+	// pipeline's newest version. Like any update, it keeps the current
+	// version's salt, so unless the request reprocesses, datums whose inputs
+	// haven't changed keep their current outputs, even if they were processed
+	// by a version other than the one rolled back to.
+	update := ppsutil.PipelineReqFromInfo(target)
+	update.Update = true
+	update.Reprocess = request.Reprocess
+	if err := a.CreatePipelineInTransaction(txnCtx, update); err != nil {
+		return err
+	}
+	if !request.ReuseOutputs || target.Details.Salt == current.Details.Salt {
+		return nil
+	}
+	// Restoring the old version's salt changes the hashes of all datums, so
+	// only the outputs of datums that were processed with it are kept
+	newPipelineInfo := &pps.PipelineInfo{}
+	return a.updatePipeline(txnCtx, pipelineName, newPipelineInfo, func() error {
+		newPipelineInfo.Details.Salt = target.Details.Salt
+		return nil
+	})
+}
+
+// inspectPipelineVersionInTransaction returns the version of the pipeline that
+// 'request' rolls back to
+func (a *apiServer) inspectPipelineVersionInTransaction(txnCtx *txncontext.TransactionContext, request *pps.RollbackPipelineRequest) (*pps.PipelineInfo, error) {
+	pipelineName := request.Pipeline.Name
+	pipelineInfo := &pps.PipelineInfo{}
+	if request.SpecCommit != nil {
+		if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Get(request.SpecCommit, pipelineInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil, errors.Errorf("spec commit %s is not a version of %q", request.SpecCommit.ID, pipelineName)
+			}
+			return nil, err
+		}
+		if pipelineInfo.Pipeline.Name != pipelineName {
+			return nil, errors.Errorf("spec commit %s is a version of %q, not %q",
+				request.SpecCommit.ID, pipelineInfo.Pipeline.Name, pipelineName)
+		}
+		return pipelineInfo, nil
+	}
+	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).GetUniqueByIndex(
+		ppsdb.PipelinesVersionIndex, ppsdb.VersionKey(pipelineName, request.Version), pipelineInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("pipeline %q has no version %d", pipelineName, request.Version)
+		}
+		return nil, err
+	}
+	return pipelineInfo, nil
+}
@@ -0,0 +1,295 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// defaultRolloutTimeout is how long the new version of a service has to
+	// become ready, if its rollout doesn't set a timeout
+	defaultRolloutTimeout = 10 * time.Minute
+	// defaultReadinessPeriodSeconds is how often kubernetes checks that a
+	// service's user code is ready, if its readiness probe doesn't say
+	defaultReadinessPeriodSeconds = 10
+	// rolloutFailedAnnotation marks the RC of a service version that didn't
+	// become ready, and whose rollout was rolled back
+	rolloutFailedAnnotation = "rolloutFailed"
+)
+
+// hasRollout returns whether pipelineInfo is a service pipeline that's updated
+// by rolling out its new version alongside the old one
+func hasRollout(pipelineInfo *pps.PipelineInfo) bool {
+	return pipelineInfo.Details.Service.GetRollout() != nil
+}
+
+// setServiceDefaults sets the defaults of service's readiness probe and
+// rollout
+func setServiceDefaults(service *pps.Service) {
+	if probe := service.ReadinessProbe; probe != nil {
+		if probe.Port == 0 {
+			probe.Port = service.InternalPort
+		}
+		if probe.PeriodSeconds == 0 {
+			probe.PeriodSeconds = defaultReadinessPeriodSeconds
+		}
+	}
+	if rollout := service.Rollout; rollout != nil && rollout.Timeout == nil {
+		rollout.Timeout = types.DurationProto(defaultRolloutTimeout)
+	}
+}
+
+// validateServiceRollout validates the readiness probes and rollouts of the
+// services in details
+func validateServiceRollout(details *pps.PipelineInfo_Details) error {
+	if details.Spout.GetService().GetRollout() != nil {
+		return errors.New("spouts can't have a service rollout")
+	}
+	for _, service := range []*pps.Service{details.Service, details.Spout.GetService()} {
+		if service == nil {
+			continue
+		}
+		if probe := service.ReadinessProbe; probe != nil {
+			if probe.Port < 1 || probe.Port > 65535 {
+				return errors.Errorf("readiness probe port %d is invalid", probe.Port)
+			}
+			if probe.HttpPath != "" && !strings.HasPrefix(probe.HttpPath, "/") {
+				return errors.Errorf("readiness probe http_path %q must start with \"/\"", probe.HttpPath)
+			}
+			if probe.InitialDelaySeconds < 0 || probe.PeriodSeconds < 0 {
+				return errors.New("readiness probe delays must not be negative")
+			}
+		}
+		if rollout := service.Rollout; rollout != nil {
+			if service.ReadinessProbe == nil {
+				return errors.New("a service rollout requires a readiness probe")
+			}
+			timeout, err := types.DurationFromProto(rollout.Timeout)
+			if err != nil {
+				return errors.Wrapf(err, "invalid rollout timeout")
+			}
+			if timeout <= 0 {
+				return errors.New("rollout timeout must be positive")
+			}
+		}
+	}
+	return nil
+}
+
+// readinessProbe returns the kubernetes probe of service's readiness probe
+func readinessProbe(service *pps.Service) *v1.Probe {
+	probe := service.ReadinessProbe
+	if probe == nil {
+		return nil
+	}
+	result := &v1.Probe{
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+	}
+	if probe.HttpPath != "" {
+		result.Handler.HTTPGet = &v1.HTTPGetAction{
+			Path: probe.HttpPath,
+			Port: intstr.FromInt(int(probe.Port)),
+		}
+	} else {
+		result.Handler.TCPSocket = &v1.TCPSocketAction{
+			Port: intstr.FromInt(int(probe.Port)),
+		}
+	}
+	return result
+}
+
+// userServiceName returns the name of the kubernetes service that exposes the
+// user code of pipelineInfo. Services with a rollout keep the same service
+// across versions, so that updating them doesn't change its address.
+func userServiceName(pipelineInfo *pps.PipelineInfo) string {
+	if hasRollout(pipelineInfo) {
+		// like PipelineRcName, but without the version
+		name := strings.ToLower(strings.Replace(pipelineInfo.Pipeline.Name, "_", "-", -1))
+		return fmt.Sprintf("pipeline-%s-user", name)
+	}
+	return ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version) + "-user"
+}
+
+// userServiceSelector selects the workers in the worker set 'rcName' of
+// 'pipeline'
+func userServiceSelector(rcName, pipeline string) map[string]string {
+	return map[string]string{
+		"app":             rcName,
+		pipelineNameLabel: pipeline,
+	}
+}
+
+// rollsOut returns whether op's pipeline is a service whose traffic should
+// move to op's version by rolling it out, rather than by restarting it
+func (op *pipelineOp) rollsOut() bool {
+	return hasRollout(op.pipelineInfo) && !op.pipelineInfo.Stopped &&
+		op.pipelineInfo.State != pps.PipelineState_PIPELINE_FAILURE
+}
+
+// rollout moves the traffic of op's service from the workers of the old
+// version that's serving it to the workers of op's version, once one of them
+// is ready. If none is ready within the rollout's timeout, it stops them and
+// leaves the old version serving. rollout returns true once op's version is
+// the only one serving (or if no old version is), so that step() can carry on
+// as it would for any other pipeline.
+//
+// Like the rest of step(), rollout doesn't block: while op's version isn't
+// ready, it returns false, and pollPipelines calls step() again later.
+func (op *pipelineOp) rollout() (bool, error) {
+	runtime := op.m.a.workerRuntime
+	pipeline := op.pipelineInfo.Pipeline.Name
+	sets, err := runtime.listWorkerSets(pipeline)
+	if err != nil {
+		return false, newRetriableError(err, "error listing workers")
+	}
+	serving, err := runtime.servingWorkers(op.pipelineInfo)
+	if err != nil {
+		return false, newRetriableError(err, "error getting serving workers")
+	}
+	name := ppsutil.PipelineRcName(pipeline, op.pipelineInfo.Version)
+	var next, prev *workerSet
+	for _, ws := range sets {
+		switch ws.name {
+		case name:
+			next = ws
+		case serving:
+			prev = ws
+		}
+	}
+	if prev == nil && (next == nil || next.name != serving) {
+		return true, nil // nothing is serving, so there's no traffic to move
+	}
+	// Delete the workers of any other versions, e.g. whose rollouts failed
+	for _, ws := range sets {
+		if ws != next && ws != prev {
+			if err := op.deleteWorkerSet(ws); err != nil {
+				return false, err
+			}
+		}
+	}
+	if prev == nil {
+		return true, nil // op's version is already serving
+	}
+	if err := op.revokeOldAuthTokens(prev.pipelineVersion); err != nil {
+		return false, err
+	}
+	if next == nil {
+		log.Infof("PPS master: starting version %d of %q alongside version %s",
+			op.pipelineInfo.Version, pipeline, prev.pipelineVersion)
+		if err := op.createPipelineResources(); err != nil {
+			return false, err
+		}
+		// services have a parallelism of 1
+		if err := runtime.scaleWorkers(name, 1); err != nil {
+			return false, newRetriableError(err, "error scaling up new version")
+		}
+		return false, nil
+	}
+	if next.rolloutFailed {
+		return false, nil // keep serving the old version until the pipeline is updated
+	}
+	ready, err := runtime.workersReady(next.name)
+	if err != nil {
+		return false, newRetriableError(err, "error checking readiness of new version")
+	}
+	if !ready {
+		if next.replicas == 0 {
+			if err := runtime.scaleWorkers(name, 1); err != nil {
+				return false, newRetriableError(err, "error scaling up new version")
+			}
+		}
+		timeout, err := types.DurationFromProto(op.pipelineInfo.Details.Service.Rollout.Timeout)
+		if err != nil {
+			timeout = defaultRolloutTimeout
+		}
+		if time.Since(next.created) < timeout {
+			return false, nil
+		}
+		log.Errorf("PPS master: version %d of %q wasn't ready within %v, rolling back to version %s",
+			op.pipelineInfo.Version, pipeline, timeout, prev.pipelineVersion)
+		if err := runtime.abortRollout(next.name); err != nil {
+			return false, newRetriableError(err, "error rolling back")
+		}
+		return false, op.setPipelineState(pps.PipelineState_PIPELINE_CRASHING, fmt.Sprintf(
+			"version %d didn't pass its readiness probe within %v, so version %s is still serving",
+			op.pipelineInfo.Version, timeout, prev.pipelineVersion))
+	}
+	log.Infof("PPS master: switching service of %q from version %s to version %d",
+		pipeline, prev.pipelineVersion, op.pipelineInfo.Version)
+	if err := runtime.routeService(op.pipelineInfo); err != nil {
+		return false, newRetriableError(err, "error switching service to new version")
+	}
+	// The monitor watches the old version, so restart it
+	op.stopPipelineMonitor()
+	if err := op.deleteWorkerSet(prev); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// deleteWorkerSet deletes 'ws', the workers of an old version of op's
+// pipeline
+func (op *pipelineOp) deleteWorkerSet(ws *workerSet) error {
+	log.Infof("PPS master: deleting workers of version %s of %q", ws.pipelineVersion, ws.pipeline)
+	if err := op.m.a.workerRuntime.deleteWorkerSet(ws.name); err != nil {
+		return newRetriableError(err, "error deleting old workers")
+	}
+	return nil
+}
+
+// revokeOldAuthTokens revokes the auth tokens of the old versions of op's
+// pipeline, except that of version 'serving' (if set), whose workers still
+// serve while op's version rolls out. Updating a service with a rollout leaves
+// its old tokens to the PPS master, since only the master knows when the old
+// version stops serving. revokeOldAuthTokens clears the tokens that it
+// revokes, so that later steps don't revoke them again.
+func (op *pipelineOp) revokeOldAuthTokens(serving string) error {
+	a := op.m.a
+	if err := a.txnEnv.WithWriteContext(op.ctx, func(txnCtx *txncontext.TransactionContext) error {
+		pipelines := a.pipelines.ReadWrite(txnCtx.SqlTx)
+		var oldInfos []*pps.PipelineInfo
+		pipelineInfo := &pps.PipelineInfo{}
+		if err := pipelines.GetByIndex(ppsdb.PipelinesNameIndex, op.pipelineInfo.Pipeline.Name,
+			pipelineInfo, col.DefaultOptions(), func(string) error {
+				if pipelineInfo.Version < op.pipelineInfo.Version && pipelineInfo.AuthToken != "" &&
+					strconv.FormatUint(pipelineInfo.Version, 10) != serving {
+					oldInfos = append(oldInfos, proto.Clone(pipelineInfo).(*pps.PipelineInfo))
+				}
+				return nil
+			}); err != nil {
+			return err
+		}
+		for _, oldInfo := range oldInfos {
+			log.Infof("PPS master: revoking auth token of version %d of %q", oldInfo.Version, oldInfo.Pipeline.Name)
+			if _, err := a.env.AuthServer.RevokeAuthTokenInTransaction(txnCtx,
+				&auth.RevokeAuthTokenRequest{Token: oldInfo.AuthToken}); err != nil && !auth.IsErrNotActivated(err) {
+				return err
+			}
+			oldInfo.AuthToken = ""
+			if err := pipelines.Put(oldInfo.SpecCommit, oldInfo); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return newRetriableError(err, "error revoking auth tokens of old versions")
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestServiceRolloutSpec(t *testing.T) {
+	service := &pps.Service{
+		InternalPort:   8080,
+		ExternalPort:   30080,
+		ReadinessProbe: &pps.ReadinessProbe{HttpPath: "/healthz"},
+		Rollout:        &pps.ServiceRollout{},
+	}
+	setServiceDefaults(service)
+	require.Equal(t, int32(8080), service.ReadinessProbe.Port)
+	require.Equal(t, int32(defaultReadinessPeriodSeconds), service.ReadinessProbe.PeriodSeconds)
+	timeout, err := types.DurationFromProto(service.Rollout.Timeout)
+	require.NoError(t, err)
+	require.Equal(t, defaultRolloutTimeout, timeout)
+	require.NoError(t, validateServiceRollout(&pps.PipelineInfo_Details{Service: service}))
+
+	probe := readinessProbe(service)
+	require.Equal(t, "/healthz", probe.Handler.HTTPGet.Path)
+	require.Equal(t, 8080, probe.Handler.HTTPGet.Port.IntValue())
+	service.ReadinessProbe.HttpPath = ""
+	require.Equal(t, 8080, readinessProbe(service).Handler.TCPSocket.Port.IntValue())
+
+	// Rollouts need a readiness probe, and aren't supported for spouts
+	require.YesError(t, validateServiceRollout(&pps.PipelineInfo_Details{
+		Service: &pps.Service{InternalPort: 8080, Rollout: &pps.ServiceRollout{}},
+	}))
+	require.YesError(t, validateServiceRollout(&pps.PipelineInfo_Details{
+		Spout: &pps.Spout{Service: service},
+	}))
+	service.Rollout.Timeout = types.DurationProto(-time.Minute)
+	require.YesError(t, validateServiceRollout(&pps.PipelineInfo_Details{Service: service}))
+}
+
+func TestUserServiceName(t *testing.T) {
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline: &pps.Pipeline{Name: "Model_Server"},
+		Version:  3,
+		Details:  &pps.PipelineInfo_Details{Service: &pps.Service{}},
+	}
+	require.Equal(t, "pipeline-model-server-v3-user", userServiceName(pipelineInfo))
+	// Services with a rollout keep their service across versions
+	pipelineInfo.Details.Service.Rollout = &pps.ServiceRollout{}
+	require.Equal(t, "pipeline-model-server-user", userServiceName(pipelineInfo))
+}
+
+// fakeWorkerRuntime keeps worker sets in memory. Its workers are never
+// scheduled, so only the worker sets in 'ready' pass their readiness probe.
+type fakeWorkerRuntime struct {
+	workerRuntime // the methods that pipelineOps don't call panic
+	sets          map[string]*workerSet
+	serving       string
+	ready         map[string]bool
+}
+
+func newFakeWorkerRuntime() *fakeWorkerRuntime {
+	return &fakeWorkerRuntime{
+		sets:  make(map[string]*workerSet),
+		ready: make(map[string]bool),
+	}
+}
+
+// addWorkerSet adds the workers of pipelineInfo, as createWorkers does
+func (f *fakeWorkerRuntime) addWorkerSet(pipelineInfo *pps.PipelineInfo, replicas int32) *workerSet {
+	ws := &workerSet{
+		name:            ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version),
+		pipeline:        pipelineInfo.Pipeline.Name,
+		pipelineVersion: strconv.FormatUint(pipelineInfo.Version, 10),
+		authTokenHash:   hashAuthToken(pipelineInfo.AuthToken),
+		replicas:        replicas,
+		created:         time.Now(),
+	}
+	f.sets[ws.name] = ws
+	return ws
+}
+
+func (f *fakeWorkerRuntime) createWorkers(_ context.Context, pipelineInfo *pps.PipelineInfo) error {
+	if _, ok := f.sets[ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)]; !ok {
+		f.addWorkerSet(pipelineInfo, 0)
+	}
+	return nil
+}
+
+func (f *fakeWorkerRuntime) listWorkerSets(pipeline string) ([]*workerSet, error) {
+	var sets []*workerSet
+	for _, ws := range f.sets {
+		if pipeline == "" || ws.pipeline == pipeline {
+			sets = append(sets, ws)
+		}
+	}
+	sort.Slice(sets, func(i, j int) bool { return sets[i].name < sets[j].name })
+	return sets, nil
+}
+
+func (f *fakeWorkerRuntime) scaleWorkers(name string, replicas int32) error {
+	ws, ok := f.sets[name]
+	if !ok {
+		return errors.Errorf("worker set %q not found", name)
+	}
+	ws.replicas = replicas
+	return nil
+}
+
+func (f *fakeWorkerRuntime) servingWorkers(*pps.PipelineInfo) (string, error) {
+	return f.serving, nil
+}
+
+func (f *fakeWorkerRuntime) routeService(pipelineInfo *pps.PipelineInfo) error {
+	f.serving = ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	return nil
+}
+
+func (f *fakeWorkerRuntime) workersReady(name string) (bool, error) {
+	return f.ready[name], nil
+}
+
+func (f *fakeWorkerRuntime) abortRollout(name string) error {
+	if err := f.scaleWorkers(name, 0); err != nil {
+		return err
+	}
+	f.sets[name].rolloutFailed = true
+	return nil
+}
+
+func (f *fakeWorkerRuntime) deleteWorkerSet(name string) error {
+	delete(f.sets, name)
+	return nil
+}
+
+// newTestPipelineOp returns a pipelineOp of pipelineInfo, whose workers run in
+// 'runtime', and stores pipelineInfo as CreatePipeline would
+func newTestPipelineOp(t *testing.T, runtime workerRuntime, pipelineInfo *pps.PipelineInfo) *pipelineOp {
+	ctx := context.Background()
+	a := newTestAPIServer(t)
+	a.workerRuntime = runtime
+	putPipelineInfo(t, a, pipelineInfo)
+	return &pipelineOp{
+		m: &ppsMaster{
+			a:                      a,
+			masterCtx:              ctx,
+			monitorCancels:         make(map[string]func()),
+			crashingMonitorCancels: make(map[string]func()),
+		},
+		ctx:          ctx,
+		pipelineInfo: pipelineInfo,
+	}
+}
+
+func putPipelineInfo(t *testing.T, a *apiServer, pipelineInfo *pps.PipelineInfo) {
+	require.NoError(t, dbutil.WithTx(context.Background(), a.env.DB, func(sqlTx *sqlx.Tx) error {
+		return a.pipelines.ReadWrite(sqlTx).Put(pipelineInfo.SpecCommit, pipelineInfo)
+	}))
+}
+
+// pipelineState returns the state of the pipeline version whose spec is
+// 'specCommit', as stored in the database
+func pipelineState(t *testing.T, a *apiServer, specCommit *pfs.Commit) pps.PipelineState {
+	pipelineInfo := &pps.PipelineInfo{}
+	require.NoError(t, a.pipelines.ReadOnly(context.Background()).Get(specCommit, pipelineInfo))
+	return pipelineInfo.State
+}
+
+// testServiceVersions returns two versions of a service with a rollout
+func testServiceVersions() (v1, v2 *pps.PipelineInfo) {
+	v1 = testPipelineInfo()
+	v1.SpecCommit.ID = uuid.NewWithoutDashes()
+	v1.State = pps.PipelineState_PIPELINE_RUNNING
+	v1.Details.Service = &pps.Service{
+		InternalPort:   8080,
+		ReadinessProbe: &pps.ReadinessProbe{Port: 8080},
+		Rollout:        &pps.ServiceRollout{Timeout: types.DurationProto(time.Minute)},
+	}
+	v2 = proto.Clone(v1).(*pps.PipelineInfo)
+	v2.Version = 2
+	v2.SpecCommit.ID = uuid.NewWithoutDashes()
+	return v1, v2
+}
+
+func TestRollout(t *testing.T) {
+	v1, v2 := testServiceVersions()
+	runtime := newFakeWorkerRuntime()
+	op := newTestPipelineOp(t, runtime, v2)
+	old := runtime.addWorkerSet(v1, 1)
+	runtime.serving = old.name
+	// A version whose rollout was rolled back is deleted
+	v0 := proto.Clone(v1).(*pps.PipelineInfo)
+	v0.Version = 0
+	runtime.addWorkerSet(v0, 0).rolloutFailed = true
+	monitorCancelled := false
+	op.m.monitorCancels["edges"] = func() { monitorCancelled = true }
+
+	// The new version starts alongside the old one, which keeps serving
+	// until the new one is ready.
+	done, err := op.rollout()
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, 2, len(runtime.sets))
+	next := runtime.sets[ppsutil.PipelineRcName("edges", 2)]
+	require.NotNil(t, next)
+	require.Equal(t, int32(1), next.replicas)
+	require.Equal(t, old.name, runtime.serving)
+	done, err = op.rollout()
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, old.name, runtime.serving)
+
+	// Once the new version is ready, its workers serve, and the old ones are
+	// deleted.
+	runtime.ready[next.name] = true
+	done, err = op.rollout()
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, next.name, runtime.serving)
+	require.Equal(t, 1, len(runtime.sets))
+	require.NotNil(t, runtime.sets[next.name])
+	require.True(t, monitorCancelled)
+	require.Equal(t, pps.PipelineState_PIPELINE_RUNNING, pipelineState(t, op.m.a, v2.SpecCommit))
+	done, err = op.rollout()
+	require.NoError(t, err)
+	require.True(t, done)
+}
+
+func TestRolloutTimeout(t *testing.T) {
+	v1, v2 := testServiceVersions()
+	runtime := newFakeWorkerRuntime()
+	op := newTestPipelineOp(t, runtime, v2)
+	old := runtime.addWorkerSet(v1, 1)
+	runtime.serving = old.name
+	next := runtime.addWorkerSet(v2, 1)
+	next.created = time.Now().Add(-2 * time.Minute)
+
+	// The new version wasn't ready within the rollout's timeout, so it's
+	// stopped, and the old version keeps serving.
+	done, err := op.rollout()
+	require.NoError(t, err)
+	require.False(t, done)
+	require.True(t, next.rolloutFailed)
+	require.Equal(t, int32(0), next.replicas)
+	require.Equal(t, old.name, runtime.serving)
+	require.Equal(t, int32(1), old.replicas)
+	require.Equal(t, pps.PipelineState_PIPELINE_CRASHING, pipelineState(t, op.m.a, v2.SpecCommit))
+
+	// It isn't retried, even once it would be ready, until the pipeline is
+	// updated again.
+	runtime.ready[next.name] = true
+	done, err = op.rollout()
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, old.name, runtime.serving)
+	require.Equal(t, int32(0), next.replicas)
+}
@@ -45,11 +45,17 @@ type Env struct {
 }
 
 func EnvFromServiceEnv(senv serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, reporter *metrics.Reporter) Env {
+	// The local worker runtime doesn't use kubernetes, so pachd may not have
+	// connected to it
+	var kubeClient *kubernetes.Clientset
+	if senv.Config().PPSWorkerRuntime != localRuntimeName {
+		kubeClient = senv.GetKubeClient()
+	}
 	return Env{
 		DB:            senv.GetDBClient(),
 		TxnEnv:        txnEnv,
 		Listener:      senv.GetPostgresListener(),
-		KubeClient:    senv.GetKubeClient(),
+		KubeClient:    kubeClient,
 		EtcdClient:    senv.GetEtcdClient(),
 		GetLokiClient: senv.GetLokiClient,
 
@@ -85,16 +91,28 @@ func NewAPIServer(env Env) (ppsiface.APIServer, error) {
 		workerUsesRoot:        config.WorkerUsesRoot,
 		pipelines:             ppsdb.Pipelines(env.DB, env.Listener),
 		jobs:                  ppsdb.Jobs(env.DB, env.Listener),
+		eventLog:              newEventLog(env),
 		workerGrpcPort:        config.PPSWorkerPort,
 		port:                  config.Port,
 		peerPort:              config.PeerPort,
 		gcPercent:             config.GCPercent,
 	}
-	apiServer.validateKube()
+	runtime, err := newWorkerRuntime(apiServer)
+	if err != nil {
+		return nil, err
+	}
+	apiServer.workerRuntime = runtime
+	apiServer.workerRuntime.validate()
 	go apiServer.master()
 	return apiServer, nil
 }
 
+// NewAPIServerFromServiceEnv creates an APIServer from a ServiceEnv, without
+// metrics. It's used to run PPS in testpachd.NewRealEnvWithPPS.
+func NewAPIServerFromServiceEnv(senv serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) (ppsiface.APIServer, error) {
+	return NewAPIServer(EnvFromServiceEnv(senv, txnEnv, nil))
+}
+
 // NewSidecarAPIServer creates an APIServer that has limited functionalities
 // and is meant to be run as a worker sidecar.  It cannot, for instance,
 // create pipelines.
@@ -115,6 +133,7 @@ func NewSidecarAPIServer(
 		workerUsesRoot: true,
 		pipelines:      ppsdb.Pipelines(env.DB, env.Listener),
 		jobs:           ppsdb.Jobs(env.DB, env.Listener),
+		eventLog:       newEventLog(env),
 		workerGrpcPort: workerGrpcPort,
 		peerPort:       peerPort,
 	}
@@ -1,10 +1,8 @@
 package server
 
 import (
-	"github.com/pachyderm/pachyderm/v2/src/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
-	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
@@ -99,12 +97,10 @@ func (jf *JobFinisher) FinishJob(commitInfo *pfs.CommitInfo) {
 
 func (jf *JobFinisher) Run() error {
 	if len(jf.commitInfos) > 0 {
-		pipelines := jf.a.pipelines.ReadWrite(jf.txnCtx.SqlTx)
 		jobs := jf.a.jobs.ReadWrite(jf.txnCtx.SqlTx)
 		for _, commitInfo := range jf.commitInfos {
-			jobKey := ppsdb.JobKey(client.NewJob(commitInfo.Commit.Branch.Repo.Name, commitInfo.Commit.ID))
 			jobInfo := &pps.JobInfo{}
-			if err := jobs.Get(jobKey, jobInfo); err != nil {
+			if err := getJobOfCommit(jobs, commitInfo.Commit, jobInfo); err != nil {
 				// Commits in source repos will not have a job associated with them.
 				if col.IsErrNotFound(err) {
 					continue
@@ -120,7 +116,7 @@ func (jf *JobFinisher) Run() error {
 				state = pps.JobState_JOB_FAILURE
 				reason = commitInfo.Error
 			}
-			if err := ppsutil.UpdateJobState(pipelines, jobs, jobInfo, state, reason); err != nil {
+			if err := jf.a.updateJobState(jf.txnCtx.SqlTx, jobInfo, state, reason); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// eventLogRetention is how long events are kept in the event log, and
+	// so how long a client of Watch can be disconnected and still resume.
+	eventLogRetention     = 24 * time.Hour
+	eventLogSweepInterval = 10 * time.Minute
+)
+
+func newEventLog(env Env) *col.PostgresEventLog {
+	return col.NewPostgresEventLog(env.DB, env.Listener,
+		pfsdb.Repos(env.DB, env.Listener),
+		pfsdb.Branches(env.DB, env.Listener),
+		pfsdb.Commits(env.DB, env.Listener),
+		ppsdb.Pipelines(env.DB, env.Listener),
+		ppsdb.Jobs(env.DB, env.Listener),
+	)
+}
+
+// Watch implements the protobuf pps.Watch RPC
+func (a *apiServer) Watch(request *pps.WatchRequest, stream pps.API_WatchServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	ctx := stream.Context()
+
+	var cursor col.EventLogCursor
+	var err error
+	if request.Cursor == "" {
+		cursor, err = a.eventLog.Head(ctx)
+	} else {
+		cursor, err = col.ParseEventLogCursor(request.Cursor)
+	}
+	if err != nil {
+		return err
+	}
+	wf := newWatchFilter(a, request)
+	// Check access to the requested repos and pipelines up front, rather than
+	// silently filtering out all of their events.
+	for _, repo := range request.Repos {
+		if err := wf.checkAccess(ctx, normalizeRepo(repo)); err != nil {
+			return err
+		}
+	}
+	for _, pipeline := range request.Pipelines {
+		if err := wf.checkAccess(ctx, client.NewRepo(pipeline.Name)); err != nil {
+			return err
+		}
+	}
+	return a.eventLog.Watch(ctx, cursor, func(ev *col.EventLogEvent) error {
+		event := &pps.WatchEvent{
+			Cursor:    ev.Cursor.String(),
+			Operation: pps.WatchOperation_WATCH_PUT,
+		}
+		if ev.Type == watch.EventDelete {
+			event.Operation = pps.WatchOperation_WATCH_DELETE
+		}
+		var err error
+		if event.Time, err = types.TimestampProto(ev.Time); err != nil {
+			return err
+		}
+		var repo *pfs.Repo
+		var pipeline string
+		switch info := ev.Value.(type) {
+		case *pfs.RepoInfo:
+			event.RepoInfo = info
+			repo = info.Repo
+		case *pfs.BranchInfo:
+			event.BranchInfo = info
+			repo = info.Branch.Repo
+		case *pfs.CommitInfo:
+			event.CommitInfo = info
+			repo = info.Commit.Branch.Repo
+		case *pps.JobInfo:
+			event.JobInfo = info
+			pipeline = info.Job.Pipeline.Name
+			repo = client.NewRepo(pipeline)
+		case *pps.PipelineInfo:
+			// Erase the pipeline's auth token, as InspectPipeline does.
+			info.AuthToken = ""
+			event.PipelineInfo = info
+			pipeline = info.Pipeline.Name
+			repo = client.NewRepo(pipeline)
+		default:
+			return nil
+		}
+		if ok, err := wf.matches(ctx, repo, pipeline, ev.Type == watch.EventDelete); err != nil || !ok {
+			return err
+		}
+		return stream.Send(event)
+	})
+}
+
+// watchFilter filters the events sent by Watch to those that were requested,
+// and that the caller can read. Access is checked for every event, so that
+// changes to the caller's role bindings apply to open streams.
+type watchFilter struct {
+	a         *apiServer
+	repos     map[string]bool
+	pipelines map[string]bool
+	// readable records the repos that the caller could read when their
+	// events were checked. A repo's role binding is deleted with it, so the
+	// events of its deletion can't be checked, and are instead sent if the
+	// caller could read the repo earlier in the stream.
+	readable map[string]bool
+}
+
+func newWatchFilter(a *apiServer, request *pps.WatchRequest) *watchFilter {
+	wf := &watchFilter{
+		a:         a,
+		repos:     make(map[string]bool),
+		pipelines: make(map[string]bool),
+		readable:  make(map[string]bool),
+	}
+	for _, repo := range request.Repos {
+		wf.repos[pfsdb.RepoKey(normalizeRepo(repo))] = true
+	}
+	for _, pipeline := range request.Pipelines {
+		wf.pipelines[pipeline.Name] = true
+	}
+	return wf
+}
+
+// normalizeRepo defaults the type of repos in requests to user repos.
+func normalizeRepo(repo *pfs.Repo) *pfs.Repo {
+	if repo.Type == "" {
+		return client.NewRepo(repo.Name)
+	}
+	return repo
+}
+
+// matches returns true if an event about repo (and pipeline, for job and
+// pipeline events) should be sent. deleted is true for events that delete
+// what they are about.
+func (wf *watchFilter) matches(ctx context.Context, repo *pfs.Repo, pipeline string, deleted bool) (bool, error) {
+	if len(wf.repos) > 0 || len(wf.pipelines) > 0 {
+		// Events about a pipeline's output, meta and spec repos are sent for
+		// the pipeline.
+		if !wf.repos[pfsdb.RepoKey(repo)] && !wf.pipelines[repo.Name] && !wf.pipelines[pipeline] {
+			return false, nil
+		}
+	}
+	if err := wf.checkAccess(ctx, repo); err != nil {
+		// Deleted repos have no role binding.
+		if auth.IsErrNoRoleBinding(err) {
+			return deleted && wf.readable[pfsdb.RepoKey(repo)], nil
+		}
+		if !auth.IsErrNotAuthorized(err) {
+			return false, err
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func (wf *watchFilter) checkAccess(ctx context.Context, repo *pfs.Repo) error {
+	if err := wf.a.env.AuthServer.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_READ); err != nil && !auth.IsErrNotActivated(err) {
+		if auth.IsErrNotAuthorized(err) {
+			delete(wf.readable, pfsdb.RepoKey(repo))
+		}
+		return err
+	}
+	wf.readable[pfsdb.RepoKey(repo)] = true
+	return nil
+}
+
+// sweepEventLog deletes the events older than eventLogRetention from the
+// event log.
+func (m *ppsMaster) sweepEventLog(ctx context.Context) {
+	ticker := time.NewTicker(eventLogSweepInterval)
+	defer ticker.Stop()
+	for {
+		if err := m.a.eventLog.Sweep(ctx, time.Now().Add(-eventLogRetention)); err != nil && ctx.Err() == nil {
+			log.Errorf("PPS master: error sweeping the event log: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -30,10 +30,11 @@ import (
 )
 
 const (
-	pipelineNameLabel         = "pipelineName"
-	pachVersionAnnotation     = "pachVersion"
-	pipelineVersionAnnotation = "pipelineVersion"
-	hashedAuthTokenAnnotation = "authTokenHash"
+	pipelineNameLabel          = "pipelineName"
+	pachVersionAnnotation      = "pachVersion"
+	pipelineVersionAnnotation  = "pipelineVersion"
+	hashedAuthTokenAnnotation  = "authTokenHash"
+	pipelinePriorityAnnotation = "pipelinePriority"
 )
 
 // Parameters used when creating the kubernetes replication controller in charge
@@ -79,6 +80,14 @@ func getPachctlSecretVolumeAndMount(secret string) (v1.Volume, v1.VolumeMount) {
 		}
 }
 
+// userCodeGetsCredentials returns false if the user code is sandboxed without
+// pachd's credentials, in which case the spout pachctl secret, which holds the
+// pipeline's auth token, isn't mounted in the user container, as the user code
+// could read the token from it.
+func userCodeGetsCredentials(transform *pps.Transform) bool {
+	return transform.Sandbox == nil || transform.Sandbox.PassCredentials
+}
+
 func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.PipelineInfo) (v1.PodSpec, error) {
 	pullPolicy := a.workerImagePullPolicy
 	if pullPolicy == "" {
@@ -221,6 +230,16 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "GOOGLE_CLOUD_PROFILER_PROJECT", Value: p})
 		workerEnv = append(workerEnv, v1.EnvVar{Name: "GOOGLE_CLOUD_PROFILER_PROJECT", Value: p})
 	}
+	// Workers and sidecars must use the same work task queue backend as pachd
+	sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: assets.WorkBackendEnvVar, Value: a.env.Config.WorkBackend})
+	workerEnv = append(workerEnv, v1.EnvVar{Name: assets.WorkBackendEnvVar, Value: a.env.Config.WorkBackend})
+	// Propagate the OTLP trace exporter configuration to worker and sidecar
+	for _, name := range tracing.ExporterEnvVars {
+		if value, ok := os.LookupEnv(name); ok {
+			sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: name, Value: value})
+			workerEnv = append(workerEnv, v1.EnvVar{Name: name, Value: value})
+		}
+	}
 
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
@@ -269,7 +288,9 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		pachctlSecretVolume, pachctlSecretMount := getPachctlSecretVolumeAndMount("spout-pachctl-secret-" + pipelineInfo.Pipeline.Name)
 		options.volumes = append(options.volumes, pachctlSecretVolume)
 		sidecarVolumeMounts = append(sidecarVolumeMounts, pachctlSecretMount)
-		userVolumeMounts = append(userVolumeMounts, pachctlSecretMount)
+		if userCodeGetsCredentials(pipelineInfo.Details.Transform) {
+			userVolumeMounts = append(userVolumeMounts, pachctlSecretMount)
+		}
 	}
 
 	// Explicitly set CPU requests to zero because some cloud providers set their
@@ -388,6 +409,9 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		ImagePullSecrets:              options.imagePullSecrets,
 		TerminationGracePeriodSeconds: int64Ptr(0),
 	}
+	if options.service != nil {
+		podSpec.Containers[0].ReadinessProbe = readinessProbe(options.service)
+	}
 	if options.schedulingSpec != nil {
 		podSpec.NodeSelector = options.schedulingSpec.NodeSelector
 		podSpec.PriorityClassName = options.schedulingSpec.PriorityClassName
@@ -462,6 +486,17 @@ func (a *apiServer) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.EnvVa
 		{Name: assets.UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(a.env.Config.StorageUploadConcurrencyLimit)},
 		{Name: client.PPSPipelineNameEnv, Value: pipelineInfo.Pipeline.Name},
 	}
+	// The sidecar reads chunks that may have been moved to the cold tier.
+	if a.env.Config.StorageColdTierURL != "" {
+		vars = append(vars, v1.EnvVar{Name: assets.ColdTierURLEnvVar, Value: a.env.Config.StorageColdTierURL})
+	}
+	// The sidecar reads chunks from the replica when pachd has failed over.
+	if a.env.Config.StorageReplicaURL != "" {
+		vars = append(vars,
+			v1.EnvVar{Name: assets.ReplicaURLEnvVar, Value: a.env.Config.StorageReplicaURL},
+			v1.EnvVar{Name: assets.FailoverEnvVar, Value: strconv.FormatBool(a.env.Config.StorageFailover)},
+		)
+	}
 	return vars
 }
 
@@ -592,10 +627,11 @@ func (a *apiServer) getWorkerOptions(pipelineInfo *pps.PipelineInfo) (*workerOpt
 	}
 
 	annotations := map[string]string{
-		pipelineNameLabel:         pipelineName,
-		pachVersionAnnotation:     version.PrettyVersion(),
-		pipelineVersionAnnotation: strconv.FormatUint(pipelineInfo.Version, 10),
-		hashedAuthTokenAnnotation: hashAuthToken(pipelineInfo.AuthToken),
+		pipelineNameLabel:          pipelineName,
+		pachVersionAnnotation:      version.PrettyVersion(),
+		pipelineVersionAnnotation:  strconv.FormatUint(pipelineInfo.Version, 10),
+		hashedAuthTokenAnnotation:  hashAuthToken(pipelineInfo.AuthToken),
+		pipelinePriorityAnnotation: strconv.FormatInt(pipelineInfo.Details.Priority, 10),
 	}
 
 	// add the user's custom metadata (annotations and labels).
@@ -808,7 +844,7 @@ func (a *apiServer) createWorkerSvcAndRc(ctx context.Context, pipelineInfo *pps.
 				APIVersion: "v1",
 			},
 			ObjectMeta: metav1.ObjectMeta{
-				Name:        options.rcName + "-user",
+				Name:        userServiceName(pipelineInfo),
 				Labels:      options.labels,
 				Annotations: options.annotations,
 			},
@@ -818,6 +854,17 @@ func (a *apiServer) createWorkerSvcAndRc(ctx context.Context, pipelineInfo *pps.
 				Ports:    servicePort,
 			},
 		}
+		if hasRollout(pipelineInfo) {
+			// The service outlives this version's RC, so it's labelled only with
+			// the pipeline, and it starts out selecting this version's workers.
+			// If it already exists, it's switched to this version's workers once
+			// they're ready (see rollout()).
+			pipelineName := pipelineInfo.Pipeline.Name
+			service.ObjectMeta.Labels = labels(service.ObjectMeta.Name)
+			service.ObjectMeta.Labels[pipelineNameLabel] = pipelineName
+			service.ObjectMeta.Annotations = map[string]string{pipelineNameLabel: pipelineName}
+			service.Spec.Selector = userServiceSelector(options.rcName, pipelineName)
+		}
 		if _, err := a.env.KubeClient.CoreV1().Services(a.namespace).Create(service); err != nil {
 			if !errutil.IsAlreadyExistError(err) {
 				return err
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/enterprise"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestSpoutPachctlSecretMount(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mock, err := testpachd.NewMockPachd(ctx)
+	require.NoError(t, err)
+	defer mock.Close()
+	mock.Enterprise.GetState.Use(func(context.Context, *enterprise.GetStateRequest) (*enterprise.GetStateResponse, error) {
+		return &enterprise.GetStateResponse{State: enterprise.State_ACTIVE}, nil
+	})
+	c, err := client.NewFromURI(mock.Addr.String())
+	require.NoError(t, err)
+	defer c.Close()
+	a := &apiServer{env: Env{
+		Config:        *serviceenv.ConfigFromOptions(),
+		Logger:        logrus.New(),
+		GetPachClient: func(context.Context) *client.APIClient { return c },
+	}}
+	pipelineInfo := testPipelineInfo()
+	pipelineInfo.Details.Spout = &pps.Spout{}
+	pipelineInfo.Details.Transform.User = "1000"
+	// mounted returns whether the spout's pachctl secret is mounted in the
+	// sidecar and user containers.
+	mounted := func(sandbox *pps.Sandbox) (sidecar, user bool) {
+		pipelineInfo.Details.Transform.Sandbox = sandbox
+		podSpec, err := a.workerPodSpec(&workerOptions{}, pipelineInfo)
+		require.NoError(t, err)
+		for _, container := range podSpec.Containers {
+			for _, mount := range container.VolumeMounts {
+				if mount.Name != client.PachctlSecretName {
+					continue
+				}
+				switch container.Name {
+				case client.PPSWorkerSidecarContainerName:
+					sidecar = true
+				case client.PPSWorkerUserContainerName:
+					user = true
+				}
+			}
+		}
+		return sidecar, user
+	}
+	for _, test := range []struct {
+		name    string
+		sandbox *pps.Sandbox
+		user    bool
+	}{
+		{"unsandboxed", nil, true},
+		{"passes credentials", &pps.Sandbox{PassCredentials: true}, true},
+		// The secret holds the pipeline's auth token, so it isn't mounted where
+		// the sandboxed user code could read it.
+		{"sandboxed", &pps.Sandbox{}, false},
+	} {
+		sidecar, user := mounted(test.sandbox)
+		require.True(t, sidecar, test.name)
+		require.Equal(t, test.user, user, test.name)
+	}
+}
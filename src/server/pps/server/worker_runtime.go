@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// kubeRuntimeName is the default worker runtime, which runs each pipeline's
+	// workers as pods managed by an RC
+	kubeRuntimeName = "kubernetes"
+	// localRuntimeName is the worker runtime that runs workers as subprocesses
+	// of pachd, for development and testing without a cluster
+	localRuntimeName = "local"
+)
+
+// workerSet is the set of workers that a workerRuntime runs for one version of
+// a pipeline. In Kubernetes, this is the pipeline's RC.
+type workerSet struct {
+	name            string // ppsutil.PipelineRcName of the pipeline
+	pipeline        string
+	pipelineVersion string
+	pachVersion     string
+	authTokenHash   string
+	replicas        int32
+	created         time.Time
+	// rolloutFailed is set if the workers were a service version whose rollout
+	// was rolled back
+	rolloutFailed bool
+}
+
+// workerLogOptions selects the lines of a worker's logs that workerLogs
+// returns
+type workerLogOptions struct {
+	follow       bool
+	tail         int64 // <= 0 for all lines
+	sinceSeconds int64
+}
+
+// workerRuntime is what the PPS master uses to run pipeline workers. It
+// creates, scales, and deletes a pipeline's workers, and reports workers that
+// can't run so that their pipeline can be put in CRASHING.
+type workerRuntime interface {
+	// validate logs any problems with the runtime's access to its backend
+	validate()
+	// createWorkers creates the workers and any other resources of
+	// pipelineInfo, scaled to zero. It's a no-op if they already exist.
+	createWorkers(ctx context.Context, pipelineInfo *pps.PipelineInfo) error
+	// listWorkerSets returns the worker sets of a pipeline, or of all pipelines
+	// if pipeline is empty
+	listWorkerSets(pipeline string) ([]*workerSet, error)
+	// scaleWorkers sets the number of workers in the worker set 'name'
+	scaleWorkers(name string, replicas int32) error
+	// deleteWorkers deletes all worker sets and other resources of a pipeline
+	deleteWorkers(pipeline string) error
+	// pendingHigherPriority returns the name of a pipeline with a higher
+	// priority than pipelineInfo's that has workers which can't be scheduled,
+	// if there is one
+	pendingHigherPriority(pipelineInfo *pps.PipelineInfo) (string, bool, error)
+	// watchWorkers calls crash for each worker that can't run, until ctx is
+	// cancelled or the watch fails
+	watchWorkers(ctx context.Context, crash func(pipeline string, pipelineVersion int, reason string) error) error
+	// listWorkers returns the names of the workers in the worker set 'name'
+	// (or pachd's pods, if name is "pachd")
+	listWorkers(name string) ([]string, error)
+	// workerLogs returns the logs of a container of a worker
+	workerLogs(worker, container string, opts *workerLogOptions) (io.ReadCloser, error)
+	// serviceIP returns the IP address of the user service 'name', if it exists
+	serviceIP(name string) (string, error)
+	// servingWorkers returns the name of the worker set that pipelineInfo's
+	// user service sends traffic to, or "" if it has no user service
+	servingWorkers(pipelineInfo *pps.PipelineInfo) (string, error)
+	// routeService sends the traffic of pipelineInfo's user service to the
+	// workers of pipelineInfo's version
+	routeService(pipelineInfo *pps.PipelineInfo) error
+	// workersReady returns whether any worker in the worker set 'name' passes
+	// its readiness probe
+	workersReady(name string) (bool, error)
+	// abortRollout stops the workers of the worker set 'name', and marks them
+	// as a rollout that was rolled back
+	abortRollout(name string) error
+	// deleteWorkerSet deletes the worker set 'name', but not the other worker
+	// sets or the user service of its pipeline
+	deleteWorkerSet(name string) error
+}
+
+func newWorkerRuntime(a *apiServer) (workerRuntime, error) {
+	switch a.env.Config.PPSWorkerRuntime {
+	case kubeRuntimeName, "":
+		return &kubeRuntime{a: a}, nil
+	case localRuntimeName:
+		return newLocalRuntime(a), nil
+	default:
+		return nil, errors.Errorf("unknown worker runtime %q, must be %q or %q",
+			a.env.Config.PPSWorkerRuntime, kubeRuntimeName, localRuntimeName)
+	}
+}
@@ -339,6 +339,63 @@ func testSpout(t *testing.T, usePachctl bool) {
 		require.NoError(t, c.DeleteAll())
 	})
 
+	t.Run("SpoutMarker", func(t *testing.T) {
+		pipeline := tu.UniqueString("pipelinespoutmarker")
+		_, err := c.PpsAPIClient.CreatePipeline(
+			c.Ctx(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipeline),
+				Transform: &pps.Transform{
+					Cmd: []string{"/bin/sh"},
+					Stdin: []string{
+						// resume from the restored checkpoint, if there is one
+						"N=$(cat /pfs/offset 2>/dev/null || echo 0)",
+						"while [ : ]",
+						"do",
+						"sleep 1",
+						"N=$((N+1))",
+						"echo $N > offset",
+						"pachctl start transaction > /dev/null",
+						"pachctl start commit $PPS_PIPELINE_NAME@master",
+						"pachctl start commit $PPS_PIPELINE_NAME@marker",
+						"pachctl finish transaction",
+						"pachctl put file -a $PPS_PIPELINE_NAME@master:/$N -f offset",
+						"pachctl put file $PPS_PIPELINE_NAME@marker:/offset -f offset",
+						"pachctl start transaction > /dev/null",
+						"pachctl finish commit $PPS_PIPELINE_NAME@master",
+						"pachctl finish commit $PPS_PIPELINE_NAME@marker",
+						"pachctl finish transaction",
+						"done"},
+				},
+				Spout: &pps.Spout{Marker: "offset"},
+			})
+		require.NoError(t, err)
+
+		countBreakFunc := newCountBreakFunc(4)
+		require.NoError(t, c.SubscribeCommit(client.NewRepo(pipeline), "master", "", pfs.CommitState_FINISHED, func(ci *pfs.CommitInfo) error {
+			return countBreakFunc(func() error { return nil })
+		}))
+		require.NoError(t, c.StopPipeline(pipeline))
+		require.NoError(t, c.StartPipeline(pipeline))
+
+		// Each file written after the restart must continue from the last
+		// checkpoint, so no file is appended to twice and none are skipped.
+		countBreakFunc = newCountBreakFunc(8)
+		require.NoError(t, c.SubscribeCommit(client.NewRepo(pipeline), "master", "", pfs.CommitState_FINISHED, func(ci *pfs.CommitInfo) error {
+			return countBreakFunc(func() error { return nil })
+		}))
+		commitInfo, err := c.InspectCommit(pipeline, "master", "")
+		require.NoError(t, err)
+		files, err := c.ListFileAll(commitInfo.Commit, "")
+		require.NoError(t, err)
+		for i := 1; i <= len(files); i++ {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(commitInfo.Commit, fmt.Sprintf("/%d", i), &buf))
+			require.Equal(t, fmt.Sprintf("%d\n", i), buf.String())
+		}
+		require.NoError(t, c.DeleteAll())
+	})
+
 	t.Run("SpoutProvenance", func(t *testing.T) {
 		// create a pipeline
 		pipeline := tu.UniqueString("pipelinespoutprovenance")
@@ -0,0 +1,140 @@
+package common
+
+import (
+	"regexp"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// UserCodeError is returned when the user code exits with a non-zero exit code.
+type UserCodeError struct {
+	Err      error
+	ExitCode int
+	// Stderr is the tail of the user code's stderr. It is only captured when
+	// the pipeline's retry policy matches on stderr.
+	Stderr string
+}
+
+func (e *UserCodeError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *UserCodeError) Unwrap() error {
+	return e.Err
+}
+
+// RetryClassifier classifies datum failures as retryable or fatal based on a
+// pipeline's retry policy.
+type RetryClassifier struct {
+	retryableExitCodes, fatalExitCodes map[int64]struct{}
+	retryableStderr, fatalStderr       []*regexp.Regexp
+}
+
+// NewRetryClassifier creates a retry classifier for a retry policy. A nil
+// policy classifies all failures as retryable.
+func NewRetryClassifier(policy *pps.RetryPolicy) (*RetryClassifier, error) {
+	rc := &RetryClassifier{}
+	if policy == nil {
+		return rc, nil
+	}
+	rc.retryableExitCodes = exitCodeSet(policy.RetryableExitCodes)
+	rc.fatalExitCodes = exitCodeSet(policy.FatalExitCodes)
+	var err error
+	if rc.retryableStderr, err = compileRegexps(policy.RetryableStderr); err != nil {
+		return nil, errors.Wrapf(err, "invalid retryable stderr regex")
+	}
+	if rc.fatalStderr, err = compileRegexps(policy.FatalStderr); err != nil {
+		return nil, errors.Wrapf(err, "invalid fatal stderr regex")
+	}
+	return rc, nil
+}
+
+func exitCodeSet(exitCodes []int64) map[int64]struct{} {
+	set := make(map[int64]struct{})
+	for _, exitCode := range exitCodes {
+		set[exitCode] = struct{}{}
+	}
+	return set
+}
+
+func compileRegexps(exprs []string) ([]*regexp.Regexp, error) {
+	var res []*regexp.Regexp
+	for _, expr := range exprs {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+// Retryable returns true if a failed attempt at processing a datum should be
+// retried. Failures other than the user code exiting with a non-zero exit
+// code (such as timeouts) only match the policy if it has no retryable exit
+// codes or stderr regexes.
+func (rc *RetryClassifier) Retryable(err error) bool {
+	var exitCode *int64
+	var stderr *string
+	ucErr := &UserCodeError{}
+	if errors.As(err, &ucErr) {
+		code := int64(ucErr.ExitCode)
+		exitCode, stderr = &code, &ucErr.Stderr
+	}
+	if rc.matches(rc.fatalExitCodes, rc.fatalStderr, exitCode, stderr) {
+		return false
+	}
+	if len(rc.retryableExitCodes) == 0 && len(rc.retryableStderr) == 0 {
+		return true
+	}
+	return rc.matches(rc.retryableExitCodes, rc.retryableStderr, exitCode, stderr)
+}
+
+func (rc *RetryClassifier) matches(exitCodes map[int64]struct{}, res []*regexp.Regexp, exitCode *int64, stderr *string) bool {
+	if exitCode != nil {
+		if _, ok := exitCodes[*exitCode]; ok {
+			return true
+		}
+	}
+	if stderr != nil {
+		for _, re := range res {
+			if re.MatchString(*stderr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MatchesStderr returns true if the retry policy has stderr regexes, which
+// means the user code's stderr needs to be captured.
+func MatchesStderr(policy *pps.RetryPolicy) bool {
+	return policy != nil && (len(policy.RetryableStderr) > 0 || len(policy.FatalStderr) > 0)
+}
+
+// NewRetryBackOff creates the backoff between attempts at processing a datum
+// for a retry policy. It returns nil if the policy has no backoff.
+func NewRetryBackOff(policy *pps.RetryPolicy) (backoff.BackOff, error) {
+	if policy == nil || policy.InitialBackoff == nil {
+		return nil, nil
+	}
+	b := backoff.NewInfiniteBackOff()
+	b.Multiplier = 2
+	var err error
+	if b.InitialInterval, err = types.DurationFromProto(policy.InitialBackoff); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	b.MaxInterval = backoff.DefaultMaxInterval
+	if policy.MaxBackoff != nil {
+		if b.MaxInterval, err = types.DurationFromProto(policy.MaxBackoff); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	b.Reset()
+	return b, nil
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func userCodeError(exitCode int, stderr string) error {
+	return errors.Wrap(&UserCodeError{
+		Err:      errors.Errorf("exit status %d", exitCode),
+		ExitCode: exitCode,
+		Stderr:   stderr,
+	}, "errored running user code")
+}
+
+func TestRetryClassifier(t *testing.T) {
+	// Without a policy, all failures are retryable.
+	rc, err := NewRetryClassifier(nil)
+	require.NoError(t, err)
+	require.True(t, rc.Retryable(userCodeError(1, "")))
+	require.True(t, rc.Retryable(errors.New("context deadline exceeded")))
+	// Fatal failures are not retried.
+	rc, err = NewRetryClassifier(&pps.RetryPolicy{
+		FatalExitCodes: []int64{2},
+		FatalStderr:    []string{"invalid input"},
+	})
+	require.NoError(t, err)
+	require.True(t, rc.Retryable(userCodeError(1, "connection reset")))
+	require.False(t, rc.Retryable(userCodeError(2, "")))
+	require.False(t, rc.Retryable(userCodeError(1, "error: invalid input on line 3")))
+	// With retryable failures, only those are retried, and fatal failures
+	// take precedence.
+	rc, err = NewRetryClassifier(&pps.RetryPolicy{
+		RetryableExitCodes: []int64{75},
+		RetryableStderr:    []string{"(?i)rate limit"},
+		FatalStderr:        []string{"quota exhausted"},
+	})
+	require.NoError(t, err)
+	require.True(t, rc.Retryable(userCodeError(75, "")))
+	require.True(t, rc.Retryable(userCodeError(1, "429: Rate limit exceeded")))
+	require.False(t, rc.Retryable(userCodeError(75, "rate limit, quota exhausted")))
+	require.False(t, rc.Retryable(userCodeError(1, "")))
+	require.False(t, rc.Retryable(errors.New("context deadline exceeded")))
+	// Invalid regexes are rejected.
+	_, err = NewRetryClassifier(&pps.RetryPolicy{RetryableStderr: []string{"("}})
+	require.YesError(t, err)
+}
+
+func TestMatchesStderr(t *testing.T) {
+	require.False(t, MatchesStderr(nil))
+	require.False(t, MatchesStderr(&pps.RetryPolicy{RetryableExitCodes: []int64{1}}))
+	require.True(t, MatchesStderr(&pps.RetryPolicy{FatalStderr: []string{"invalid input"}}))
+}
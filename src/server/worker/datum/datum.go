@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	io "io"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
@@ -16,12 +17,15 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
@@ -34,6 +38,9 @@ const (
 	MetaPrefix = "meta"
 	// MetaFileName is the name of the meta file.
 	MetaFileName = "meta"
+	// LogFileName is the name of the file that a datum's logs are persisted to
+	// in the meta commit.
+	LogFileName = "logs"
 	// PFSPrefix is the prefix for the pfs path.
 	PFSPrefix = "pfs"
 	// OutputPrefix is the prefix for the output path.
@@ -144,6 +151,13 @@ func WithSet(cacheClient *pfssync.CacheClient, storageRoot string, cb func(*Set)
 	return cb(s)
 }
 
+func (s *Set) ctx() context.Context {
+	if s.cacheClient == nil {
+		return context.Background()
+	}
+	return s.cacheClient.Ctx()
+}
+
 // UploadMeta uploads the meta file for a datum.
 func (s *Set) UploadMeta(meta *Meta, opts ...Option) error {
 	d := newDatum(s, meta, opts...)
@@ -154,12 +168,26 @@ func (s *Set) UploadMeta(meta *Meta, opts ...Option) error {
 // TODO: Handle datum concurrency here, and potentially move symlinking here.
 func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error {
 	d := newDatum(s, meta, opts...)
-
+	d.meta.Attempts = nil
+	if d.backOff != nil {
+		d.backOff.Reset()
+	}
 	var err error
 	for i := 0; i <= d.numRetries; i++ {
+		if i > 0 && d.backOff != nil {
+			if err := d.wait(); err != nil {
+				return err
+			}
+		}
+		var span opentracing.Span
+		span, d.ctx = tracing.StartSpan(s.ctx(), "/worker/Datum", "datum", d.ID, "attempt", i)
+		var retry bool
 		err = d.withData(func() (retErr error) {
+			start := time.Now()
 			defer func() {
-				if retErr == nil || i == d.numRetries {
+				retry = retErr != nil && i < d.numRetries && d.isRetryable(retErr)
+				d.recordAttempt(start, retErr)
+				if !retry {
 					retErr = d.finish(retErr)
 				}
 				duration := time.Duration(d.meta.Stats.ProcessTime.GetNanos()) + time.Duration(d.meta.Stats.ProcessTime.GetSeconds())*time.Second
@@ -170,8 +198,9 @@ func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error
 			}()
 			return cb(d)
 		})
-		if err == nil {
-			return nil
+		tracing.FinishAnySpan(span, "err", err)
+		if !retry {
+			return err
 		}
 	}
 	return err
@@ -184,9 +213,13 @@ type Datum struct {
 	meta             *Meta
 	storageRoot      string
 	numRetries       int
+	backOff          backoff.BackOff
+	retryable        func(error) bool
 	recoveryCallback func(context.Context) error
 	timeout          time.Duration
 	IDPrefix         string
+	// ctx carries the span for the current attempt at processing the datum.
+	ctx context.Context
 }
 
 func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
@@ -197,6 +230,7 @@ func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
 		ID:          ID,
 		storageRoot: path.Join(set.storageRoot, ID),
 		numRetries:  defaultNumRetries,
+		ctx:         set.ctx(),
 	}
 	d.meta.Stats = &pps.ProcessStats{}
 	for _, opt := range opts {
@@ -205,6 +239,47 @@ func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
 	return d
 }
 
+func (d *Datum) isRetryable(err error) bool {
+	return d.retryable == nil || d.retryable(err)
+}
+
+// recordAttempt records an attempt at processing the datum in the datum's meta.
+func (d *Datum) recordAttempt(start time.Time, err error) {
+	attempt := &pps.DatumAttempt{
+		Duration: types.DurationProto(time.Since(start)),
+	}
+	attempt.Started, _ = types.TimestampProto(start)
+	if err != nil {
+		attempt.Error = err.Error()
+		attempt.Retryable = d.isRetryable(err)
+		ucErr := &common.UserCodeError{}
+		if errors.As(err, &ucErr) {
+			attempt.ExitCode = int64(ucErr.ExitCode)
+		}
+	}
+	d.meta.Attempts = append(d.meta.Attempts, attempt)
+}
+
+// wait waits for the backoff before the next attempt at processing the datum.
+func (d *Datum) wait() error {
+	next := d.backOff.NextBackOff()
+	if next == backoff.Stop {
+		return nil
+	}
+	select {
+	case <-time.After(next):
+		return nil
+	case <-d.set.ctx().Done():
+		return errors.EnsureStack(d.set.ctx().Err())
+	}
+}
+
+// Stats returns the datum's process stats. The resources used by each attempt
+// at processing the datum are added to them.
+func (d *Datum) Stats() *pps.ProcessStats {
+	return d.meta.Stats
+}
+
 // PFSStorageRoot returns the pfs storage root.
 func (d *Datum) PFSStorageRoot() string {
 	return path.Join(d.storageRoot, PFSPrefix, d.ID)
@@ -261,9 +336,11 @@ func (d *Datum) withData(cb func() error) (retErr error) {
 	})
 }
 
-func (d *Datum) downloadData(downloader pfssync.Downloader) error {
+func (d *Datum) downloadData(downloader pfssync.Downloader) (retErr error) {
+	span, _ := tracing.AddSpanToAnyExisting(d.ctx, "/worker/Datum/Download")
 	start := time.Now()
 	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr, "bytes", d.meta.Stats.DownloadBytes)
 		duration := time.Since(start)
 		d.meta.Stats.DownloadTime = types.DurationProto(duration)
 		labels := workerStats.JobLabels(d.meta.Job)
@@ -298,11 +375,40 @@ func (d *Datum) downloadData(downloader pfssync.Downloader) error {
 	return nil
 }
 
-// Run provides a scoped environment for the processing of a datum.
-func (d *Datum) Run(ctx context.Context, cb func(ctx context.Context) error) error {
+// WithLogFile provides a writer for the datum's logs, which are uploaded to the
+// meta commit along with the datum's meta file. Logs from each attempt at
+// processing the datum are appended to the same file.
+func (d *Datum) WithLogFile(cb func(io.Writer) error) (retErr error) {
+	if d.set.metaOutputClient == nil {
+		return cb(ioutil.Discard)
+	}
+	if err := os.MkdirAll(d.MetaStorageRoot(), 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	f, err := os.OpenFile(path.Join(d.MetaStorageRoot(), LogFileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0666)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := f.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	return cb(f)
+}
+
+// Run provides a scoped environment for the processing of a datum. The context
+// passed to cb carries a span for processing the datum (if the datum is being
+// traced), which is a child of the span for the datum.
+func (d *Datum) Run(ctx context.Context, cb func(ctx context.Context) error) (retErr error) {
+	if parent := opentracing.SpanFromContext(d.ctx); parent != nil {
+		ctx = opentracing.ContextWithSpan(ctx, parent)
+	}
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/worker/Datum/Process")
 	start := time.Now()
 	defer func() {
 		d.meta.Stats.ProcessTime = types.DurationProto(time.Since(start))
+		tracing.FinishAnySpan(span, "err", retErr)
 	}()
 	if d.timeout > 0 {
 		timeoutCtx, cancel := context.WithTimeout(ctx, d.timeout)
@@ -356,7 +462,11 @@ func (d *Datum) uploadMetaFile(mf client.ModifyFile) error {
 	return mf.PutFile(fullPath, buf, client.WithAppendPutFile(), client.WithDatumPutFile(d.ID))
 }
 
-func (d *Datum) uploadOutput() error {
+func (d *Datum) uploadOutput() (retErr error) {
+	span, _ := tracing.AddSpanToAnyExisting(d.ctx, "/worker/Datum/Upload")
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr, "bytes", d.meta.Stats.UploadBytes)
+	}()
 	if d.set.pfsOutputClient != nil {
 		start := time.Now()
 		d.meta.Stats.UploadBytes = 0
@@ -54,16 +54,17 @@ func (State) EnumDescriptor() ([]byte, []int) {
 }
 
 type Meta struct {
-	Job                  *pps.Job          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	Inputs               []*common.Input   `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	Hash                 string            `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
-	State                State             `protobuf:"varint,4,opt,name=state,proto3,enum=datum.State" json:"state,omitempty"`
-	Reason               string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	Stats                *pps.ProcessStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
-	Index                int64             `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Job                  *pps.Job            `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Inputs               []*common.Input     `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Hash                 string              `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	State                State               `protobuf:"varint,4,opt,name=state,proto3,enum=datum.State" json:"state,omitempty"`
+	Reason               string              `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Stats                *pps.ProcessStats   `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	Index                int64               `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
+	Attempts             []*pps.DatumAttempt `protobuf:"bytes,8,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Meta) Reset()         { *m = Meta{} }
@@ -148,6 +149,13 @@ func (m *Meta) GetIndex() int64 {
 	return 0
 }
 
+func (m *Meta) GetAttempts() []*pps.DatumAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
+}
+
 type Stats struct {
 	ProcessStats         *pps.ProcessStats `protobuf:"bytes,1,opt,name=process_stats,json=processStats,proto3" json:"process_stats,omitempty"`
 	Processed            int64             `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
@@ -244,35 +252,36 @@ func init() {
 func init() { proto.RegisterFile("server/worker/datum/datum.proto", fileDescriptor_96ec7427544ac634) }
 
 var fileDescriptor_96ec7427544ac634 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6b, 0xdb, 0x30,
-	0x14, 0x9e, 0xe2, 0xd8, 0xad, 0x95, 0x64, 0x04, 0x11, 0x86, 0x28, 0x5b, 0xea, 0x05, 0x06, 0x5e,
-	0x0f, 0x36, 0xf3, 0x4e, 0x3d, 0xae, 0x8d, 0x3b, 0x32, 0x36, 0x5a, 0x14, 0xd8, 0x61, 0x97, 0xe0,
-	0x58, 0x6f, 0x89, 0xd7, 0x25, 0x12, 0x92, 0x93, 0x6d, 0xff, 0x70, 0xc7, 0xdd, 0x07, 0x65, 0xf8,
-	0x97, 0x0c, 0x49, 0x2e, 0xed, 0x60, 0xf4, 0x62, 0xbf, 0xef, 0xfb, 0xde, 0xfb, 0x78, 0x1f, 0x7a,
-	0xf8, 0x58, 0x83, 0xda, 0x83, 0x4a, 0xbf, 0x09, 0x75, 0x0d, 0x2a, 0xe5, 0x45, 0xbd, 0xdb, 0xb8,
-	0x6f, 0x22, 0x95, 0xa8, 0x05, 0xf1, 0x2d, 0x38, 0x1a, 0xad, 0xc4, 0x4a, 0x58, 0x26, 0x35, 0x95,
-	0x13, 0x8f, 0x06, 0x52, 0xea, 0x54, 0x4a, 0xdd, 0xc2, 0xe7, 0xff, 0x9a, 0x95, 0x62, 0xb3, 0x11,
-	0xdb, 0xf6, 0xe7, 0x5a, 0x26, 0x37, 0x08, 0x77, 0x3f, 0x40, 0x5d, 0x90, 0x67, 0xd8, 0xfb, 0x22,
-	0x96, 0x14, 0x45, 0x28, 0xee, 0x65, 0xbd, 0x44, 0x4a, 0xbd, 0xd8, 0x67, 0xc9, 0x3b, 0xb1, 0x64,
-	0x86, 0x27, 0x2f, 0x70, 0x50, 0x6d, 0xe5, 0xae, 0xd6, 0xb4, 0x13, 0x79, 0x71, 0x2f, 0x1b, 0x24,
-	0xad, 0xcd, 0xcc, 0xb0, 0xac, 0x15, 0x09, 0xc1, 0xdd, 0x75, 0xa1, 0xd7, 0xd4, 0x8b, 0x50, 0x1c,
-	0x32, 0x5b, 0x93, 0x09, 0xf6, 0x75, 0x5d, 0xd4, 0x40, 0xbb, 0x11, 0x8a, 0x1f, 0x67, 0xfd, 0xc4,
-	0xc5, 0x99, 0x1b, 0x8e, 0x39, 0x89, 0x3c, 0xc1, 0x81, 0x82, 0x42, 0x8b, 0x2d, 0xf5, 0xed, 0x64,
-	0x8b, 0xc8, 0x89, 0x9b, 0xd5, 0x34, 0xb0, 0x7b, 0x8d, 0x6e, 0xf7, 0xba, 0x52, 0xa2, 0x04, 0xad,
-	0x8d, 0x87, 0x76, 0x1e, 0x9a, 0x8c, 0xb0, 0x5f, 0x6d, 0x39, 0x7c, 0xa7, 0x07, 0x11, 0x8a, 0x3d,
-	0xe6, 0xc0, 0xe4, 0x37, 0xc2, 0xbe, 0x6d, 0x23, 0xa7, 0x78, 0x20, 0xdd, 0xd8, 0xc2, 0x79, 0xa2,
-	0x07, 0x3c, 0xfb, 0xf2, 0x1e, 0x22, 0x4f, 0x71, 0xd8, 0x62, 0xe0, 0xb4, 0x63, 0xed, 0xef, 0x08,
-	0x42, 0xf1, 0x81, 0xbe, 0xae, 0xa4, 0x04, 0x6e, 0x73, 0x7b, 0xec, 0x16, 0x9a, 0x58, 0x9f, 0x8b,
-	0xea, 0x2b, 0x70, 0x9b, 0xdd, 0x63, 0x2d, 0x32, 0x7e, 0x0a, 0x4a, 0xb1, 0x07, 0x05, 0xdc, 0x26,
-	0xf6, 0xd8, 0x1d, 0x41, 0x5e, 0xe2, 0xd0, 0xf5, 0x2d, 0x2a, 0x6e, 0x83, 0x87, 0x67, 0xfd, 0xe6,
-	0xe6, 0xf8, 0xf0, 0xc2, 0x92, 0xb3, 0x29, 0x3b, 0x74, 0xf2, 0x8c, 0x9f, 0xbc, 0x72, 0xe1, 0x80,
-	0x0c, 0x70, 0x78, 0xc5, 0x2e, 0xcf, 0xf3, 0xf9, 0x3c, 0x9f, 0x0e, 0x1f, 0x11, 0x8c, 0x83, 0x8b,
-	0x37, 0xb3, 0xf7, 0xf9, 0x74, 0x88, 0x8c, 0xc4, 0xf2, 0xf3, 0xcb, 0x8f, 0x39, 0xcb, 0xa7, 0xc3,
-	0xce, 0xd9, 0xdb, 0x9f, 0xcd, 0x18, 0xfd, 0x6a, 0xc6, 0xe8, 0x4f, 0x33, 0x46, 0x9f, 0x4e, 0x57,
-	0x55, 0xbd, 0xde, 0x2d, 0xcd, 0x6b, 0xa6, 0xb2, 0x28, 0xd7, 0x3f, 0x38, 0xa8, 0xfb, 0xd5, 0x3e,
-	0x4b, 0xb5, 0x2a, 0xd3, 0xff, 0x5c, 0xe5, 0x32, 0xb0, 0x17, 0xf4, 0xfa, 0x6f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x91, 0xcf, 0x21, 0xf4, 0xb3, 0x02, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xc5, 0x4d, 0x93, 0x35, 0x6e, 0x8b, 0x2a, 0xab, 0x42, 0xd6, 0x04, 0x5d, 0xa8, 0x84, 0x14,
+	0x76, 0x48, 0x20, 0x9c, 0x76, 0xdc, 0x96, 0x0c, 0x15, 0x81, 0x36, 0xb9, 0x12, 0x07, 0x2e, 0x55,
+	0x1a, 0x9b, 0x36, 0x8c, 0xd6, 0x96, 0xed, 0x16, 0xf8, 0x17, 0xfc, 0x2c, 0x8e, 0xdc, 0x91, 0x10,
+	0xea, 0x2f, 0x41, 0xb6, 0x33, 0x5a, 0x24, 0xc4, 0x25, 0xf1, 0x7b, 0xef, 0xf3, 0xcb, 0xf3, 0x8b,
+	0xe1, 0x89, 0x62, 0x72, 0xcb, 0x64, 0xfa, 0x89, 0xcb, 0x5b, 0x26, 0x53, 0x5a, 0xea, 0xcd, 0xca,
+	0x3d, 0x13, 0x21, 0xb9, 0xe6, 0xc8, 0xb7, 0xe0, 0x78, 0xb8, 0xe0, 0x0b, 0x6e, 0x99, 0xd4, 0xac,
+	0x9c, 0x78, 0xdc, 0x17, 0x42, 0xa5, 0x42, 0xa8, 0x06, 0x3e, 0xfe, 0xdb, 0xac, 0xe2, 0xab, 0x15,
+	0x5f, 0x37, 0x2f, 0x37, 0x32, 0xfe, 0xda, 0x82, 0xed, 0x37, 0x4c, 0x97, 0xe8, 0x11, 0xf4, 0x3e,
+	0xf0, 0x39, 0x06, 0x11, 0x88, 0xbb, 0x59, 0x37, 0x11, 0x42, 0xcd, 0xb6, 0x59, 0xf2, 0x8a, 0xcf,
+	0x89, 0xe1, 0xd1, 0x13, 0x18, 0xd4, 0x6b, 0xb1, 0xd1, 0x0a, 0xb7, 0x22, 0x2f, 0xee, 0x66, 0xfd,
+	0xa4, 0xb1, 0x99, 0x18, 0x96, 0x34, 0x22, 0x42, 0xb0, 0xbd, 0x2c, 0xd5, 0x12, 0x7b, 0x11, 0x88,
+	0x43, 0x62, 0xd7, 0x68, 0x0c, 0x7d, 0xa5, 0x4b, 0xcd, 0x70, 0x3b, 0x02, 0xf1, 0xfd, 0xac, 0x97,
+	0xb8, 0xe3, 0x4c, 0x0d, 0x47, 0x9c, 0x84, 0x1e, 0xc0, 0x40, 0xb2, 0x52, 0xf1, 0x35, 0xf6, 0xed,
+	0xce, 0x06, 0xa1, 0x53, 0xb7, 0x57, 0xe1, 0xc0, 0xe6, 0x1a, 0xde, 0xe5, 0xba, 0x91, 0xbc, 0x62,
+	0x4a, 0x19, 0x0f, 0xe5, 0x3c, 0x14, 0x1a, 0x42, 0xbf, 0x5e, 0x53, 0xf6, 0x19, 0x1f, 0x45, 0x20,
+	0xf6, 0x88, 0x03, 0xe8, 0x19, 0xec, 0x94, 0x5a, 0xb3, 0x95, 0xd0, 0x0a, 0x77, 0x22, 0xef, 0xd0,
+	0x24, 0x37, 0x39, 0xce, 0x9d, 0x48, 0xfe, 0x4c, 0x8d, 0x7f, 0x00, 0xe8, 0x5b, 0x63, 0x74, 0x06,
+	0xfb, 0xc2, 0x7d, 0x68, 0xe6, 0x52, 0x80, 0xff, 0xa4, 0xe8, 0x89, 0x03, 0x84, 0x1e, 0xc2, 0xb0,
+	0xc1, 0x8c, 0xe2, 0x96, 0x0d, 0xb4, 0x27, 0x10, 0x86, 0x47, 0xea, 0xb6, 0x16, 0x82, 0x51, 0xdb,
+	0x94, 0x47, 0xee, 0xa0, 0x29, 0xe2, 0x7d, 0x59, 0x7f, 0x64, 0xd4, 0xb6, 0xe5, 0x91, 0x06, 0x19,
+	0x3f, 0xc9, 0x2a, 0xbe, 0x65, 0x92, 0x51, 0xdb, 0x91, 0x47, 0xf6, 0x04, 0x7a, 0x0a, 0x43, 0x37,
+	0x37, 0xab, 0xa9, 0xad, 0x2a, 0xbc, 0xe8, 0xed, 0x7e, 0x9e, 0x74, 0xae, 0x2c, 0x39, 0xc9, 0x49,
+	0xc7, 0xc9, 0x13, 0x7a, 0xfa, 0xdc, 0x1d, 0x8e, 0xa1, 0x3e, 0x0c, 0x6f, 0xc8, 0xf5, 0x65, 0x31,
+	0x9d, 0x16, 0xf9, 0xe0, 0x1e, 0x82, 0x30, 0xb8, 0x3a, 0x9f, 0xbc, 0x2e, 0xf2, 0x01, 0x30, 0x12,
+	0x29, 0x2e, 0xaf, 0xdf, 0x16, 0xa4, 0xc8, 0x07, 0xad, 0x8b, 0x97, 0xdf, 0x76, 0x23, 0xf0, 0x7d,
+	0x37, 0x02, 0xbf, 0x76, 0x23, 0xf0, 0xee, 0x6c, 0x51, 0xeb, 0xe5, 0x66, 0x6e, 0xfe, 0x7f, 0x2a,
+	0xca, 0x6a, 0xf9, 0x85, 0x32, 0x79, 0xb8, 0xda, 0x66, 0xa9, 0x92, 0x55, 0xfa, 0x8f, 0x7b, 0x3c,
+	0x0f, 0xec, 0x9d, 0x7b, 0xf1, 0x7b, 0x00, 0x4b, 0x80, 0x82, 0x74, 0xe5, 0x02, 0x00, 0x00,
 }
 
 func (m *Meta) Marshal() (dAtA []byte, err error) {
@@ -299,6 +308,20 @@ func (m *Meta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Attempts) > 0 {
+		for iNdEx := len(m.Attempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDatum(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Index != 0 {
 		i = encodeVarintDatum(dAtA, i, uint64(m.Index))
 		i--
@@ -475,6 +498,12 @@ func (m *Meta) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovDatum(uint64(m.Index))
 	}
+	if len(m.Attempts) > 0 {
+		for _, e := range m.Attempts {
+			l = e.Size()
+			n += 1 + l + sovDatum(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -756,6 +785,40 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDatum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attempts = append(m.Attempts, &pps.DatumAttempt{})
+			if err := m.Attempts[len(m.Attempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDatum(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 )
 
 // SetOption configures a set.
@@ -42,6 +43,16 @@ func WithRetry(numRetries int) Option {
 	}
 }
 
+// WithRetryPolicy sets the backoff between attempts and the function that
+// determines whether a failed attempt is retried. A nil backoff retries
+// immediately, and a nil retryable function retries all failures.
+func WithRetryPolicy(b backoff.BackOff, retryable func(error) bool) Option {
+	return func(d *Datum) {
+		d.backOff = b
+		d.retryable = retryable
+	}
+}
+
 // WithRecoveryCallback sets the recovery callback.
 func WithRecoveryCallback(cb func(context.Context) error) Option {
 	return func(d *Datum) {
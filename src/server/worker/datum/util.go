@@ -36,6 +36,11 @@ func MergeProcessStats(x, y *pps.ProcessStats) error {
 	}
 	x.DownloadBytes += y.DownloadBytes
 	x.UploadBytes += y.UploadBytes
+	x.CpuSeconds += y.CpuSeconds
+	if y.PeakMemoryBytes > x.PeakMemoryBytes {
+		x.PeakMemoryBytes = y.PeakMemoryBytes
+	}
+	x.GpuSeconds += y.GpuSeconds
 	return nil
 }
 
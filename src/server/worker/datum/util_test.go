@@ -0,0 +1,34 @@
+package datum
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestMergeProcessStats(t *testing.T) {
+	x := &pps.ProcessStats{
+		ProcessTime:     types.DurationProto(time.Second),
+		CpuSeconds:      1.5,
+		PeakMemoryBytes: 100,
+		GpuSeconds:      2,
+	}
+	y := &pps.ProcessStats{
+		ProcessTime:     types.DurationProto(2 * time.Second),
+		CpuSeconds:      0.5,
+		PeakMemoryBytes: 300,
+		GpuSeconds:      4,
+	}
+	require.NoError(t, MergeProcessStats(x, y))
+	processTime, err := types.DurationFromProto(x.ProcessTime)
+	require.NoError(t, err)
+	require.Equal(t, 3*time.Second, processTime)
+	// CPU and GPU time add up, while peak memory is the peak of either.
+	require.Equal(t, 2.0, x.CpuSeconds)
+	require.Equal(t, int64(300), x.PeakMemoryBytes)
+	require.Equal(t, 6.0, x.GpuSeconds)
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
@@ -82,10 +84,13 @@ type Driver interface {
 	WithActiveData([]*common.Input, string, func() error) error
 
 	// UserCodeEnv returns the set of environment variables to construct when
-	// launching the configured user process.
-	UserCodeEnv(string, *pfs.Commit, []*common.Input) []string
+	// launching the configured user process. The trace context of any span in
+	// the given context is included, so that user code can continue the trace.
+	UserCodeEnv(context.Context, string, *pfs.Commit, []*common.Input) []string
 
-	RunUserCode(context.Context, logs.TaggedLogger, []string) error
+	// RunUserCode runs the pipeline's user code. If stats is non-nil, the
+	// resources that the user code used are added to it.
+	RunUserCode(context.Context, logs.TaggedLogger, []string, *pps.ProcessStats) error
 
 	RunUserErrorHandlingCode(context.Context, logs.TaggedLogger, []string) error
 
@@ -266,10 +271,16 @@ func (d *driver) Pipelines() col.PostgresCollection {
 }
 
 func (d *driver) NewTaskWorker() *work.Worker {
+	if d.env.Config().WorkBackend == work.PostgresBackend {
+		return work.NewPostgresWorker(d.env.GetDBClient(), d.env.GetPostgresListener(), WorkNamespace(d.pipelineInfo))
+	}
 	return work.NewWorker(d.env.GetEtcdClient(), d.env.Config().PPSEtcdPrefix, WorkNamespace(d.pipelineInfo))
 }
 
 func (d *driver) NewTaskQueue() (*work.TaskQueue, error) {
+	if d.env.Config().WorkBackend == work.PostgresBackend {
+		return work.NewPostgresTaskQueue(d.ctx, d.env.GetDBClient(), d.env.GetPostgresListener(), WorkNamespace(d.pipelineInfo))
+	}
 	return work.NewTaskQueue(d.ctx, d.env.GetEtcdClient(), d.env.Config().PPSEtcdPrefix, WorkNamespace(d.pipelineInfo))
 }
 
@@ -309,6 +320,7 @@ func (d *driver) RunUserCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
 	environ []string,
+	stats *pps.ProcessStats,
 ) (retErr error) {
 	logger.Logf("beginning to run user code")
 	defer func(start time.Time) {
@@ -323,12 +335,22 @@ func (d *driver) RunUserCode(
 	}
 
 	// Run user code
-	cmd := exec.CommandContext(ctx, d.pipelineInfo.Details.Transform.Cmd[0], d.pipelineInfo.Details.Transform.Cmd[1:]...)
+	cmdline, environ, err := d.sandbox(d.pipelineInfo.Details.Transform.Cmd, environ)
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, cmdline[0], cmdline[1:]...)
 	if d.pipelineInfo.Details.Transform.Stdin != nil {
 		cmd.Stdin = strings.NewReader(strings.Join(d.pipelineInfo.Details.Transform.Stdin, "\n") + "\n")
 	}
 	cmd.Stdout = logger.WithUserCode()
 	cmd.Stderr = logger.WithUserCode()
+	// Capture the tail of stderr if the retry policy needs to match on it.
+	var stderr *tailBuffer
+	if common.MatchesStderr(d.pipelineInfo.Details.RetryPolicy) {
+		stderr = newTailBuffer(stderrTailSize)
+		cmd.Stderr = io.MultiWriter(cmd.Stderr, stderr)
+	}
 	cmd.Env = environ
 	if d.uid != nil && d.gid != nil {
 		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
@@ -339,8 +361,8 @@ func (d *driver) RunUserCode(
 	if d.pipelineInfo.Details.Transform.WorkingDir != "" || d.rootDir != "/" {
 		cmd.Dir = filepath.Join(d.rootDir, d.pipelineInfo.Details.Transform.WorkingDir)
 	}
-	err := cmd.Start()
-	if err != nil {
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
 		return errors.EnsureStack(err)
 	}
 	// A context with a deadline will successfully cancel/kill
@@ -349,6 +371,9 @@ func (d *driver) RunUserCode(
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
+	if stats != nil {
+		d.addUsage(stats, state, time.Since(start))
+	}
 	if common.IsDone(ctx) {
 		if err = ctx.Err(); err != nil {
 			return errors.EnsureStack(err)
@@ -375,6 +400,14 @@ func (d *driver) RunUserCode(
 						return nil
 					}
 				}
+				ucErr := &common.UserCodeError{
+					Err:      errors.EnsureStack(err),
+					ExitCode: status.ExitStatus(),
+				}
+				if stderr != nil {
+					ucErr.Stderr = stderr.String()
+				}
+				return ucErr
 			}
 		}
 		return errors.EnsureStack(err)
@@ -382,6 +415,72 @@ func (d *driver) RunUserCode(
 	return nil
 }
 
+// sandbox returns the command line and environment that run the user code
+// 'cmdline' in the pipeline's sandbox, if it has one
+func (d *driver) sandbox(cmdline, environ []string) ([]string, []string, error) {
+	sandbox := d.pipelineInfo.Details.Transform.Sandbox
+	if sandbox == nil {
+		return cmdline, environ, nil
+	}
+	// The user code could read the worker's credentials from /proc if it ran
+	// as the same user as the worker, or as root.
+	if !sandbox.PassCredentials && (d.uid == nil || *d.uid == 0 || int(*d.uid) == os.Getuid()) {
+		return nil, nil, errors.New("sandboxed user code must run as a user other than root and the worker's user")
+	}
+	sandboxed, err := sandboxCmd(sandbox, cmdline)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not sandbox user code")
+	}
+	return sandboxed, sandboxEnv(sandbox, environ), nil
+}
+
+// addUsage adds the resources used by the user code, which ran for 'elapsed'
+// and exited with 'state', to stats. CPU time and peak memory come from the
+// process's rusage, which includes the descendants that it waited for.
+func (d *driver) addUsage(stats *pps.ProcessStats, state *os.ProcessState, elapsed time.Duration) {
+	stats.CpuSeconds += (state.UserTime() + state.SystemTime()).Seconds()
+	if peak := peakMemory(state); peak > stats.PeakMemoryBytes {
+		stats.PeakMemoryBytes = peak
+	}
+	stats.GpuSeconds += float64(d.gpus()) * elapsed.Seconds()
+}
+
+// gpus returns the number of GPUs allocated to each of the pipeline's workers
+func (d *driver) gpus() int64 {
+	for _, spec := range []*pps.ResourceSpec{d.pipelineInfo.Details.ResourceLimits, d.pipelineInfo.Details.ResourceRequests} {
+		if spec != nil && spec.Gpu != nil && spec.Gpu.Number > 0 {
+			return spec.Gpu.Number
+		}
+	}
+	return 0
+}
+
+// stderrTailSize is the amount of the user code's stderr that is captured for
+// matching against the retry policy.
+const stderrTailSize = 64 * 1024
+
+// tailBuffer is a writer that keeps the last size bytes written to it.
+type tailBuffer struct {
+	size int
+	buf  []byte
+}
+
+func newTailBuffer(size int) *tailBuffer {
+	return &tailBuffer{size: size}
+}
+
+func (tb *tailBuffer) Write(data []byte) (int, error) {
+	tb.buf = append(tb.buf, data...)
+	if len(tb.buf) > tb.size {
+		tb.buf = append(tb.buf[:0], tb.buf[len(tb.buf)-tb.size:]...)
+	}
+	return len(data), nil
+}
+
+func (tb *tailBuffer) String() string {
+	return string(tb.buf)
+}
+
 func (d *driver) RunUserErrorHandlingCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
@@ -396,7 +495,11 @@ func (d *driver) RunUserErrorHandlingCode(
 		}
 	}(time.Now())
 
-	cmd := exec.CommandContext(ctx, d.pipelineInfo.Details.Transform.ErrCmd[0], d.pipelineInfo.Details.Transform.ErrCmd[1:]...)
+	cmdline, environ, err := d.sandbox(d.pipelineInfo.Details.Transform.ErrCmd, environ)
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, cmdline[0], cmdline[1:]...)
 	if d.pipelineInfo.Details.Transform.ErrStdin != nil {
 		cmd.Stdin = strings.NewReader(strings.Join(d.pipelineInfo.Details.Transform.ErrStdin, "\n") + "\n")
 	}
@@ -407,8 +510,7 @@ func (d *driver) RunUserErrorHandlingCode(
 		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
 	}
 	cmd.Dir = d.pipelineInfo.Details.Transform.WorkingDir
-	err := cmd.Start()
-	if err != nil {
+	if err := cmd.Start(); err != nil {
 		return errors.EnsureStack(err)
 	}
 	// A context w a deadline will successfully cancel/kill
@@ -483,6 +585,7 @@ func (d *driver) unlinkData(inputs []*common.Input) error {
 }
 
 func (d *driver) UserCodeEnv(
+	ctx context.Context,
 	jobID string,
 	outputCommit *pfs.Commit,
 	inputs []*common.Input,
@@ -521,5 +624,7 @@ func (d *driver) UserCodeEnv(
 		result = append(result, fmt.Sprintf("%s=%s", client.OutputCommitIDEnv, outputCommit.ID))
 	}
 
+	result = append(result, tracing.EnvVars(ctx)...)
+
 	return result
 }
@@ -5,6 +5,7 @@ package driver
 import (
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"syscall"
 
@@ -22,6 +23,20 @@ func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 	}
 }
 
+// peakMemory returns the peak resident set size of a finished process, in
+// bytes
+func peakMemory(state *os.ProcessState) int64 {
+	rusage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok {
+		return 0
+	}
+	if runtime.GOOS == "darwin" {
+		return int64(rusage.Maxrss)
+	}
+	// Linux reports maxrss in kilobytes
+	return int64(rusage.Maxrss) * 1024
+}
+
 // WithActiveData is implemented differently in unix vs windows because of how
 // symlinks work on windows. Here, we create symlinks to the scratch space
 // directory, then clean up before returning.
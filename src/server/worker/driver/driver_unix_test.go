@@ -0,0 +1,36 @@
+// +build !windows
+
+package driver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+)
+
+func TestRunUserCodeUsage(t *testing.T) {
+	d := &driver{
+		pipelineInfo: &pps.PipelineInfo{
+			Details: &pps.PipelineInfo_Details{
+				Transform: &pps.Transform{
+					Cmd: []string{"sh", "-c", "i=0; while [ $i -lt 200000 ]; do i=$((i+1)); done"},
+				},
+			},
+		},
+		rootDir: t.TempDir(),
+	}
+	stats := &pps.ProcessStats{}
+	require.NoError(t, d.RunUserCode(context.Background(), logs.NewMockLogger(), nil, stats))
+	require.True(t, stats.CpuSeconds > 0, "cpu seconds: %v", stats.CpuSeconds)
+	require.True(t, stats.PeakMemoryBytes > 0, "peak memory bytes: %v", stats.PeakMemoryBytes)
+	// The pipeline has no GPUs
+	require.Equal(t, float64(0), stats.GpuSeconds)
+
+	// CPU time accumulates across runs
+	cpuSeconds := stats.CpuSeconds
+	require.NoError(t, d.RunUserCode(context.Background(), logs.NewMockLogger(), nil, stats))
+	require.True(t, stats.CpuSeconds > cpuSeconds, "cpu seconds: %v", stats.CpuSeconds)
+}
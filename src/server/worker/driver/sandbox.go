@@ -0,0 +1,119 @@
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// sandboxArg is the argument that the worker binary is re-executed with to
+	// run user code in a sandbox. It's followed by the sandbox spec, and then
+	// the user code's command.
+	sandboxArg = "pach-sandbox-exec"
+	// DefaultSeccompProfile is the name of the seccomp profile that blocks
+	// system calls that administer the kernel or inspect other processes
+	DefaultSeccompProfile = "default"
+)
+
+// credentialEnvVars are the variables in the worker's environment that grant
+// access to pachd or its database, or locate them. Sandboxed user code doesn't
+// see them unless the sandbox passes credentials. Removing them from the user
+// code's environment only isolates the credentials if the user code runs as a
+// different user than the worker, since otherwise it could read the worker's
+// environment from /proc/<pid>/environ.
+var credentialEnvVars = []string{
+	"PACH_CONFIG",
+	"ETCD_SERVICE_HOST",
+	"ETCD_SERVICE_PORT",
+	"PEER_PORT",
+	"POSTGRES_USER",
+	"POSTGRES_PASSWORD",
+	"POSTGRES_DATABASE",
+	"POSTGRES_HOST",
+	"POSTGRES_PORT",
+	"PG_BOUNCER_HOST",
+	"PG_BOUNCER_PORT",
+}
+
+// ValidateSandbox returns an error if sandbox can't be applied to user code
+// that runs as 'user' (the transform's user)
+func ValidateSandbox(sandbox *pps.Sandbox, user string) error {
+	if sandbox.CpuSeconds < 0 || sandbox.OpenFiles < 0 || sandbox.OutputBytes < 0 {
+		return errors.New("sandbox limits must not be negative")
+	}
+	if sandbox.SeccompProfile != "" && sandbox.SeccompProfile != DefaultSeccompProfile {
+		return errors.Errorf("unknown seccomp profile %q, must be %q", sandbox.SeccompProfile, DefaultSeccompProfile)
+	}
+	if !sandbox.PassCredentials {
+		switch strings.SplitN(user, ":", 2)[0] {
+		case "":
+			return errors.New("a sandbox that doesn't pass credentials requires the transform's user to be set, so that the user code can't read the worker's credentials")
+		case "root", "0":
+			return errors.New("a sandbox that doesn't pass credentials requires the transform's user not to be root, so that the user code can't read the worker's credentials")
+		}
+	}
+	return nil
+}
+
+// sandboxEnv returns environ (or the worker's environment, if environ is nil)
+// without the worker's credentials, unless sandbox passes them
+func sandboxEnv(sandbox *pps.Sandbox, environ []string) []string {
+	if environ == nil {
+		environ = os.Environ()
+	}
+	if sandbox.PassCredentials {
+		return environ
+	}
+	var result []string
+	for _, v := range environ {
+		name := strings.SplitN(v, "=", 2)[0]
+		credential := false
+		for _, c := range credentialEnvVars {
+			if name == c {
+				credential = true
+				break
+			}
+		}
+		if !credential {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// sandboxCmd returns the command that runs cmdline in sandbox. The command
+// re-executes the worker binary, which applies the sandbox's limits and
+// seccomp profile to itself (see ExecSandbox) before executing cmdline.
+func sandboxCmd(sandbox *pps.Sandbox, cmdline []string) ([]string, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	spec, err := json.Marshal(sandbox)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return append([]string{self, sandboxArg, string(spec)}, cmdline...), nil
+}
+
+// ExecSandbox runs the sandboxed user code described by the process's
+// arguments, if the process was started by sandboxCmd, and doesn't return in
+// that case. The worker must call it before doing anything else.
+func ExecSandbox() {
+	if len(os.Args) < 4 || os.Args[1] != sandboxArg {
+		return
+	}
+	sandbox := &pps.Sandbox{}
+	err := json.Unmarshal([]byte(os.Args[2]), sandbox)
+	if err == nil {
+		err = execSandbox(sandbox, os.Args[3:])
+	}
+	// execSandbox only returns if it fails
+	fmt.Fprintf(os.Stderr, "could not run user code in sandbox: %v\n", err)
+	os.Exit(126)
+}
@@ -0,0 +1,132 @@
+// +build linux
+
+package driver
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// seccomp constants that golang.org/x/sys/unix doesn't define
+const (
+	seccompSetModeFilter   = 1
+	seccompFilterFlagTsync = 1
+	seccompRetKillProcess  = 0x80000000
+	seccompRetErrno        = 0x00050000
+	seccompRetAllow        = 0x7fff0000
+	// offsets of the fields of struct seccomp_data
+	seccompDataNr   = 0
+	seccompDataArch = 4
+)
+
+// deniedSyscalls are the system calls that the default seccomp profile
+// blocks. They fail with EPERM.
+var deniedSyscalls = []uintptr{
+	unix.SYS_ACCT,
+	unix.SYS_ADD_KEY,
+	unix.SYS_BPF,
+	unix.SYS_CLOCK_ADJTIME,
+	unix.SYS_CLOCK_SETTIME,
+	unix.SYS_DELETE_MODULE,
+	unix.SYS_FINIT_MODULE,
+	unix.SYS_INIT_MODULE,
+	unix.SYS_KCMP,
+	unix.SYS_KEXEC_LOAD,
+	unix.SYS_KEYCTL,
+	unix.SYS_MOUNT,
+	unix.SYS_NAME_TO_HANDLE_AT,
+	unix.SYS_OPEN_BY_HANDLE_AT,
+	unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_PIVOT_ROOT,
+	unix.SYS_PROCESS_VM_READV,
+	unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_PTRACE,
+	unix.SYS_QUOTACTL,
+	unix.SYS_REBOOT,
+	unix.SYS_REQUEST_KEY,
+	unix.SYS_SETDOMAINNAME,
+	unix.SYS_SETHOSTNAME,
+	unix.SYS_SETNS,
+	unix.SYS_SETTIMEOFDAY,
+	unix.SYS_SWAPOFF,
+	unix.SYS_SWAPON,
+	unix.SYS_SYSLOG,
+	unix.SYS_UMOUNT2,
+	unix.SYS_UNSHARE,
+	unix.SYS_USERFAULTFD,
+	unix.SYS_VHANGUP,
+}
+
+// execSandbox applies sandbox to the current process and executes cmdline
+func execSandbox(sandbox *pps.Sandbox, cmdline []string) error {
+	for resource, limit := range map[int]int64{
+		unix.RLIMIT_CPU:    sandbox.CpuSeconds,
+		unix.RLIMIT_NOFILE: sandbox.OpenFiles,
+		unix.RLIMIT_FSIZE:  sandbox.OutputBytes,
+	} {
+		if limit > 0 {
+			if err := unix.Setrlimit(resource, &unix.Rlimit{Cur: uint64(limit), Max: uint64(limit)}); err != nil {
+				return errors.Wrapf(err, "could not set rlimit %d", resource)
+			}
+		}
+	}
+	path, err := exec.LookPath(cmdline[0])
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	// The seccomp filter applies to the thread that installs it (and, with
+	// TSYNC, the process's other threads), which must be the thread that calls
+	// exec.
+	runtime.LockOSThread()
+	if sandbox.SeccompProfile == DefaultSeccompProfile {
+		if err := installSeccompFilter(deniedSyscalls); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(syscall.Exec(path, cmdline, os.Environ()))
+}
+
+// installSeccompFilter installs a seccomp filter that makes the system calls
+// 'denied' fail with EPERM, and kills the process if it makes system calls
+// for an architecture other than the worker's.
+func installSeccompFilter(denied []uintptr) error {
+	if seccompAuditArch == 0 {
+		return errors.Errorf("seccomp profiles aren't supported on %s", runtime.GOARCH)
+	}
+	filter := []unix.SockFilter{
+		{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: seccompDataArch},
+		{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, Jt: 1, K: seccompAuditArch},
+		{Code: unix.BPF_RET | unix.BPF_K, K: seccompRetKillProcess},
+		{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: seccompDataNr},
+	}
+	if seccompSyscallBit != 0 {
+		// Deny the same architecture's alternate system call ABI (x32 on amd64)
+		filter = append(filter,
+			unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K, Jf: 1, K: seccompSyscallBit},
+			unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: seccompRetErrno | uint32(unix.EPERM)})
+	}
+	for _, nr := range denied {
+		filter = append(filter,
+			unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, Jf: 1, K: uint32(nr)},
+			unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: seccompRetErrno | uint32(unix.EPERM)})
+	}
+	filter = append(filter, unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: seccompRetAllow})
+	prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+
+	// Without no_new_privs, installing a filter requires CAP_SYS_ADMIN
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return errors.Wrap(err, "could not set no_new_privs")
+	}
+	if _, _, errno := unix.Syscall(unix.SYS_SECCOMP, seccompSetModeFilter, seccompFilterFlagTsync, uintptr(unsafe.Pointer(&prog))); errno != 0 {
+		return errors.Wrap(errno, "could not install seccomp filter")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// +build linux
+
+package driver
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// sandboxHelperEnv makes the test binary act as user code that reports the
+// result of a system call that the default seccomp profile blocks
+const sandboxHelperEnv = "SANDBOX_TEST_HELPER"
+
+func TestMain(m *testing.M) {
+	ExecSandbox()
+	if os.Getenv(sandboxHelperEnv) != "" {
+		// unshare(0) is a no-op, unless it's blocked
+		if err := unix.Unshare(0); err != nil {
+			fmt.Print(err)
+			os.Exit(1)
+		}
+		fmt.Print("ok")
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runSandboxed runs cmdline in sandbox, and returns its output
+func runSandboxed(t *testing.T, sandbox *pps.Sandbox, environ []string, cmdline ...string) (string, error) {
+	t.Helper()
+	sandboxed, err := sandboxCmd(sandbox, cmdline)
+	require.NoError(t, err)
+	cmd := exec.Command(sandboxed[0], sandboxed[1:]...)
+	cmd.Env = sandboxEnv(sandbox, environ)
+	out, err := cmd.CombinedOutput()
+	return strings.TrimSpace(string(out)), err
+}
+
+func TestSandboxEnv(t *testing.T) {
+	environ := []string{"PATH=/bin", "POSTGRES_PASSWORD=hunter2", "PACH_CONFIG=/pachctl/config.json", "FOO=bar"}
+	require.ElementsEqual(t, []string{"PATH=/bin", "FOO=bar"}, sandboxEnv(&pps.Sandbox{}, environ))
+	require.ElementsEqual(t, environ, sandboxEnv(&pps.Sandbox{PassCredentials: true}, environ))
+
+	out, err := runSandboxed(t, &pps.Sandbox{}, append(os.Environ(), "POSTGRES_PASSWORD=hunter2"),
+		"sh", "-c", "echo \"password=$POSTGRES_PASSWORD\"")
+	require.NoError(t, err, out)
+	require.Equal(t, "password=", out)
+}
+
+func TestSandboxLimits(t *testing.T) {
+	out, err := runSandboxed(t, &pps.Sandbox{OpenFiles: 64}, os.Environ(), "sh", "-c", "ulimit -n")
+	require.NoError(t, err, out)
+	require.Equal(t, "64", out)
+
+	// Writing a file larger than output_bytes fails
+	file := filepath.Join(t.TempDir(), "out")
+	sandbox := &pps.Sandbox{OutputBytes: 1024}
+	_, err = runSandboxed(t, sandbox, os.Environ(), "sh", "-c", fmt.Sprintf("head -c 512 /dev/zero > %s", file))
+	require.NoError(t, err)
+	_, err = runSandboxed(t, sandbox, os.Environ(), "sh", "-c", fmt.Sprintf("head -c 4096 /dev/zero > %s", file))
+	require.YesError(t, err)
+
+	// User code is killed after using cpu_seconds of CPU time
+	_, err = runSandboxed(t, &pps.Sandbox{CpuSeconds: 1}, os.Environ(), "sh", "-c", "while :; do :; done")
+	require.YesError(t, err)
+}
+
+func TestSandboxSeccomp(t *testing.T) {
+	self, err := os.Executable()
+	require.NoError(t, err)
+	environ := append(os.Environ(), sandboxHelperEnv+"=true")
+	out, err := runSandboxed(t, &pps.Sandbox{}, environ, self)
+	if err != nil {
+		t.Skipf("unshare is blocked outside of the sandbox: %s", out)
+	}
+	require.Equal(t, "ok", out)
+
+	out, err = runSandboxed(t, &pps.Sandbox{SeccompProfile: DefaultSeccompProfile}, environ, self)
+	require.YesError(t, err)
+	require.Equal(t, unix.EPERM.Error(), out)
+}
+
+func TestValidateSandbox(t *testing.T) {
+	require.NoError(t, ValidateSandbox(&pps.Sandbox{CpuSeconds: 10, SeccompProfile: DefaultSeccompProfile}, "nobody"))
+	require.YesError(t, ValidateSandbox(&pps.Sandbox{OpenFiles: -1}, "nobody"))
+	require.YesError(t, ValidateSandbox(&pps.Sandbox{SeccompProfile: "unconfined"}, "nobody"))
+	// Credentials are only isolated from user code that runs as another user
+	require.YesError(t, ValidateSandbox(&pps.Sandbox{}, ""))
+	require.YesError(t, ValidateSandbox(&pps.Sandbox{}, "root"))
+	require.YesError(t, ValidateSandbox(&pps.Sandbox{}, "0:0"))
+	require.NoError(t, ValidateSandbox(&pps.Sandbox{}, "1000:1000"))
+	require.NoError(t, ValidateSandbox(&pps.Sandbox{PassCredentials: true}, ""))
+}
@@ -0,0 +1,12 @@
+// +build !linux
+
+package driver
+
+import (
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func execSandbox(sandbox *pps.Sandbox, cmdline []string) error {
+	return errors.New("user code can only be sandboxed on Linux")
+}
@@ -0,0 +1,9 @@
+package driver
+
+const (
+	// seccompAuditArch is AUDIT_ARCH_X86_64
+	seccompAuditArch = 0xc000003e
+	// seccompSyscallBit is __X32_SYSCALL_BIT, which is set in the numbers of
+	// x32 system calls
+	seccompSyscallBit = 0x40000000
+)
@@ -0,0 +1,7 @@
+package driver
+
+const (
+	// seccompAuditArch is AUDIT_ARCH_AARCH64
+	seccompAuditArch  = 0xc00000b7
+	seccompSyscallBit = 0
+)
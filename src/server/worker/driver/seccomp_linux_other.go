@@ -0,0 +1,9 @@
+// +build linux,!amd64,!arm64
+
+package driver
+
+// seccomp profiles are only supported on amd64 and arm64
+const (
+	seccompAuditArch  = 0
+	seccompSyscallBit = 0
+)
@@ -41,6 +41,9 @@ type TaggedLogger interface {
 	WithJob(jobID string) TaggedLogger
 	WithData(data []*common.Input) TaggedLogger
 	WithUserCode() TaggedLogger
+	// WithDatumLog clones the current logger and constructs a new logger that
+	// also writes its log messages to the given datum log
+	WithDatumLog(w io.Writer) TaggedLogger
 
 	JobID() string
 }
@@ -49,6 +52,7 @@ type taggedLogger struct {
 	template  pps.LogMessage
 	stderrLog *log.Logger
 	marshaler *jsonpb.Marshaler
+	datumLog  io.Writer
 
 	buffer bytes.Buffer
 }
@@ -143,6 +147,14 @@ func (logger *taggedLogger) WithUserCode() TaggedLogger {
 	return result
 }
 
+// WithDatumLog clones the current logger and returns a new one that will also
+// write log statements to 'w', which persists them with the datum's meta files.
+func (logger *taggedLogger) WithDatumLog(w io.Writer) TaggedLogger {
+	result := logger.clone()
+	result.datumLog = w
+	return result
+}
+
 // JobID returns the current job that the logger is configured with.
 func (logger *taggedLogger) JobID() string {
 	return logger.template.JobID
@@ -153,6 +165,7 @@ func (logger *taggedLogger) clone() *taggedLogger {
 		template:  logger.template,  // Copy struct
 		stderrLog: logger.stderrLog, // logger should be goroutine-safe
 		marshaler: &jsonpb.Marshaler{},
+		datumLog:  logger.datumLog,
 	}
 }
 
@@ -174,6 +187,11 @@ func (logger *taggedLogger) Logf(formatString string, args ...interface{}) {
 		return
 	}
 	fmt.Println(msg)
+	if logger.datumLog != nil {
+		if _, err := fmt.Fprintln(logger.datumLog, msg); err != nil {
+			logger.Errf("could not write to datum log: %s\n", err)
+		}
+	}
 }
 
 // LogStep will log before and after the given callback function runs, using
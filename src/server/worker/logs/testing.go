@@ -106,6 +106,14 @@ func (ml *MockLogger) WithUserCode() TaggedLogger {
 	return result
 }
 
+// WithDatumLog duplicates the MockLogger and returns a new one that writes
+// its log statements to 'w'.
+func (ml *MockLogger) WithDatumLog(w io.Writer) TaggedLogger {
+	result := ml.clone()
+	result.Writer = w
+	return result
+}
+
 // JobID returns the currently tagged job ID for the logger.
 // This is redundant for MockLogger, as you can access ml.Job directly,
 // but it is needed for the TaggedLogger interface.
@@ -61,11 +61,11 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 			return datum.WithSet(cacheClient, storageRoot, func(s *datum.Set) error {
 				inputs := meta.Inputs
 				logger = logger.WithData(inputs)
-				env := driver.UserCodeEnv(logger.JobID(), jobInfo.OutputCommit, inputs)
 				return s.WithDatum(meta, func(d *datum.Datum) error {
 					return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
 						return d.Run(ctx, func(runCtx context.Context) error {
-							return driver.RunUserCode(runCtx, logger, env)
+							env := driver.UserCodeEnv(runCtx, logger.JobID(), jobInfo.OutputCommit, inputs)
+							return driver.RunUserCode(runCtx, logger, env, d.Stats())
 						})
 					})
 				})
@@ -84,6 +84,11 @@ func forEachJob(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, lo
 	var cancel func()
 	var eg *errgroup.Group
 	return pachClient.SubscribeJob(pipelineInfo.Pipeline.Name, true, func(ji *pps.JobInfo) error {
+		if ji.PipelineVersion != pipelineInfo.Version {
+			// Skip this job, which is for another version of the service. This
+			// version keeps serving until it's shut down.
+			return nil
+		}
 		if cancel != nil {
 			logger.Logf("canceling previous service, new job ready")
 			cancel()
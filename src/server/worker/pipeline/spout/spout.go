@@ -1,6 +1,17 @@
 package spout
 
 import (
+	"os"
+	"path/filepath"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
 )
@@ -8,5 +19,99 @@ import (
 // Run will run a spout pipeline until the driver is canceled.
 func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 	logger = logger.WithJob("spout")
-	return driver.RunUserCode(driver.PachClient().Ctx(), logger, nil)
+	if marker := driver.PipelineInfo().Details.Spout.Marker; marker != "" {
+		if err := restoreMarker(driver, logger, marker); err != nil {
+			return err
+		}
+	}
+	return driver.RunUserCode(driver.PachClient().Ctx(), logger, nil, nil)
+}
+
+// restoreMarker recovers from a previous run of the spout code, and writes the
+// last checkpoint that was committed along with its output to /pfs/<marker>.
+func restoreMarker(driver driver.Driver, logger logs.TaggedLogger, marker string) error {
+	pachClient := driver.PachClient()
+	pipelineInfo := driver.PipelineInfo()
+	repo := client.NewRepo(pipelineInfo.Pipeline.Name)
+	outputBranch := repo.NewBranch(pipelineInfo.Details.OutputBranch)
+	markerBranch := repo.NewBranch(ppsconsts.SpoutMarkerBranch)
+	// A previous run may have died between starting its commits and finishing
+	// them. Anything written to those commits is discarded, as the spout code
+	// will produce it again from the last checkpoint.
+	for _, branch := range []*pfs.Branch{outputBranch, markerBranch} {
+		if err := abandonOpenHead(pachClient, logger, branch); err != nil {
+			return err
+		}
+	}
+	if err := ppsutil.ValidateSpoutMarker(marker); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(filepath.Join(driver.InputDir(), marker)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	var checkpoint *pfs.Commit
+	if err := pachClient.ListCommitF(repo, markerBranch.NewCommit(""), nil, 0, false, func(ci *pfs.CommitInfo) error {
+		if ci.Finishing == nil || ci.Error != "" {
+			return nil
+		}
+		outputInfo, err := pachClient.InspectCommit(repo.Name, outputBranch.Name, ci.Commit.ID)
+		if err != nil {
+			if pfsserver.IsCommitNotFoundErr(err) {
+				return nil
+			}
+			return err
+		}
+		if outputInfo.Finishing == nil || outputInfo.Error != "" {
+			return nil
+		}
+		checkpoint = ci.Commit
+		return errutil.ErrBreak
+	}); err != nil {
+		if !pfsserver.IsBranchNotFoundErr(err) && !pfsserver.IsCommitNotFoundErr(err) {
+			return err
+		}
+	}
+	if checkpoint == nil {
+		logger.Logf("no spout checkpoint found, starting from the beginning")
+		return nil
+	}
+	if _, err := pachClient.WaitCommit(repo.Name, markerBranch.Name, checkpoint.ID); err != nil {
+		return err
+	}
+	r, err := pachClient.GetFileTAR(checkpoint, marker)
+	if err != nil {
+		if pfsserver.IsFileNotFoundErr(err) {
+			return nil
+		}
+		return err
+	}
+	defer r.Close()
+	logger.Logf("restoring spout checkpoint from commit %v", checkpoint.ID)
+	return tarutil.Import(driver.InputDir(), r)
+}
+
+// abandonOpenHead clears and finishes the head of 'branch' if it was left open.
+func abandonOpenHead(pachClient *client.APIClient, logger logs.TaggedLogger, branch *pfs.Branch) error {
+	branchInfo, err := pachClient.InspectBranch(branch.Repo.Name, branch.Name)
+	if err != nil {
+		if pfsserver.IsBranchNotFoundErr(err) {
+			return nil
+		}
+		return err
+	}
+	if branchInfo.Head == nil {
+		return nil
+	}
+	commitInfo, err := pachClient.InspectCommit(branch.Repo.Name, branch.Name, branchInfo.Head.ID)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Finishing != nil {
+		return nil
+	}
+	logger.Logf("abandoning unfinished spout commit %v", commitInfo.Commit)
+	if err := pachClient.ClearCommit(branch.Repo.Name, branch.Name, commitInfo.Commit.ID); err != nil {
+		return err
+	}
+	return pachClient.FinishCommit(branch.Repo.Name, branch.Name, commitInfo.Commit.ID)
 }
@@ -99,11 +99,11 @@ func (td *testDriver) WithContext(ctx context.Context) driver.Driver {
 func (td *testDriver) WithActiveData(inputs []*common.Input, dir string, cb func() error) error {
 	return td.inner.WithActiveData(inputs, dir, cb)
 }
-func (td *testDriver) UserCodeEnv(jobID string, commit *pfs.Commit, inputs []*common.Input) []string {
-	return td.inner.UserCodeEnv(jobID, commit, inputs)
+func (td *testDriver) UserCodeEnv(ctx context.Context, jobID string, commit *pfs.Commit, inputs []*common.Input) []string {
+	return td.inner.UserCodeEnv(ctx, jobID, commit, inputs)
 }
-func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
-	return td.inner.RunUserCode(ctx, logger, env)
+func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger, env []string, stats *pps.ProcessStats) error {
+	return td.inner.RunUserCode(ctx, logger, env, stats)
 }
 func (td *testDriver) RunUserErrorHandlingCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
 	return td.inner.RunUserErrorHandlingCode(ctx, logger, env)
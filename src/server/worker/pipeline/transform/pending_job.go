@@ -7,6 +7,9 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -28,6 +31,13 @@ type pendingJob struct {
 	parentMetaCommit           *pfs.Commit
 	hasher                     datum.Hasher
 	noSkip                     bool
+	// When concurrent is set, a job that does not share datums with the
+	// running jobs for the earlier output commits uses the most recent
+	// finished ancestor as its parent, and is reconciled with the running
+	// parent (runningParentMetaCommit) once that parent finishes.
+	concurrent              bool
+	runningParentMetaCommit *pfs.Commit
+	datumSets               *datumSetCache
 }
 
 func (pj *pendingJob) writeJobInfo() error {
@@ -88,13 +98,17 @@ func (pj *pendingJob) load() error {
 	}
 	// Find the most recent successful ancestor commit to use as the
 	// base for this job.
+	// In concurrent mode, keep going past the running ancestors to find the
+	// most recent finished ancestor.
 	// TODO: This should be an operation supported and exposed by PFS.
-	pj.parentMetaCommit = pj.metaCommitInfo.ParentCommit
-	for pj.parentMetaCommit != nil {
+	pj.parentMetaCommit, pj.parentDit, pj.runningParentMetaCommit = nil, nil, nil
+	var runningMetaCommit *pfs.Commit
+	var runningJis []*pps.JobInfo
+	for metaCommit := pj.metaCommitInfo.ParentCommit; metaCommit != nil; {
 		ci, err := pachClient.PfsAPIClient.InspectCommit(
 			pachClient.Ctx(),
 			&pfs.InspectCommitRequest{
-				Commit: pj.parentMetaCommit,
+				Commit: metaCommit,
 				Wait:   pfs.CommitState_STARTED,
 			})
 		if err != nil {
@@ -102,31 +116,103 @@ func (pj *pendingJob) load() error {
 		}
 		if ci.Error == "" {
 			if ci.Finishing != nil {
-				pj.parentDit = datum.NewCommitIterator(pachClient, pj.parentMetaCommit)
-			} else {
-				parentJi, err := pachClient.InspectJob(pj.ji.Job.Pipeline.Name, pj.parentMetaCommit.ID, true)
-				if err != nil {
-					return err
-				}
-				dit, err := datum.NewIterator(pachClient, parentJi.Details.Input)
-				if err != nil {
-					return err
-				}
-				pj.parentDit = datum.NewJobIterator(dit, parentJi.Job, pj.hasher)
+				pj.parentMetaCommit = metaCommit
+				pj.parentDit = datum.NewCommitIterator(pachClient, metaCommit)
+				break
+			}
+			parentJi, err := pj.inspectJob(metaCommit.ID)
+			if err != nil {
+				return err
+			}
+			if runningMetaCommit == nil {
+				runningMetaCommit = metaCommit
+			}
+			runningJis = append(runningJis, parentJi)
+			if !pj.concurrent {
+				break
 			}
-			break
 		}
-		pj.parentMetaCommit = ci.ParentCommit
+		metaCommit = ci.ParentCommit
 	}
 	// Load the job info.
-	pj.ji, err = pachClient.InspectJob(pj.ji.Job.Pipeline.Name, pj.ji.Job.ID, true)
+	pj.ji, err = pj.inspectJob(pj.ji.Job.ID)
 	if err != nil {
 		return err
 	}
+	if runningMetaCommit != nil {
+		var independent bool
+		if pj.concurrent {
+			independent, err = pj.independent(runningJis)
+			if err != nil {
+				return err
+			}
+		}
+		if independent {
+			pj.logger.Logf("job does not share datums with running jobs, processing it concurrently")
+			pj.runningParentMetaCommit = runningMetaCommit
+		} else {
+			// Use the nearest running ancestor as the parent.
+			pj.parentMetaCommit = runningMetaCommit
+			dit, err := datum.NewIterator(pachClient, runningJis[0].Details.Input)
+			if err != nil {
+				return err
+			}
+			pj.parentDit = datum.NewJobIterator(dit, runningJis[0].Job, pj.hasher)
+		}
+	}
 	pj.clearJobStats()
 	return nil
 }
 
+// inspectJob returns the job with ID 'id' of pj's pipeline that writes to the
+// same branch as pj's job (which, for a shadow candidate, isn't the pipeline's
+// output branch).
+func (pj *pendingJob) inspectJob(id string) (*pps.JobInfo, error) {
+	pachClient := pj.driver.PachClient()
+	jobInfo, err := pachClient.PpsAPIClient.InspectJob(pachClient.Ctx(), &pps.InspectJobRequest{
+		Job: &pps.Job{
+			Pipeline: pj.ji.Job.Pipeline,
+			ID:       id,
+			Branch:   pj.ji.Job.Branch,
+		},
+		Details: true,
+	})
+	return jobInfo, grpcutil.ScrubGRPC(err)
+}
+
+// independent returns true if the job does not share any datums with the passed in jobs.
+func (pj *pendingJob) independent(jis []*pps.JobInfo) (bool, error) {
+	independent := true
+	pachClient := pj.driver.PachClient()
+	if err := pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
+		pachClient := pachClient.WithCtx(ctx)
+		// The current job's iterator comes first, so a shared datum has the
+		// current job's meta first.
+		var dits []datum.Iterator
+		for _, ji := range append([]*pps.JobInfo{pj.ji}, jis...) {
+			fileSetID, err := pj.datumSets.get(pachClient, ji, pj.hasher)
+			if err != nil {
+				return err
+			}
+			// The cached file set is only renewed while its job is running.
+			if err := renewer.Add(ctx, fileSetID); err != nil {
+				return err
+			}
+			dits = append(dits, datum.NewFileSetIterator(pachClient, fileSetID))
+		}
+		return datum.Merge(dits, func(metas []*datum.Meta) error {
+			if len(metas) > 1 && proto.Equal(metas[0].Job, pj.ji.Job) {
+				independent = false
+				return errutil.ErrBreak
+			}
+			return nil
+		})
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return false, err
+	}
+	return independent, nil
+}
+
 func (pj *pendingJob) clearJobStats() {
 	pj.ji.Stats = &pps.ProcessStats{}
 	pj.ji.DataProcessed = 0
@@ -136,14 +222,13 @@ func (pj *pendingJob) clearJobStats() {
 	pj.ji.DataTotal = 0
 }
 
-func (pj *pendingJob) withDeleter(pachClient *client.APIClient, cb func(datum.Deleter) error) error {
+func (pj *pendingJob) withDeleter(pachClient *client.APIClient, parentMetaCommit *pfs.Commit, cb func(datum.Deleter) error) error {
 	// Setup modify file client for meta commit.
 	metaCommit := pj.metaCommitInfo.Commit
 	return pachClient.WithModifyFileClient(metaCommit, func(mfMeta client.ModifyFile) error {
 		// Setup modify file client for output commit.
 		outputCommit := pj.commitInfo.Commit
 		return pachClient.WithModifyFileClient(outputCommit, func(mfPFS client.ModifyFile) error {
-			parentMetaCommit := pj.parentMetaCommit
 			metaFileWalker := func(path string) ([]string, error) {
 				var files []string
 				if err := pachClient.WalkFile(parentMetaCommit, path, func(fi *pfs.FileInfo) error {
@@ -254,7 +339,7 @@ func (pj *pendingJob) withSerialDatums(ctx context.Context, cb func(context.Cont
 		fileSetIterator := datum.NewFileSetIterator(pachClient, fileSetID)
 		stats := &datum.Stats{ProcessStats: &pps.ProcessStats{}}
 		outputFileSetID, err := withDatumFileSet(pachClient, func(s *datum.Set) error {
-			return pj.withDeleter(pachClient, func(deleter datum.Deleter) error {
+			return pj.withDeleter(pachClient, pj.parentMetaCommit, func(deleter datum.Deleter) error {
 				return datum.Merge([]datum.Iterator{parentDit, fileSetIterator}, func(metas []*datum.Meta) error {
 					if len(metas) == 1 {
 						// Datum was processed in the parallel step.
@@ -291,6 +376,106 @@ func (pj *pendingJob) withSerialDatums(ctx context.Context, cb func(context.Cont
 	})
 }
 
+// The reconciled datums are the datums that need to be processed after the running parent job finishes, when the job was processed
+// concurrently with it (against the most recent finished ancestor).
+// Since the jobs do not share datums, the datums skipped by this job only need to be processed if the running parent job (or the jobs
+// in between) removed them from the output, and the datums that only exist in the running parent job need to be deleted.
+// Waiting for the running parent job also preserves the ordering of the output commits.
+func (pj *pendingJob) withReconciledDatums(ctx context.Context, cb func(context.Context, datum.Iterator) error) error {
+	// There is nothing to reconcile if the job was not processed concurrently.
+	if pj.runningParentMetaCommit == nil {
+		return nil
+	}
+	pachClient := pj.driver.PachClient().WithCtx(ctx)
+	// Wait for the running parent job to finish.
+	parentMetaCommit := pj.runningParentMetaCommit
+	ci, err := pachClient.WaitCommit(parentMetaCommit.Branch.Repo.Name, parentMetaCommit.Branch.Name, parentMetaCommit.ID)
+	if err != nil {
+		return err
+	}
+	if ci.Error != "" {
+		return pfsserver.ErrCommitError{Commit: ci.Commit}
+	}
+	return pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
+		pachClient = pachClient.WithCtx(ctx)
+		// Upload the datums from the current job into the datum file set format.
+		dit, err := datum.NewIterator(pachClient, pj.ji.Details.Input)
+		if err != nil {
+			return err
+		}
+		dit = datum.NewJobIterator(dit, pj.ji.Job, pj.hasher)
+		fileSetID, err := uploadDatumFileSet(pachClient, dit)
+		if err != nil {
+			return err
+		}
+		if err := renewer.Add(ctx, fileSetID); err != nil {
+			return err
+		}
+		// Create a datum file set for the datums that were skipped in the serial step.
+		var baseDits []datum.Iterator
+		if pj.parentMetaCommit != nil {
+			baseDits = append(baseDits, datum.NewCommitIterator(pachClient, pj.parentMetaCommit))
+		}
+		baseDits = append(baseDits, datum.NewFileSetIterator(pachClient, fileSetID))
+		skippedFileSetID, err := withDatumFileSet(pachClient, func(s *datum.Set) error {
+			return datum.Merge(baseDits, func(metas []*datum.Meta) error {
+				if len(metas) > 1 && pj.skippableDatum(metas[1], metas[0]) {
+					return s.UploadMeta(metas[1])
+				}
+				return nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+		if err := renewer.Add(ctx, skippedFileSetID); err != nil {
+			return err
+		}
+		// Create the output datum file set for the skipped datums that do not exist in the running parent job.
+		// Also create deletion operations for the datums that only exist in the running parent job.
+		// The running parent job's metas are the only metas that are not from the current job.
+		parentDit := datum.NewCommitIterator(pachClient, parentMetaCommit)
+		dits := []datum.Iterator{
+			parentDit,
+			datum.NewFileSetIterator(pachClient, fileSetID),
+			datum.NewFileSetIterator(pachClient, skippedFileSetID),
+		}
+		stats := &datum.Stats{ProcessStats: &pps.ProcessStats{}}
+		outputFileSetID, err := withDatumFileSet(pachClient, func(s *datum.Set) error {
+			return pj.withDeleter(pachClient, parentMetaCommit, func(deleter datum.Deleter) error {
+				return datum.Merge(dits, func(metas []*datum.Meta) error {
+					if !proto.Equal(metas[0].Job, pj.ji.Job) {
+						// Datum only exists in the running parent job.
+						if len(metas) == 1 {
+							return deleter(metas[0])
+						}
+						return nil
+					}
+					// Datum was skipped, but does not exist in the running parent job.
+					if len(metas) > 1 {
+						// The datum was counted as skipped in the serial step.
+						stats.Skipped--
+						pj.logger.WithData(metas[0].Inputs).Logf("setting up datum for processing (reconciled jobs)")
+						return s.UploadMeta(metas[0], datum.WithPrefixIndex())
+					}
+					return nil
+				})
+			})
+		})
+		if err != nil {
+			return err
+		}
+		if err := renewer.Add(ctx, outputFileSetID); err != nil {
+			return err
+		}
+		pj.saveJobStats(stats)
+		if err := pj.writeJobInfo(); err != nil {
+			return err
+		}
+		return cb(ctx, datum.NewFileSetIterator(pachClient, outputFileSetID))
+	})
+}
+
 func uploadDatumFileSet(pachClient *client.APIClient, dit datum.Iterator) (string, error) {
 	return withDatumFileSet(pachClient, func(s *datum.Set) error {
 		return dit.Iterate(func(meta *datum.Meta) error {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -21,6 +22,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -50,6 +52,70 @@ type registry struct {
 	taskQueue   *work.TaskQueue
 	concurrency int64
 	limiter     limit.ConcurrencyLimiter
+	datumSets   *datumSetCache
+}
+
+// datumSetCache holds the datum file sets of the registry's running jobs, so
+// that checking whether a job is independent of the running jobs doesn't
+// re-upload their datums for every job. The file sets are renewed until the
+// job is evicted.
+type datumSetCache struct {
+	pachClient *client.APIClient
+	mu         sync.Mutex
+	sets       map[string]*cachedDatumSet
+}
+
+type cachedDatumSet struct {
+	id      string
+	renewer *renew.StringSet
+}
+
+func newDatumSetCache(pachClient *client.APIClient) *datumSetCache {
+	return &datumSetCache{
+		pachClient: pachClient,
+		sets:       make(map[string]*cachedDatumSet),
+	}
+}
+
+// get returns the ID of the datum file set of job 'ji', uploading it if it
+// isn't cached.
+func (c *datumSetCache) get(pachClient *client.APIClient, ji *pps.JobInfo, hasher datum.Hasher) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ds, ok := c.sets[ji.Job.ID]; ok {
+		return ds.id, nil
+	}
+	dit, err := datum.NewIterator(pachClient, ji.Details.Input)
+	if err != nil {
+		return "", err
+	}
+	id, err := uploadDatumFileSet(pachClient, datum.NewJobIterator(dit, ji.Job, hasher))
+	if err != nil {
+		return "", err
+	}
+	rf := func(ctx context.Context, p string, ttl time.Duration) error {
+		return c.pachClient.WithCtx(ctx).RenewFileSet(p, ttl)
+	}
+	cf := func(ctx context.Context, ps []string, ttl time.Duration) (string, error) {
+		return c.pachClient.WithCtx(ctx).ComposeFileSet(ps, ttl)
+	}
+	renewer := renew.NewStringSet(c.pachClient.Ctx(), client.DefaultTTL, rf, cf)
+	if err := renewer.Add(pachClient.Ctx(), id); err != nil {
+		renewer.Close()
+		return "", err
+	}
+	c.sets[ji.Job.ID] = &cachedDatumSet{id: id, renewer: renewer}
+	return id, nil
+}
+
+// evict stops renewing the datum file set of job 'jobID', if it's cached.
+func (c *datumSetCache) evict(jobID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ds, ok := c.sets[jobID]; ok {
+		ds.renewer.Close()
+		delete(c.sets, jobID)
+	}
 }
 
 // TODO:
@@ -71,6 +137,7 @@ func newRegistry(driver driver.Driver, logger logs.TaggedLogger) (*registry, err
 		taskQueue:   taskQueue,
 		concurrency: concurrency,
 		limiter:     limit.New(int(concurrency)),
+		datumSets:   newDatumSetCache(driver.PachClient()),
 	}, nil
 }
 
@@ -107,6 +174,7 @@ func (reg *registry) startJob(jobInfo *pps.JobInfo) (retErr error) {
 		// For transient errors, we would want to retry, not just give up on the job.
 		if retErr != nil {
 			reg.limiter.Release()
+			reg.datumSets.evict(jobInfo.Job.ID)
 		}
 	}()
 	pi := reg.driver.PipelineInfo()
@@ -117,7 +185,9 @@ func (reg *registry) startJob(jobInfo *pps.JobInfo) (retErr error) {
 		hasher: &hasher{
 			salt: pi.Details.Salt,
 		},
-		noSkip: pi.Details.ReprocessSpec == client.ReprocessSpecEveryJob || pi.Details.S3Out,
+		noSkip:     pi.Details.ReprocessSpec == client.ReprocessSpecEveryJob || pi.Details.S3Out,
+		concurrent: pi.Details.ConcurrentJobs,
+		datumSets:  reg.datumSets,
 	}
 	if pj.ji.State == pps.JobState_JOB_CREATED {
 		pj.ji.State = pps.JobState_JOB_STARTING
@@ -149,6 +219,7 @@ func (reg *registry) startJob(jobInfo *pps.JobInfo) (retErr error) {
 	}
 	go func() {
 		defer reg.limiter.Release()
+		defer reg.datumSets.evict(pj.ji.Job.ID)
 		if pj.ji.Details.JobTimeout != nil {
 			pj.logger.Logf("cancelling job at: %+v", afterTime)
 			timer := time.AfterFunc(afterTime, func() {
@@ -273,8 +344,14 @@ func (reg *registry) processJobStarting(pj *pendingJob) error {
 	return pj.writeJobInfo()
 }
 
-func (reg *registry) processJobRunning(pj *pendingJob) error {
-	pachClient := pj.driver.PachClient()
+func (reg *registry) processJobRunning(pj *pendingJob) (retErr error) {
+	// The spans for the job's datums are children of the job's span.
+	span, ctx := tracing.StartSpan(pj.driver.PachClient().Ctx(), "/worker/Job",
+		"pipeline", pj.ji.Job.Pipeline.Name, "job", pj.ji.Job.ID)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	pachClient := pj.driver.PachClient().WithCtx(ctx)
 	// TODO: We need to delete the output for S3Out since we don't have a clear way to track the output in the stats commit (which means datums cannot be skipped with S3Out).
 	// If we had a way to map the output added through the S3 gateway back to the datums, and stored this in the appropriate place in the stats commit, then we would be able
 	// handle datums the same way we handle normal pipelines.
@@ -289,7 +366,12 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 		}); err != nil {
 			return err
 		}
-		return pj.withSerialDatums(master.Ctx(), func(ctx context.Context, dit datum.Iterator) error {
+		if err := pj.withSerialDatums(master.Ctx(), func(ctx context.Context, dit datum.Iterator) error {
+			return reg.processDatums(ctx, pj, master, dit)
+		}); err != nil {
+			return err
+		}
+		return pj.withReconciledDatums(master.Ctx(), func(ctx context.Context, dit datum.Iterator) error {
 			return reg.processDatums(ctx, pj, master, dit)
 		})
 	}); err != nil {
@@ -423,6 +505,7 @@ func createDatumSetSubtask(pachClient *client.APIClient, pj *pendingJob, upload
 		// TODO: It might make sense for this to be a hash of the constituent datums?
 		// That could make it possible to recover from a master restart.
 		FileSetId: resp.FileSetId,
+		Trace:     tracing.SerializeSpan(pachClient.Ctx()),
 	})
 	if err != nil {
 		return nil, err
@@ -30,6 +30,9 @@ type DatumSet struct {
 	JobID        string      `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	FileSetId    string      `protobuf:"bytes,2,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
 	OutputCommit *pfs.Commit `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
+	// trace is the serialized context of the job's span, which the spans for
+	// the datums in the set are children of.
+	Trace map[string]string `protobuf:"bytes,7,rep,name=trace,proto3" json:"trace,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Outputs
 	OutputFileSetId      string       `protobuf:"bytes,4,opt,name=output_file_set_id,json=outputFileSetId,proto3" json:"output_file_set_id,omitempty"`
 	MetaFileSetId        string       `protobuf:"bytes,5,opt,name=meta_file_set_id,json=metaFileSetId,proto3" json:"meta_file_set_id,omitempty"`
@@ -93,6 +96,13 @@ func (m *DatumSet) GetOutputCommit() *pfs.Commit {
 	return nil
 }
 
+func (m *DatumSet) GetTrace() map[string]string {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
+}
+
 func (m *DatumSet) GetOutputFileSetId() string {
 	if m != nil {
 		return m.OutputFileSetId
@@ -116,6 +126,7 @@ func (m *DatumSet) GetStats() *datum.Stats {
 
 func init() {
 	proto.RegisterType((*DatumSet)(nil), "pachyderm.worker.pipeline.transform.DatumSet")
+	proto.RegisterMapType((map[string]string)(nil), "pachyderm.worker.pipeline.transform.DatumSet.TraceEntry")
 }
 
 func init() {
@@ -123,28 +134,32 @@ func init() {
 }
 
 var fileDescriptor_21583a759eb7fa97 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x4e, 0x3a, 0x31,
-	0x10, 0xc7, 0xd3, 0xdf, 0xcf, 0x25, 0x52, 0x40, 0xcd, 0xc6, 0x03, 0xe1, 0xb0, 0x10, 0x3c, 0x48,
-	0x62, 0xd2, 0x1a, 0x78, 0x03, 0x24, 0x24, 0x78, 0x5c, 0x3c, 0x79, 0xd9, 0xec, 0x9f, 0x2e, 0x2c,
-	0x52, 0xda, 0xb4, 0xb3, 0x18, 0xdf, 0xd0, 0xa3, 0x4f, 0x60, 0xcc, 0xbe, 0x86, 0x17, 0xd3, 0x16,
-	0xf9, 0x73, 0xf2, 0xd2, 0xcc, 0x7c, 0xe7, 0xf3, 0x9d, 0x69, 0x3b, 0xf8, 0x5e, 0x33, 0xb5, 0x65,
-	0x8a, 0xbe, 0x0a, 0xf5, 0xc2, 0x14, 0x95, 0x85, 0x64, 0xeb, 0x62, 0xc3, 0x28, 0xa8, 0x78, 0xa3,
-	0x73, 0xa1, 0xf8, 0x21, 0x22, 0x52, 0x09, 0x10, 0xfe, 0x8d, 0x8c, 0xd3, 0xe5, 0x5b, 0xc6, 0x14,
-	0x27, 0xce, 0x44, 0x7e, 0x4d, 0x64, 0x8f, 0x76, 0xae, 0x17, 0x62, 0x21, 0x2c, 0x4f, 0x4d, 0xe4,
-	0xac, 0x9d, 0x96, 0xcc, 0x35, 0x95, 0xb9, 0xde, 0xa5, 0xdd, 0xd3, 0xd9, 0x59, 0x0c, 0x25, 0x77,
-	0xa7, 0x03, 0xfa, 0xdf, 0x08, 0x9f, 0x4f, 0x4c, 0x3e, 0x67, 0xe0, 0xf7, 0x70, 0x6d, 0x25, 0x92,
-	0xa8, 0xc8, 0xda, 0xa8, 0x87, 0x06, 0xf5, 0x71, 0xbd, 0xfa, 0xec, 0x7a, 0x8f, 0x22, 0x99, 0x4d,
-	0x42, 0x6f, 0x25, 0x92, 0x59, 0xe6, 0x07, 0xb8, 0x91, 0x17, 0x6b, 0x16, 0x69, 0x06, 0x06, 0xfb,
-	0x67, 0xb0, 0xb0, 0x6e, 0xa4, 0x39, 0x83, 0x59, 0xe6, 0x8f, 0x70, 0x4b, 0x94, 0x20, 0x4b, 0x88,
-	0x52, 0xc1, 0x79, 0x01, 0xed, 0xff, 0x3d, 0x34, 0x68, 0x0c, 0x2f, 0x88, 0xcc, 0x75, 0xb4, 0x1d,
-	0x92, 0x07, 0xab, 0x86, 0x4d, 0x07, 0xb9, 0xcc, 0xbf, 0xc3, 0xfe, 0xce, 0x74, 0xdc, 0xfb, 0xcc,
-	0xf6, 0xbe, 0x74, 0x95, 0xe9, 0x7e, 0xc2, 0x2d, 0xbe, 0xe2, 0x0c, 0xe2, 0x13, 0xd4, 0xb3, 0x68,
-	0xcb, 0xe8, 0x07, 0xb0, 0x8f, 0x3d, 0x0d, 0x31, 0xe8, 0x76, 0xcd, 0x5e, 0xa1, 0x49, 0xdc, 0xb3,
-	0xe7, 0x46, 0x0b, 0x5d, 0x69, 0xfc, 0xf4, 0x5e, 0x05, 0xe8, 0xa3, 0x0a, 0xd0, 0x57, 0x15, 0xa0,
-	0xe7, 0xe9, 0xa2, 0x80, 0x65, 0x99, 0x90, 0x54, 0x70, 0xba, 0xdf, 0xc0, 0x51, 0xb4, 0x1d, 0x52,
-	0xad, 0x52, 0xfa, 0xd7, 0x3a, 0x93, 0x9a, 0xfd, 0xda, 0xd1, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x82, 0x98, 0xd3, 0xa7, 0xf9, 0x01, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0xae, 0xda, 0x30,
+	0x14, 0xc6, 0x15, 0xd2, 0xd0, 0x62, 0xa0, 0x45, 0x16, 0x43, 0xc4, 0x10, 0x22, 0x3a, 0x14, 0xa9,
+	0x92, 0x5d, 0x85, 0x05, 0x75, 0xa4, 0x14, 0x89, 0x0e, 0x1d, 0x02, 0x53, 0x97, 0x28, 0x7f, 0x1c,
+	0x08, 0x10, 0x6c, 0xd9, 0x4e, 0x2a, 0x1e, 0xa0, 0xef, 0xd6, 0xb1, 0x4f, 0x50, 0x5d, 0xe5, 0x49,
+	0xae, 0x1c, 0xf3, 0x77, 0xba, 0x77, 0x89, 0xce, 0x39, 0xfe, 0x7d, 0xc9, 0x77, 0xbe, 0x18, 0x7c,
+	0x11, 0x84, 0x97, 0x84, 0xe3, 0xdf, 0x94, 0xef, 0x09, 0xc7, 0x2c, 0x63, 0xe4, 0x90, 0x1d, 0x09,
+	0x96, 0x3c, 0x3c, 0x8a, 0x94, 0xf2, 0xfc, 0x56, 0x21, 0xc6, 0xa9, 0xa4, 0xf0, 0x23, 0x0b, 0xe3,
+	0xed, 0x29, 0x21, 0x3c, 0x47, 0x5a, 0x84, 0x2e, 0x22, 0x74, 0x45, 0x07, 0xfd, 0x0d, 0xdd, 0xd0,
+	0x9a, 0xc7, 0xaa, 0xd2, 0xd2, 0x41, 0x97, 0xa5, 0x02, 0xb3, 0x54, 0x9c, 0xdb, 0xe1, 0xe3, 0xb7,
+	0x93, 0x50, 0x16, 0xb9, 0x7e, 0x6a, 0x60, 0xf4, 0xc7, 0x04, 0xef, 0xe6, 0xaa, 0x5f, 0x11, 0x09,
+	0x5d, 0xd0, 0xdc, 0xd1, 0x28, 0xc8, 0x12, 0xdb, 0x70, 0x8d, 0x71, 0x6b, 0xd6, 0xaa, 0xfe, 0x0f,
+	0xad, 0x1f, 0x34, 0x5a, 0xce, 0x7d, 0x6b, 0x47, 0xa3, 0x65, 0x02, 0x1d, 0xd0, 0x4e, 0xb3, 0x03,
+	0x09, 0x04, 0x91, 0x0a, 0x6b, 0x28, 0xcc, 0x6f, 0xa9, 0xd1, 0x8a, 0xc8, 0x65, 0x02, 0x27, 0xa0,
+	0x4b, 0x0b, 0xc9, 0x0a, 0x19, 0xc4, 0x34, 0xcf, 0x33, 0x69, 0x9b, 0xae, 0x31, 0x6e, 0x7b, 0xef,
+	0x11, 0x4b, 0x45, 0x50, 0x7a, 0xe8, 0x5b, 0x3d, 0xf5, 0x3b, 0x1a, 0xd2, 0x1d, 0xfc, 0x09, 0x2c,
+	0xc9, 0xc3, 0x98, 0xd8, 0x6f, 0x5d, 0x73, 0xdc, 0xf6, 0xa6, 0xe8, 0x15, 0xeb, 0xa3, 0x8b, 0x69,
+	0xb4, 0x56, 0xd2, 0xef, 0x47, 0xc9, 0x4f, 0xbe, 0x7e, 0x0d, 0xfc, 0x0c, 0xe0, 0xd9, 0xc4, 0xbd,
+	0xd7, 0x37, 0xb5, 0xd7, 0x0f, 0xfa, 0x64, 0x71, 0x75, 0xfc, 0x09, 0xf4, 0x72, 0x22, 0xc3, 0x07,
+	0xd4, 0xaa, 0xd1, 0xae, 0x9a, 0xdf, 0xc0, 0x11, 0xb0, 0x84, 0x0c, 0xa5, 0xb0, 0x9b, 0xf5, 0x4a,
+	0x1d, 0xa4, 0x63, 0x5c, 0xa9, 0x99, 0xaf, 0x8f, 0x06, 0x53, 0x00, 0x6e, 0x76, 0x60, 0x0f, 0x98,
+	0x7b, 0x72, 0xd2, 0x59, 0xfa, 0xaa, 0x84, 0x7d, 0x60, 0x95, 0xe1, 0xa1, 0x20, 0xe7, 0xe0, 0x74,
+	0xf3, 0xb5, 0x31, 0x35, 0x66, 0xeb, 0xbf, 0x95, 0x63, 0xfc, 0xab, 0x1c, 0xe3, 0xa9, 0x72, 0x8c,
+	0x5f, 0x8b, 0x4d, 0x26, 0xb7, 0x45, 0x84, 0x62, 0x9a, 0xe3, 0x6b, 0x18, 0x77, 0x55, 0xe9, 0x61,
+	0xc1, 0x63, 0xfc, 0xd2, 0xc5, 0x8a, 0x9a, 0xf5, 0x4f, 0x9e, 0x3c, 0x0f, 0x00, 0x4c, 0xea, 0xbd,
+	0xa0, 0x83, 0x02, 0x00, 0x00,
 }
 
 func (m *DatumSet) Marshal() (dAtA []byte, err error) {
@@ -171,6 +186,25 @@ func (m *DatumSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Trace) > 0 {
+		for k := range m.Trace {
+			v := m.Trace[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTransform(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTransform(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTransform(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.Stats != nil {
 		{
 			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
@@ -267,6 +301,14 @@ func (m *DatumSet) Size() (n int) {
 		l = m.Stats.Size()
 		n += 1 + l + sovTransform(uint64(l))
 	}
+	if len(m.Trace) > 0 {
+		for k, v := range m.Trace {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTransform(uint64(len(k))) + 1 + len(v) + sovTransform(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTransform(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -508,6 +550,133 @@ func (m *DatumSet) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trace == nil {
+				m.Trace = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTransform
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTransform
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTransform
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTransform
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTransform
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTransform
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTransform
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTransform(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTransform
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Trace[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransform(dAtA[iNdEx:])
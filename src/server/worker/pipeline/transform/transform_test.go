@@ -5,7 +5,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -211,7 +214,91 @@ func mockBasicJob(t *testing.T, env *testEnv, pi *pps.PipelineInfo) (context.Con
 	return ctx, jobInfo
 }
 
-func triggerJob(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files []tarutil.File) {
+// mockedJobs tracks the states of several jobs of a pipeline at once, for
+// tests where jobs run concurrently. Jobs are identified by the ID of their
+// input commit.
+type mockedJobs struct {
+	mu     sync.Mutex
+	states map[string]pps.JobState
+	done   map[string]chan struct{}
+}
+
+func mockJobs(t *testing.T, env *testEnv, pi *pps.PipelineInfo) *mockedJobs {
+	jobs := &mockedJobs{
+		states: make(map[string]pps.JobState),
+		done:   make(map[string]chan struct{}),
+	}
+	env.MockPachd.PPS.ListJob.Use(func(*pps.ListJobRequest, pps.API_ListJobServer) error {
+		return nil
+	})
+	env.MockPachd.PPS.InspectJob.Use(func(ctx context.Context, request *pps.InspectJobRequest) (*pps.JobInfo, error) {
+		outputCommit := client.NewCommit(pi.Pipeline.Name, pi.Details.OutputBranch, request.Job.ID)
+		outputCommitInfo, err := env.PachClient.InspectCommit(pi.Pipeline.Name, pi.Details.OutputBranch, request.Job.ID)
+		require.NoError(t, err)
+		return &pps.JobInfo{
+			Job:          client.NewJob(pi.Pipeline.Name, request.Job.ID),
+			OutputCommit: outputCommit,
+			State:        jobs.state(request.Job.ID),
+			Details: &pps.JobInfo_Details{
+				Transform:       pi.Details.Transform,
+				ParallelismSpec: pi.Details.ParallelismSpec,
+				Input:           ppsutil.JobInput(pi, outputCommitInfo.Commit),
+				Salt:            pi.Details.Salt,
+				DatumTries:      pi.Details.DatumTries,
+			},
+		}, nil
+	})
+	env.MockPPSTransactionServer.UpdateJobStateInTransaction.Use(func(txnCtx *txncontext.TransactionContext, request *pps.UpdateJobStateRequest) error {
+		jobs.update(request)
+		return nil
+	})
+	env.MockPachd.PPS.UpdateJobState.Use(func(ctx context.Context, request *pps.UpdateJobStateRequest) (*types.Empty, error) {
+		jobs.update(request)
+		return &types.Empty{}, nil
+	})
+	return jobs
+}
+
+func (j *mockedJobs) doneChan(id string) chan struct{} {
+	if _, ok := j.done[id]; !ok {
+		j.done[id] = make(chan struct{})
+	}
+	return j.done[id]
+}
+
+func (j *mockedJobs) update(request *pps.UpdateJobStateRequest) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	id := request.Job.ID
+	if pps.IsTerminal(j.states[id]) {
+		return
+	}
+	j.states[id] = request.State
+	if pps.IsTerminal(request.State) {
+		close(j.doneChan(id))
+	}
+}
+
+func (j *mockedJobs) state(id string) pps.JobState {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.states[id]
+}
+
+// wait returns the state of job 'id' once it's terminal, or its current state
+// if it isn't terminal within timeout.
+func (j *mockedJobs) wait(id string, timeout time.Duration) pps.JobState {
+	j.mu.Lock()
+	done := j.doneChan(id)
+	j.mu.Unlock()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
+	return j.state(id)
+}
+
+func triggerJob(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files []tarutil.File) *pfs.Commit {
 	commit, err := env.PachClient.StartCommit(pi.Details.Input.Pfs.Repo, "master")
 	require.NoError(t, err)
 	buf := &bytes.Buffer{}
@@ -225,6 +312,7 @@ func triggerJob(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files []taruti
 	}))
 	require.NoError(t, env.PachClient.PutFileTAR(commit, buf, client.WithAppendPutFile()))
 	require.NoError(t, env.PachClient.FinishCommit(pi.Details.Input.Pfs.Repo, commit.Branch.Name, commit.ID))
+	return commit
 }
 
 func testJobSuccess(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files []tarutil.File) {
@@ -450,6 +538,58 @@ func TestTransformPipeline(suite *testing.T) {
 			return nil
 		}))
 	})
+
+	suite.Run("TestJobConcurrentDelete", func(t *testing.T) {
+		t.Parallel()
+		pi := defaultPipelineInfo()
+		pi.Details.ConcurrentJobs = true
+		// The datum for /a is held until the hold file is removed, and each
+		// datum leaves a marker once it has been processed.
+		dir := t.TempDir()
+		hold := filepath.Join(dir, "hold")
+		require.NoError(t, ioutil.WriteFile(hold, nil, 0644))
+		pi.Details.Transform.Stdin = []string{
+			fmt.Sprintf("if [ -e inputRepo/a ]; then while [ -e %s ]; do sleep 0.1; done; fi", hold),
+			"cp inputRepo/* out",
+			fmt.Sprintf("touch %s/$(ls inputRepo)", dir),
+		}
+		env := newWorkerSpawnerPair(t, dockertestenv.NewTestDBConfig(t), pi)
+		jobs := mockJobs(t, env, pi)
+
+		fileA := tarutil.NewMemFile("/a", []byte("foobar"))
+		fileB := tarutil.NewMemFile("/b", []byte("barfoo"))
+		first := triggerJob(t, env, pi, []tarutil.File{fileA})
+		// The second job deletes /a and adds /b, so it doesn't share any datums
+		// with the first job, and processes /b while the first job is held.
+		commit, err := env.PachClient.StartCommit(pi.Details.Input.Pfs.Repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.DeleteFile(commit, "/a"))
+		require.NoError(t, env.PachClient.PutFile(commit, "/b", bytes.NewReader([]byte("barfoo"))))
+		require.NoError(t, env.PachClient.FinishCommit(pi.Details.Input.Pfs.Repo, commit.Branch.Name, commit.ID))
+		require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+			_, err := os.Stat(filepath.Join(dir, "b"))
+			return errors.EnsureStack(err)
+		})
+		require.False(t, pps.IsTerminal(jobs.state(first.ID)))
+		_, err = os.Stat(filepath.Join(dir, "a"))
+		require.True(t, os.IsNotExist(err))
+
+		// Once the first job finishes, the second job removes its output for /a.
+		require.NoError(t, os.Remove(hold))
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobs.wait(first.ID, 10*time.Second))
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobs.wait(commit.ID, 10*time.Second))
+		r, err := env.PachClient.GetFileTAR(client.NewCommit(pi.Pipeline.Name, pi.Details.OutputBranch, commit.ID), "/*")
+		require.NoError(t, err)
+		var files int
+		require.NoError(t, tarutil.Iterate(r, func(file tarutil.File) error {
+			files++
+			ok, err := tarutil.Equal(fileB, file)
+			require.NoError(t, err)
+			require.True(t, ok)
+			return nil
+		}))
+		require.Equal(t, 1, files)
+	})
 }
 
 func deleteFiles(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files []string) {
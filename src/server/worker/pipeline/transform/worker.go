@@ -3,6 +3,7 @@ package transform
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -14,9 +15,11 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
@@ -71,8 +74,12 @@ func checkS3Gateway(driver driver.Driver, logger logs.TaggedLogger) error {
 	// return nil
 }
 
-func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *DatumSet, status *Status) error {
-	pachClient := driver.PachClient()
+func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *DatumSet, status *Status) (retErr error) {
+	span, ctx := tracing.ContinueSpan(driver.PachClient().Ctx(), datumSet.Trace, "/worker/DatumSet", "job", datumSet.JobID)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	pachClient := driver.PachClient().WithCtx(ctx)
 	// TODO: Can this just be refactored into the datum package such that we don't need to specify a storage root for the sets?
 	// The sets would just create a temporary directory under /tmp.
 	storageRoot := filepath.Join(driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
@@ -86,6 +93,15 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 				datum.WithPFSOutput(mfPFS),
 				datum.WithStats(datumSet.Stats),
 			}
+			retryPolicy := driver.PipelineInfo().Details.RetryPolicy
+			retryClassifier, err := common.NewRetryClassifier(retryPolicy)
+			if err != nil {
+				return err
+			}
+			retryBackOff, err := common.NewRetryBackOff(retryPolicy)
+			if err != nil {
+				return err
+			}
 			return pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
 				pachClient := pachClient.WithCtx(ctx)
 				cacheClient := pfssync.NewCacheClient(pachClient, renewer)
@@ -97,7 +113,9 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						ctx := pachClient.Ctx()
 						inputs := meta.Inputs
 						logger = logger.WithData(inputs)
-						env := driver.UserCodeEnv(logger.JobID(), datumSet.OutputCommit, inputs)
+						env := func(ctx context.Context) []string {
+							return driver.UserCodeEnv(ctx, logger.JobID(), datumSet.OutputCommit, inputs)
+						}
 						var opts []datum.Option
 						if driver.PipelineInfo().Details.DatumTimeout != nil {
 							timeout, err := types.DurationFromProto(driver.PipelineInfo().Details.DatumTimeout)
@@ -109,9 +127,10 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						if driver.PipelineInfo().Details.DatumTries > 0 {
 							opts = append(opts, datum.WithRetry(int(driver.PipelineInfo().Details.DatumTries)-1))
 						}
+						opts = append(opts, datum.WithRetryPolicy(retryBackOff, retryClassifier.Retryable))
 						if driver.PipelineInfo().Details.Transform.ErrCmd != nil {
 							opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
-								return driver.RunUserErrorHandlingCode(runCtx, logger, env)
+								return driver.RunUserErrorHandlingCode(runCtx, logger, env(runCtx))
 							}))
 						}
 						return s.WithDatum(meta, func(d *datum.Datum) error {
@@ -119,8 +138,11 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 							defer cancel()
 							return status.withDatum(inputs, cancel, func() error {
 								return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
-									return d.Run(cancelCtx, func(runCtx context.Context) error {
-										return driver.RunUserCode(runCtx, logger, env)
+									return d.WithLogFile(func(w io.Writer) error {
+										logger := logger.WithDatumLog(w)
+										return d.Run(cancelCtx, func(runCtx context.Context) error {
+											return driver.RunUserCode(runCtx, logger, env(runCtx), d.Stats())
+										})
 									})
 								})
 							})
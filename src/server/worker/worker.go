@@ -113,6 +113,12 @@ func (w *Worker) master(env serviceenv.ServiceEnv) {
 	pipelineInfo := w.driver.PipelineInfo()
 	logger := logs.NewMasterLogger(pipelineInfo)
 	lockPath := path.Join(env.Config().PPSEtcdPrefix, masterLockPath, pipelineInfo.Pipeline.Name, pipelineInfo.Details.Salt)
+	if pipelineInfo.Details.Service.GetRollout() != nil || pipelineInfo.Details.Candidate != nil {
+		// The old and new versions of a service both run while it rolls out, and
+		// a candidate runs alongside the current version, so each version has
+		// its own master
+		lockPath = fmt.Sprintf("%s-v%d", lockPath, pipelineInfo.Version)
+	}
 	masterLock := dlock.NewDLock(env.GetEtcdClient(), lockPath)
 
 	b := backoff.NewInfiniteBackOff()